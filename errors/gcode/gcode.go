@@ -47,6 +47,8 @@ var (
 	CodeInvalidRequest            = localCode{66, "Invalid Request", nil}              // Invalid request.
 	CodeNecessaryPackageNotImport = localCode{67, "Necessary Package Not Import", nil} // It needs necessary package import.
 	CodeInternalPanic             = localCode{68, "Internal Panic", nil}               // A panic occurred internally.
+	CodeConversionFailed          = localCode{69, "Conversion Failed", nil}            // Type conversion failed.
+	CodeRequestTooLarge           = localCode{70, "Request Too Large", nil}            // Request content exceeds the size limit.
 	CodeBusinessValidationFailed  = localCode{300, "Business Validation Failed", nil}  // Business validation failed.
 )
 
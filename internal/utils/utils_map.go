@@ -6,6 +6,11 @@
 
 package utils
 
+import (
+	"sort"
+	"strings"
+)
+
 // MapPossibleItemByKey tries to find the possible key-value pair for given key ignoring cases and symbols.
 //
 // Note that this function might be of low performance.
@@ -35,3 +40,160 @@ func MapContainsPossibleKey(data map[string]any, key string) bool {
 	}
 	return false
 }
+
+// maxIndexedKeyIndex is the max index allowed in indexed key, which avoids huge slice allocation
+// from malicious keys like `items[99999999].name`.
+const maxIndexedKeyIndex = 10000
+
+// IndexedKeySegment is a segment of indexed key, which is either a name or an index.
+type IndexedKeySegment struct {
+	Name  string
+	Index int // It is -1 if the segment is a name.
+}
+
+// ExpandIndexedKeys expands the indexed keys like `items[0].name` and `orders[0].items[1].sku` of `data`
+// into nested maps and slices, eg:
+//
+//	{"items[0].name": "a", "items[1].name": "b"} => {"items": [{"name": "a"}, {"name": "b"}]}
+//
+// The gaps of slice are filled with nil, or empty maps if the slice elements are maps.
+// The indexed keys are ignored if their leading name also exists in `data` as plain key.
+// It returns `data` itself if there's no indexed key in `data`.
+func ExpandIndexedKeys(data map[string]any) map[string]any {
+	var indexedKeys []string
+	for key := range data {
+		if strings.IndexByte(key, '[') > 0 && ParseIndexedKey(key) != nil {
+			indexedKeys = append(indexedKeys, key)
+		}
+	}
+	if len(indexedKeys) == 0 {
+		return data
+	}
+	sort.Strings(indexedKeys)
+	var (
+		result     = make(map[string]any, len(data))
+		indexedSet = make(map[string]struct{}, len(indexedKeys))
+	)
+	for _, key := range indexedKeys {
+		indexedSet[key] = struct{}{}
+	}
+	for key, value := range data {
+		if _, ok := indexedSet[key]; !ok {
+			result[key] = value
+		}
+	}
+	var expanded = make(map[string]any)
+	for _, key := range indexedKeys {
+		segments := ParseIndexedKey(key)
+		if _, ok := result[segments[0].Name]; ok {
+			continue
+		}
+		expanded[segments[0].Name] = setIndexedValue(expanded[segments[0].Name], segments[1:], data[key])
+	}
+	for key, value := range expanded {
+		result[key] = fillIndexedGaps(value)
+	}
+	return result
+}
+
+// ParseIndexedKey parses `key` into segments split by char '.' and brackets, eg:
+// `orders[0].items[1].sku` => orders, 0, items, 1, sku.
+// It returns nil if `key` is not valid, which should start with a name, or if any index of it
+// is greater than maxIndexedKeyIndex, so that the malicious key never causes huge allocation.
+func ParseIndexedKey(key string) []IndexedKeySegment {
+	var pos = strings.IndexAny(key, "[.")
+	if pos == -1 {
+		if key == "" {
+			return nil
+		}
+		return []IndexedKeySegment{{Name: key, Index: -1}}
+	}
+	if pos == 0 {
+		return nil
+	}
+	var segments = []IndexedKeySegment{{Name: key[:pos], Index: -1}}
+	for pos < len(key) {
+		switch key[pos] {
+		case '[':
+			end := strings.IndexByte(key[pos:], ']')
+			if end < 2 {
+				return nil
+			}
+			index := 0
+			for _, c := range key[pos+1 : pos+end] {
+				if c < '0' || c > '9' {
+					return nil
+				}
+				if index = index*10 + int(c-'0'); index > maxIndexedKeyIndex {
+					return nil
+				}
+			}
+			segments = append(segments, IndexedKeySegment{Index: index})
+			pos += end + 1
+
+		case '.':
+			rest := key[pos+1:]
+			end := strings.IndexAny(rest, "[.")
+			if end == -1 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil
+			}
+			segments = append(segments, IndexedKeySegment{Name: rest[:end], Index: -1})
+			pos += end + 1
+
+		default:
+			return nil
+		}
+	}
+	return segments
+}
+
+// setIndexedValue sets `value` into `container` by `segments`, and returns the updated container.
+func setIndexedValue(container any, segments []IndexedKeySegment, value any) any {
+	if len(segments) == 0 {
+		return value
+	}
+	var segment = segments[0]
+	if segment.Index < 0 {
+		m, ok := container.(map[string]any)
+		if !ok {
+			m = make(map[string]any)
+		}
+		m[segment.Name] = setIndexedValue(m[segment.Name], segments[1:], value)
+		return m
+	}
+	s, _ := container.([]any)
+	for len(s) <= segment.Index {
+		s = append(s, nil)
+	}
+	s[segment.Index] = setIndexedValue(s[segment.Index], segments[1:], value)
+	return s
+}
+
+// fillIndexedGaps fills the nil gaps of slices with empty maps if the slice elements are maps.
+func fillIndexedGaps(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = fillIndexedGaps(item)
+		}
+	case []any:
+		var hasMap bool
+		for i, item := range v {
+			v[i] = fillIndexedGaps(item)
+			if _, ok := v[i].(map[string]any); ok {
+				hasMap = true
+			}
+		}
+		if hasMap {
+			for i, item := range v {
+				if item == nil {
+					v[i] = make(map[string]any)
+				}
+			}
+		}
+	}
+	return value
+}
@@ -138,3 +138,73 @@ func TestIsASCII(t *testing.T) {
 		t.AssertEQ(utils.IsASCII("😁😭❤️😓"), false)
 	})
 }
+
+func Test_ExpandIndexedKeys(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		data := map[string]any{"name": "john"}
+		t.Assert(utils.ExpandIndexedKeys(data), data)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		data := utils.ExpandIndexedKeys(map[string]any{
+			"name":                   "john",
+			"items[0].name":          "a",
+			"items[2].name":          "c",
+			"tags[1]":                "t1",
+			"orders[0].items[1].sku": "s1",
+			"orders[1].id":           2,
+			"invalid[a].name":        "x",
+			"invalid2[0]name":        "y",
+		})
+		t.Assert(data["name"], "john")
+		t.Assert(data["items"], []any{
+			map[string]any{"name": "a"},
+			map[string]any{},
+			map[string]any{"name": "c"},
+		})
+		t.Assert(data["tags"], []any{nil, "t1"})
+		t.Assert(data["orders"], []any{
+			map[string]any{"items": []any{map[string]any{}, map[string]any{"sku": "s1"}}},
+			map[string]any{"id": 2},
+		})
+		t.Assert(data["invalid[a].name"], "x")
+		t.Assert(data["invalid2[0]name"], "y")
+	})
+	// Plain key has priority, and huge index is not expanded.
+	gtest.C(t, func(t *gtest.T) {
+		data := utils.ExpandIndexedKeys(map[string]any{
+			"items":               "plain",
+			"items[0].name":       "a",
+			"huge[99999999].name": "h",
+		})
+		t.Assert(data["items"], "plain")
+		t.Assert(data["huge[99999999].name"], "h")
+		t.Assert(len(data), 2)
+	})
+}
+
+func Test_ParseIndexedKey(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(utils.ParseIndexedKey("name"), []utils.IndexedKeySegment{{Name: "name", Index: -1}})
+		t.Assert(utils.ParseIndexedKey("user.tags[1]"), []utils.IndexedKeySegment{
+			{Name: "user", Index: -1},
+			{Name: "tags", Index: -1},
+			{Index: 1},
+		})
+		t.Assert(utils.ParseIndexedKey("items[0][2].sku"), []utils.IndexedKeySegment{
+			{Name: "items", Index: -1},
+			{Index: 0},
+			{Index: 2},
+			{Name: "sku", Index: -1},
+		})
+	})
+	gtest.C(t, func(t *gtest.T) {
+		t.AssertNil(utils.ParseIndexedKey(""))
+		t.AssertNil(utils.ParseIndexedKey("[0].name"))
+		t.AssertNil(utils.ParseIndexedKey("user..name"))
+		t.AssertNil(utils.ParseIndexedKey("user.name."))
+		t.AssertNil(utils.ParseIndexedKey("items[]"))
+		t.AssertNil(utils.ParseIndexedKey("items[-1]"))
+		t.AssertNil(utils.ParseIndexedKey("items[0]name"))
+		t.AssertNil(utils.ParseIndexedKey("items[20000000].name"))
+	})
+}
@@ -8,6 +8,7 @@
 package gclient
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/tls"
 	"fmt"
@@ -37,6 +38,9 @@ type Client struct {
 	middlewareHandler []HandlerFunc     // Interceptor handlers
 	discovery         gsvc.Discovery    // Discovery for service.
 	builder           gsel.Builder      // Builder for request balance.
+	boundCtx          context.Context   // Bound context for all requests, eg: the context of server request.
+	requestSigner     *requestSigner    // Signer signing the request body with HMAC.
+	retryPolicy       *retryPolicy      // Policy retrying the failed request, which has priority over retryCount.
 }
 
 const (
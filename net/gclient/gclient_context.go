@@ -0,0 +1,62 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gclient
+
+import (
+	"context"
+)
+
+// boundValueContext is the context for request of client that has bound context,
+// which retrieves the value from bound context if it's missing in the request context.
+type boundValueContext struct {
+	context.Context                 // The context of the request.
+	bound           context.Context // The bound context of the client.
+}
+
+// BindContext binds `ctx` to the client, which is usually the context of the server request
+// calling upstreams. The cancellation and deadline of bound context are propagated to all requests
+// of the client, and the values of bound context like tracing information are used if they are
+// missing in the context passed to the request.
+func (c *Client) BindContext(ctx context.Context) *Client {
+	c.boundCtx = ctx
+	return c
+}
+
+// Value retrieves the value from request context, and then the bound context.
+func (ctx boundValueContext) Value(key any) any {
+	if v := ctx.Context.Value(key); v != nil {
+		return v
+	}
+	return ctx.bound.Value(key)
+}
+
+// mergeBoundContext merges the bound context of the client into the request context `ctx`.
+// The returned context is done if either the request context or bound context is done.
+func (c *Client) mergeBoundContext(ctx context.Context) context.Context {
+	var (
+		bound  = c.boundCtx
+		cancel context.CancelFunc
+	)
+	if bound == nil || bound == ctx {
+		return ctx
+	}
+	if ctx == nil {
+		return bound
+	}
+	if deadline, ok := bound.Deadline(); ok {
+		ctx, cancel = context.WithDeadline(ctx, deadline)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	// The merged context is released when the bound context is done,
+	// as the response content might be read after the request returns.
+	context.AfterFunc(bound, cancel)
+	return boundValueContext{
+		Context: ctx,
+		bound:   bound,
+	}
+}
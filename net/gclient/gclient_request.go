@@ -128,6 +128,7 @@ func (c *Client) DoRequest(
 	ctx context.Context, method, url string, data ...any,
 ) (resp *Response, err error) {
 	var requestStartTime = gtime.Now()
+	ctx = c.mergeBoundContext(ctx)
 	req, err := c.prepareRequest(ctx, method, url, data...)
 	if err != nil {
 		return nil, err
@@ -385,14 +386,35 @@ func (c *Client) callRequest(req *http.Request) (resp *Response, err error) {
 	// raw HTTP request-response procedure.
 	reqBodyContent, _ := io.ReadAll(req.Body)
 	resp.requestBody = reqBodyContent
-	for {
+	for attempt := 0; ; attempt++ {
 		req.Body = utils.NewReadCloser(reqBodyContent, false)
-		if resp.Response, err = c.Do(req); err != nil {
+		if c.requestSigner != nil {
+			c.requestSigner.sign(req, reqBodyContent)
+		}
+		resp.Response, err = c.Do(req)
+		if err != nil {
 			err = gerror.Wrapf(err, `request failed`)
 			// The response might not be nil when err != nil.
 			if resp.Response != nil {
 				_ = resp.Body.Close()
 			}
+		}
+		if c.retryPolicy != nil {
+			retry, retryErr := c.retryPolicy.shouldRetry(resp, err, attempt)
+			if !retry && retryErr == nil {
+				break
+			}
+			// The response of the retried or cancelled attempt is useless.
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+			if retryErr != nil {
+				err = retryErr
+				break
+			}
+			continue
+		}
+		if err != nil {
 			if c.retryCount > 0 {
 				c.retryCount--
 				time.Sleep(c.retryInterval)
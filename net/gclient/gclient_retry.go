@@ -0,0 +1,140 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+const (
+	defaultRetryBackoffBase = 100 * time.Millisecond // Backoff of the first retry in default.
+	defaultRetryBackoffMax  = 5 * time.Second        // Max backoff of retries in default.
+)
+
+// retryPolicy is the policy retrying the failed request.
+type retryPolicy struct {
+	maxRetries int                                  // Max retry count, which excludes the first attempt.
+	backoff    func(attempt int) time.Duration      // Waiting duration before the retry of `attempt`.
+	retryIf    func(resp *Response, err error) bool // Checks whether the request should be retried.
+}
+
+// SetRetryPolicy sets the client retrying the request at most `maxRetries` times, waiting for
+// `backoff(attempt)` before each retry, in which `attempt` starts from 1 for the first retry.
+// The request is retried only if `retryIf` returns true for the response and error of the last attempt.
+// The request body is buffered and re-sent for each retry, and the waiting is cancelled by the
+// cancellation of request context, the error of which is returned.
+//
+// It uses ExponentialBackoff from 100ms to 5s if `backoff` is nil, and DefaultRetryIf if `retryIf`
+// is nil. It disables the retrying if `maxRetries` <= 0. It has priority over SetRetry.
+//
+// Note that the `resp` of `retryIf` is never nil, but its Response is nil if the request fails without
+// response, and the response of the retried attempt is closed automatically.
+func (c *Client) SetRetryPolicy(
+	maxRetries int, backoff func(attempt int) time.Duration, retryIf func(resp *Response, err error) bool,
+) *Client {
+	if maxRetries <= 0 {
+		c.retryPolicy = nil
+		return c
+	}
+	if backoff == nil {
+		backoff = ExponentialBackoff(defaultRetryBackoffBase, defaultRetryBackoffMax)
+	}
+	if retryIf == nil {
+		retryIf = DefaultRetryIf
+	}
+	c.retryPolicy = &retryPolicy{
+		maxRetries: maxRetries,
+		backoff:    backoff,
+		retryIf:    retryIf,
+	}
+	return c
+}
+
+// RetryPolicy is a chaining function,
+// which sets the retry policy for next request, see SetRetryPolicy.
+func (c *Client) RetryPolicy(
+	maxRetries int, backoff func(attempt int) time.Duration, retryIf func(resp *Response, err error) bool,
+) *Client {
+	newClient := c.Clone()
+	newClient.SetRetryPolicy(maxRetries, backoff, retryIf)
+	return newClient
+}
+
+// ExponentialBackoff returns the backoff function for SetRetryPolicy, which doubles the waiting
+// duration from `base` for each retry, and the duration never exceeds `max`.
+func ExponentialBackoff(base, max time.Duration) func(attempt int) time.Duration {
+	return func(attempt int) time.Duration {
+		var backoff = base
+		for i := 1; i < attempt && backoff < max; i++ {
+			backoff *= 2
+		}
+		if backoff > max {
+			backoff = max
+		}
+		return backoff
+	}
+}
+
+// DefaultRetryIf is the default retry condition for SetRetryPolicy, which retries the request of
+// idempotent method if it fails with network error or the response status is 5xx.
+// It never retries if the request context is done.
+func DefaultRetryIf(resp *Response, err error) bool {
+	if resp == nil || resp.request == nil || !isIdempotentMethod(resp.request.Method) {
+		return false
+	}
+	if err != nil {
+		return resp.request.Context().Err() == nil
+	}
+	return resp.Response != nil && resp.StatusCode >= http.StatusInternalServerError
+}
+
+// isIdempotentMethod checks whether HTTP method `method` is idempotent, which is safe to be retried.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions,
+		http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// wait waits for the backoff of retry `attempt`, which returns the error if `ctx` is done.
+func (p *retryPolicy) wait(ctx context.Context, attempt int) error {
+	var backoff = p.backoff(attempt)
+	if backoff <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// shouldRetry checks whether the request should be retried after `attempt` times retrying,
+// and waits for the backoff if so. It returns the error if the waiting is cancelled.
+func (p *retryPolicy) shouldRetry(resp *Response, err error, attempt int) (bool, error) {
+	if attempt >= p.maxRetries || !p.retryIf(resp, err) {
+		return false, nil
+	}
+	if waitErr := p.wait(resp.request.Context(), attempt+1); waitErr != nil {
+		if err != nil && errors.Is(err, waitErr) {
+			return false, nil
+		}
+		return false, gerror.Wrapf(waitErr, `request retrying cancelled`)
+	}
+	return true, nil
+}
@@ -0,0 +1,83 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gclient
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"net/http"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// HMACAlgorithm is the hash algorithm for HMAC signature of request body.
+type HMACAlgorithm string
+
+const (
+	HMACAlgorithmSHA256 HMACAlgorithm = "sha256" // HMAC-SHA256, which is the default algorithm.
+	HMACAlgorithmSHA1   HMACAlgorithm = "sha1"   // HMAC-SHA1, for legacy partners.
+)
+
+// requestSigner signs the request body with HMAC and sets the signature to request header.
+type requestSigner struct {
+	secret  []byte           // Secret for signing.
+	header  string           // Request header name carrying the signature.
+	algo    HMACAlgorithm    // Hash algorithm.
+	newHash func() hash.Hash // Hash creating function of the algorithm.
+}
+
+// SetSignRequest sets the client signing the body of each request with HMAC using `secret`,
+// and sets the signature to request header `header` in format `algo=hex`, eg: `sha256=0a1b...`,
+// which can be verified by the server middleware ghttp.MiddlewareHMACVerify.
+// The request is signed right before each sending, so the retried request is signed again.
+//
+// It uses HMACAlgorithmSHA256 if `algo` is empty, and it panics if `algo` is not supported.
+// It disables the signing if `secret` is empty.
+func (c *Client) SetSignRequest(secret []byte, header string, algo HMACAlgorithm) *Client {
+	if len(secret) == 0 {
+		c.requestSigner = nil
+		return c
+	}
+	if algo == "" {
+		algo = HMACAlgorithmSHA256
+	}
+	var newHash func() hash.Hash
+	switch algo {
+	case HMACAlgorithmSHA256:
+		newHash = sha256.New
+	case HMACAlgorithmSHA1:
+		newHash = sha1.New
+	default:
+		panic(gerror.NewCodef(gcode.CodeInvalidParameter, `unsupported HMAC algorithm "%s"`, algo))
+	}
+	c.requestSigner = &requestSigner{
+		secret:  append([]byte(nil), secret...),
+		header:  header,
+		algo:    algo,
+		newHash: newHash,
+	}
+	return c
+}
+
+// SignRequest is a chaining function,
+// which signs the body of next request with HMAC, see SetSignRequest.
+func (c *Client) SignRequest(secret []byte, header string, algo HMACAlgorithm) *Client {
+	newClient := c.Clone()
+	newClient.SetSignRequest(secret, header, algo)
+	return newClient
+}
+
+// sign computes the HMAC signature of request body `body` and sets it to the header of `req`.
+func (s *requestSigner) sign(req *http.Request, body []byte) {
+	mac := hmac.New(s.newHash, s.secret)
+	mac.Write(body)
+	req.Header.Set(s.header, string(s.algo)+"="+hex.EncodeToString(mac.Sum(nil)))
+}
@@ -0,0 +1,151 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gclient_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/gclient"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Client_RetryPolicy(t *testing.T) {
+	var (
+		count  int32
+		bodies = make(chan string, 10)
+	)
+	s := g.Server(guid.S())
+	s.BindHandler("/flaky", func(r *ghttp.Request) {
+		bodies <- r.GetBodyString()
+		if atomic.AddInt32(&count, 1)%3 != 0 {
+			r.Response.WriteStatus(http.StatusServiceUnavailable)
+			return
+		}
+		r.Response.Write("ok")
+	})
+	s.BindHandler("/broken", func(r *ghttp.Request) {
+		atomic.AddInt32(&count, 1)
+		r.Response.WriteStatus(http.StatusInternalServerError)
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	var (
+		prefix  = fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort())
+		backoff = func(attempt int) time.Duration { return 10 * time.Millisecond }
+	)
+	// Idempotent method is retried in default.
+	gtest.C(t, func(t *gtest.T) {
+		atomic.StoreInt32(&count, 0)
+		client := g.Client().Prefix(prefix).RetryPolicy(3, backoff, nil)
+		t.Assert(client.GetContent(ctx, "/flaky"), "ok")
+		t.Assert(atomic.LoadInt32(&count), 3)
+	})
+	// Non-idempotent method is not retried in default.
+	gtest.C(t, func(t *gtest.T) {
+		atomic.StoreInt32(&count, 0)
+		client := g.Client().Prefix(prefix).RetryPolicy(3, backoff, nil)
+		resp, err := client.Post(ctx, "/flaky", "id=1")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusServiceUnavailable)
+		t.AssertNil(resp.Close())
+		t.Assert(atomic.LoadInt32(&count), 1)
+	})
+	// The body is re-sent for each retry.
+	gtest.C(t, func(t *gtest.T) {
+		atomic.StoreInt32(&count, 0)
+		for len(bodies) > 0 {
+			<-bodies
+		}
+		client := g.Client().Prefix(prefix).RetryPolicy(3, backoff, func(resp *gclient.Response, err error) bool {
+			return err != nil || resp.StatusCode >= 500
+		})
+		t.Assert(client.PostContent(ctx, "/flaky", "id=1"), "ok")
+		t.Assert(<-bodies, "id=1")
+		t.Assert(<-bodies, "id=1")
+		t.Assert(<-bodies, "id=1")
+	})
+	// The response of the last attempt is returned if retries are exhausted.
+	gtest.C(t, func(t *gtest.T) {
+		atomic.StoreInt32(&count, 0)
+		client := g.Client().Prefix(prefix).RetryPolicy(2, backoff, nil)
+		resp, err := client.Get(ctx, "/broken")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusInternalServerError)
+		t.AssertNil(resp.Close())
+		t.Assert(atomic.LoadInt32(&count), 3)
+	})
+	// The waiting is cancelled by the request context.
+	gtest.C(t, func(t *gtest.T) {
+		atomic.StoreInt32(&count, 0)
+		var (
+			client = g.Client().Prefix(prefix).RetryPolicy(3, func(attempt int) time.Duration {
+				return time.Second
+			}, nil)
+			timeoutCtx, cancel = context.WithTimeout(ctx, 200*time.Millisecond)
+			start              = time.Now()
+		)
+		defer cancel()
+		resp, err := client.Get(timeoutCtx, "/broken")
+		t.Assert(errors.Is(err, context.DeadlineExceeded), true)
+		t.AssertNil(resp.Close())
+		t.AssertLT(time.Since(start), time.Second)
+		t.Assert(atomic.LoadInt32(&count), 1)
+	})
+}
+
+func Test_Client_RetryPolicy_NetworkError(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			count  int32
+			client = gclient.New()
+		)
+		client.Transport = signRoundTripper(func(req *http.Request) (*http.Response, error) {
+			if atomic.AddInt32(&count, 1) < 3 {
+				return nil, errors.New("connection reset")
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader("ok")),
+				Request:    req,
+			}, nil
+		})
+		client.SetRetryPolicy(2, func(attempt int) time.Duration { return 0 }, nil)
+		t.Assert(client.GetContent(ctx, "http://127.0.0.1/"), "ok")
+		t.Assert(atomic.LoadInt32(&count), 3)
+
+		atomic.StoreInt32(&count, 0)
+		client.SetRetryPolicy(1, func(attempt int) time.Duration { return 0 }, nil)
+		_, err := client.Get(ctx, "http://127.0.0.1/")
+		t.AssertNE(err, nil)
+		t.Assert(atomic.LoadInt32(&count), 2)
+	})
+}
+
+func Test_ExponentialBackoff(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		backoff := gclient.ExponentialBackoff(100*time.Millisecond, time.Second)
+		t.Assert(backoff(1), 100*time.Millisecond)
+		t.Assert(backoff(2), 200*time.Millisecond)
+		t.Assert(backoff(4), 800*time.Millisecond)
+		t.Assert(backoff(5), time.Second)
+		t.Assert(backoff(100), time.Second)
+	})
+}
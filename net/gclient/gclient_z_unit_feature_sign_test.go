@@ -0,0 +1,109 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gclient_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/gclient"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+type signRoundTripper func(req *http.Request) (*http.Response, error)
+
+func (f signRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func Test_Client_SignRequest(t *testing.T) {
+	secret := []byte("secret")
+	s := g.Server(guid.S())
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareHMACVerify(func(r *ghttp.Request) ([]byte, error) {
+			return secret, nil
+		}, "X-Signature", ghttp.HMACAlgorithmSHA256))
+		group.POST("/webhook", func(r *ghttp.Request) {
+			r.Response.Write(r.GetBodyString())
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client().Prefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		t.Assert(client.PostContent(ctx, "/webhook", `{"id":1}`), "Unauthorized")
+		t.Assert(
+			client.SignRequest(secret, "X-Signature", gclient.HMACAlgorithmSHA256).
+				PostContent(ctx, "/webhook", `{"id":1}`),
+			`{"id":1}`,
+		)
+		t.Assert(
+			client.SignRequest([]byte("wrong"), "X-Signature", "").
+				PostContent(ctx, "/webhook", `{"id":1}`),
+			"Unauthorized",
+		)
+		t.Assert(
+			client.SignRequest(secret, "X-Signature", gclient.HMACAlgorithmSHA1).
+				PostContent(ctx, "/webhook", `{"id":1}`),
+			"Unauthorized",
+		)
+	})
+}
+
+func Test_Client_SignRequest_Retry(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			secret     = []byte("secret")
+			signatures []string
+			bodies     []string
+			client     = gclient.New()
+		)
+		client.Transport = signRoundTripper(func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			bodies = append(bodies, string(body))
+			signatures = append(signatures, req.Header.Get("X-Signature"))
+			if len(signatures) == 1 {
+				return nil, errors.New("connection refused")
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(nil),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		})
+		client.SetSignRequest(secret, "X-Signature", "").SetRetry(1, time.Millisecond)
+		resp, err := client.Post(ctx, "http://127.0.0.1/webhook", `{"id":1}`)
+		t.AssertNil(err)
+		defer resp.Close()
+
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte(`{"id":1}`))
+		expect := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+		t.Assert(signatures, []string{expect, expect})
+		t.Assert(bodies, []string{`{"id":1}`, `{"id":1}`})
+	})
+	gtest.C(t, func(t *gtest.T) {
+		defer func() {
+			t.AssertNE(recover(), nil)
+		}()
+		gclient.New().SetSignRequest([]byte("secret"), "X-Signature", "md5")
+	})
+}
@@ -719,3 +719,40 @@ func TestClient_NoUrlEncode(t *testing.T) {
 		t.Assert(c.NoUrlEncode().GetContent(ctx, `/`, params), `path=/data/binlog`)
 	})
 }
+
+func TestClient_BindContext(t *testing.T) {
+	type ctxKey string
+	s := g.Server(guid.S())
+	s.BindHandler("/", func(r *ghttp.Request) {
+		r.Response.Write("ok")
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+
+	time.Sleep(100 * time.Millisecond)
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			key           = ctxKey("key")
+			bound, cancel = context.WithCancel(context.WithValue(context.Background(), key, "bound"))
+			c             = g.Client().BindContext(bound)
+			value         any
+		)
+		c.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		c.Use(func(c *gclient.Client, r *http.Request) (*gclient.Response, error) {
+			value = r.Context().Value(key)
+			return c.Next(r)
+		})
+		t.Assert(c.GetContent(context.Background(), "/"), "ok")
+		t.Assert(value, "bound")
+
+		// The value of request context has priority.
+		t.Assert(c.GetContent(context.WithValue(context.Background(), key, "request"), "/"), "ok")
+		t.Assert(value, "request")
+
+		// The cancellation of bound context is propagated.
+		cancel()
+		_, err := c.Get(context.Background(), "/")
+		t.AssertNE(err, nil)
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -39,6 +40,7 @@ type (
 		serverCount      *gtype.Int                // Underlying http.Server number for internal usage.
 		closeChan        chan struct{}             // Used for underlying server closing event notification.
 		serveTree        map[string]any            // The route maps tree.
+		routerMu         sync.RWMutex              // Concurrent safety for route registering and searching at runtime.
 		serveCache       *gcache.Cache             // Server caches for internal usage.
 		routesMap        map[string][]*HandlerItem // Route map mainly for route dumps and repeated route checks.
 		statusHandlerMap map[string][]HandlerFunc  // Custom status handler map.
@@ -47,6 +49,10 @@ type (
 		serviceMu        sync.Mutex                // Concurrent safety for operations of attribute service.
 		service          gsvc.Service              // The service for Registry.
 		registrar        gsvc.Registrar            // Registrar for service register.
+		listeners        []*listenerItem           // Additional listeners added by AddListener.
+		inFlightRequests sync.Map                  // In-flight requests for shutdown report, *Request => *InFlightRequest.
+		shutdownReport   atomic.Value              // The report of the latest shutdown, which is *ShutdownReport.
+		routeInFlights   sync.Map                  // In-flight request counts of routes for MiddlewareRouteConcurrency, route key => *int64.
 	}
 
 	// Router object.
@@ -73,9 +79,24 @@ type (
 		IsServiceHandler bool         // Is a service handler.
 	}
 
+	// RouteInfo is the summary of a registered route, mainly for route table printing of tooling.
+	RouteInfo struct {
+		Domain          string      // Bound domain, eg: example.com
+		Method          string      // HTTP method, eg: GET, POST, ALL.
+		Pattern         string      // Route URI pattern, eg: /api/v1/user/{id}.
+		Type            HandlerType // Route handler type.
+		HookName        HookName    // Hook type name, only available for the hook type.
+		HandlerName     string      // Handler name, which is retrieved from runtime stack when registered.
+		MiddlewareCount int         // Count of middleware bound to this route.
+		Source          string      // Registering source file `path:line`.
+	}
+
 	// HandlerFunc is request handler function.
 	HandlerFunc = func(r *Request)
 
+	// ErrorHandlerFunc is the custom error handler function, which renders the error `err` of request.
+	ErrorHandlerFunc = func(r *Request, err error)
+
 	// handlerFuncInfo contains the HandlerFunc address and its reflection type.
 	handlerFuncInfo struct {
 		Func            HandlerFunc      // Handler function address.
@@ -101,6 +122,15 @@ type (
 		HookName   HookName        // Hook type name, only available for the hook type.
 		Router     *Router         // Router object.
 		Source     string          // Registering source file `path:line`.
+
+		// Deprecation information of the route, which is nil if the route is not deprecated.
+		Deprecation *RouteDeprecation
+
+		// Custom error handler of the route, which is nil if the route uses the default error handling.
+		ErrorHandler ErrorHandlerFunc
+
+		// Whether the validation of request parsing is disabled for the route.
+		NoValidation bool
 	}
 
 	// HandlerItemParsed is the item parsed from URL.Path.
@@ -152,6 +182,7 @@ const (
 	defaultMethod                      = "ALL"
 	routeCacheDuration                 = time.Hour
 	ctxKeyForRequest       gctx.StrKey = "gHttpRequestObject"
+	ctxKeyForListener      gctx.StrKey = "gHttpListener"
 	contentTypeXml                     = "text/xml"
 	contentTypeHtml                    = "text/html"
 	contentTypeJson                    = "application/json"
@@ -0,0 +1,129 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gogf/gf/v2/encoding/gbase64"
+	"github.com/gogf/gf/v2/os/gctx"
+)
+
+const (
+	ctxKeyForAuthUser   gctx.StrKey = "gHttpAuthUser"   // Context key for the user verified by basic authentication.
+	ctxKeyForAuthClaims gctx.StrKey = "gHttpAuthClaims" // Context key for the claims verified by bearer authentication.
+	defaultAuthRealm                = "Need Login"
+)
+
+// MiddlewareBasicAuth returns a middleware handler for http basic authentication.
+// It parses the `Authorization` header and calls `verify` with the user and password, and responds
+// status 401 with `WWW-Authenticate` header if the header is missing or `verify` returns false.
+// The verified user is stored in the request context, which can be retrieved by AuthUserFromCtx.
+//
+// Note that `verify` should compare the password in constant time, eg: using BasicAuthAccounts.
+func MiddlewareBasicAuth(verify func(user, pass string) bool, realm string) HandlerFunc {
+	if realm == "" {
+		realm = defaultAuthRealm
+	}
+	var challenge = fmt.Sprintf(`Basic realm="%s"`, strings.ReplaceAll(realm, `"`, `\"`))
+	return func(r *Request) {
+		user, pass, ok := parseBasicAuth(r.Header.Get("Authorization"))
+		if !ok || !verify(user, pass) {
+			r.Response.Header().Set("WWW-Authenticate", challenge)
+			r.Response.WriteStatusExit(http.StatusUnauthorized)
+		}
+		r.SetCtx(context.WithValue(r.Context(), ctxKeyForAuthUser, user))
+		r.Middleware.Next()
+	}
+}
+
+// MiddlewareBearerAuth returns a middleware handler for bearer token authentication.
+// It parses the token from `Authorization` header and calls `verify` with the token, and responds
+// status 401 with `WWW-Authenticate` header if the token is missing or `verify` returns false.
+// The claims returned by `verify` are stored in the request context, which can be retrieved
+// by AuthClaimsFromCtx.
+func MiddlewareBearerAuth(verify func(token string) (claims any, ok bool)) HandlerFunc {
+	return func(r *Request) {
+		token, found := parseBearerAuth(r.Header.Get("Authorization"))
+		if !found {
+			r.Response.Header().Set("WWW-Authenticate", `Bearer`)
+			r.Response.WriteStatusExit(http.StatusUnauthorized)
+		}
+		claims, ok := verify(token)
+		if !ok {
+			r.Response.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			r.Response.WriteStatusExit(http.StatusUnauthorized)
+		}
+		r.SetCtx(context.WithValue(r.Context(), ctxKeyForAuthClaims, claims))
+		r.Middleware.Next()
+	}
+}
+
+// BasicAuthAccounts returns a verifying function for MiddlewareBasicAuth, which checks the user and
+// password against `accounts` in format map[user]password using constant time comparison.
+func BasicAuthAccounts(accounts map[string]string) func(user, pass string) bool {
+	var hashedAccounts = make(map[string][sha256.Size]byte, len(accounts))
+	for user, pass := range accounts {
+		hashedAccounts[user] = sha256.Sum256([]byte(pass))
+	}
+	// It compares against an unknown password for unknown user, avoiding leaking which users exist.
+	var unknownPass = sha256.Sum256([]byte(defaultAuthRealm))
+	return func(user, pass string) bool {
+		var (
+			inputPass    = sha256.Sum256([]byte(pass))
+			expectPass   = unknownPass
+			expectExists = 0
+		)
+		if v, ok := hashedAccounts[user]; ok {
+			expectPass = v
+			expectExists = 1
+		}
+		return subtle.ConstantTimeCompare(inputPass[:], expectPass[:])&expectExists == 1
+	}
+}
+
+// AuthUserFromCtx retrieves and returns the user verified by MiddlewareBasicAuth from context.
+func AuthUserFromCtx(ctx context.Context) string {
+	if v, ok := ctx.Value(ctxKeyForAuthUser).(string); ok {
+		return v
+	}
+	return ""
+}
+
+// AuthClaimsFromCtx retrieves and returns the claims verified by MiddlewareBearerAuth from context.
+func AuthClaimsFromCtx(ctx context.Context) any {
+	return ctx.Value(ctxKeyForAuthClaims)
+}
+
+// parseBasicAuth parses the user and password from http basic authentication header value.
+func parseBasicAuth(auth string) (user, pass string, ok bool) {
+	const prefix = "Basic "
+	if len(auth) < len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) {
+		return "", "", false
+	}
+	decoded, err := gbase64.DecodeString(strings.TrimSpace(auth[len(prefix):]))
+	if err != nil {
+		return "", "", false
+	}
+	user, pass, ok = strings.Cut(string(decoded), ":")
+	return
+}
+
+// parseBearerAuth parses the token from bearer authentication header value.
+func parseBearerAuth(auth string) (token string, ok bool) {
+	const prefix = "Bearer "
+	if len(auth) < len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) {
+		return "", false
+	}
+	token = strings.TrimSpace(auth[len(prefix):])
+	return token, token != ""
+}
@@ -0,0 +1,60 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// MiddlewareRequireContentType returns a middleware handler that checks the `Content-Type` header
+// of request against `types`, eg: `application/json`, and responds status 415 with `Accept-Post`
+// header if it does not match. The parameters of the header like `charset` are ignored in checks,
+// and wildcard subtype like `multipart/*` is supported in `types`.
+//
+// The GET/HEAD/DELETE requests with empty body are passed through without checks.
+func MiddlewareRequireContentType(types ...string) HandlerFunc {
+	var mediaTypes = make([]string, 0, len(types))
+	for _, v := range types {
+		if mediaType := parseMediaType(v); mediaType != "" {
+			mediaTypes = append(mediaTypes, mediaType)
+		}
+	}
+	var acceptPost = strings.Join(mediaTypes, ", ")
+	return func(r *Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodDelete:
+			if r.ContentLength == 0 && len(r.TransferEncoding) == 0 {
+				r.Middleware.Next()
+				return
+			}
+		}
+		var mediaType = parseMediaType(r.Header.Get("Content-Type"))
+		for _, v := range mediaTypes {
+			if mediaType == v || (strings.HasSuffix(v, "/*") && strings.HasPrefix(mediaType, v[:len(v)-1])) {
+				r.Middleware.Next()
+				return
+			}
+		}
+		r.Response.Header().Set("Accept-Post", acceptPost)
+		r.Response.WriteStatus(
+			http.StatusUnsupportedMediaType,
+			`unsupported content type: `+mediaType,
+		)
+	}
+}
+
+// parseMediaType parses and returns the lower-case media type of `Content-Type` header value
+// without parameters, eg: `application/json; charset=utf-8` => `application/json`.
+func parseMediaType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType, _, _ = strings.Cut(contentType, ";")
+	}
+	return strings.ToLower(strings.TrimSpace(mediaType))
+}
@@ -6,8 +6,68 @@
 
 package ghttp
 
+import (
+	"net/http"
+	"strings"
+)
+
 // MiddlewareCORS is a middleware handler for CORS with default options.
 func MiddlewareCORS(r *Request) {
 	r.Response.CORSDefault()
 	r.Middleware.Next()
 }
+
+// MiddlewareCORSWithOptions returns a middleware handler for CORS with custom options,
+// which also handles the preflight requests automatically.
+//
+// The preflight request, which is an OPTIONS request with header `Access-Control-Request-Method`,
+// is responded with status 204 and the `Access-Control-*` headers, without invoking the serving handler.
+// If `AllowMethods` of `options` is empty, the preflight request computes the allowed methods from
+// the registered routes of the request path. The other empty attributes of `options` use the default
+// CORS options, see Response.DefaultCORSOptions.
+func MiddlewareCORSWithOptions(options CORSOptions) HandlerFunc {
+	return func(r *Request) {
+		var (
+			corsOptions = r.Response.DefaultCORSOptions()
+			isPreflight = r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+		)
+		if options.AllowDomain != nil {
+			corsOptions.AllowDomain = options.AllowDomain
+		}
+		if options.AllowOrigin != "" {
+			corsOptions.AllowOrigin = options.AllowOrigin
+		}
+		if options.AllowCredentials != "" {
+			corsOptions.AllowCredentials = options.AllowCredentials
+		}
+		if options.ExposeHeaders != "" {
+			corsOptions.ExposeHeaders = options.ExposeHeaders
+		}
+		if options.MaxAge != 0 {
+			corsOptions.MaxAge = options.MaxAge
+		}
+		if options.AllowHeaders != "" {
+			corsOptions.AllowHeaders = options.AllowHeaders
+		}
+		if options.AllowMethods != "" {
+			corsOptions.AllowMethods = options.AllowMethods
+		}
+		if !isPreflight {
+			r.Response.CORS(corsOptions)
+			r.Middleware.Next()
+			return
+		}
+		if options.AllowMethods == "" {
+			allowedMethods := r.Server.searchAllowedMethods(r)
+			if len(allowedMethods) == 0 {
+				// No route for the path, it continues the handling, which leads to status 404.
+				r.Middleware.Next()
+				return
+			}
+			corsOptions.AllowMethods = strings.Join(allowedMethods, ",")
+		}
+		r.Response.WriteHeader(http.StatusNoContent)
+		// It exits the handling for OPTIONS request.
+		r.Response.CORS(corsOptions)
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// decompressSupportedEncodings is the supported content encodings of MiddlewareDecompress,
+// which is responded in header `Accept-Encoding` for unsupported encodings.
+const decompressSupportedEncodings = "gzip, deflate"
+
+// MiddlewareDecompress is a middleware that decompresses the request body according to
+// the `Content-Encoding` header, so that the following handlers and `r.Parse` read
+// the decompressed data transparently.
+//
+// It supports encodings `gzip`, `x-gzip` and `deflate`. Multiple encodings like `deflate, gzip`
+// are decoded in the reverse order they were applied. It responds status 415 for unsupported
+// encodings with the supported encodings in header `Accept-Encoding`.
+//
+// Note that encoding `br` is deliberately unsupported, as there's no brotli implementation in
+// standard library and the framework does not depend on third-party compression packages. The
+// request of encoding `br` should be decompressed by a custom middleware before this one.
+//
+// The compressed body size is limited by `ClientMaxBodySize` of server configuration, and the decompressed
+// body size is limited by `MaxDecompressedBodySize`, which prevents zip bomb abuse while allowing large
+// compressed payloads. The decompressed body size is limited by `ClientMaxBodySize` as well if
+// `MaxDecompressedBodySize` is not set. Reading the body exceeding either limit fails with error of code
+// gcode.CodeRequestTooLarge naming the exceeded limit, which is responded as status 413 if ErrorStatusMapping
+// of server configuration is enabled.
+func MiddlewareDecompress(r *Request) {
+	encodings := parseContentEncodings(r.Header.Get("Content-Encoding"))
+	if len(encodings) == 0 {
+		r.Middleware.Next()
+		return
+	}
+	var (
+		reader io.Reader = &compressedBodyReader{Reader: r.Body}
+		err    error
+	)
+	for i := len(encodings) - 1; i >= 0; i-- {
+		switch encodings[i] {
+		case "gzip", "x-gzip":
+			reader, err = gzip.NewReader(reader)
+		case "deflate":
+			reader, err = newDeflateReader(reader)
+		default:
+			r.Response.Header().Set("Accept-Encoding", decompressSupportedEncodings)
+			r.Response.WriteStatus(
+				http.StatusUnsupportedMediaType,
+				`unsupported content encoding: `+encodings[i],
+			)
+			return
+		}
+		if err != nil {
+			r.Response.WriteStatus(
+				http.StatusBadRequest,
+				`invalid request body for content encoding "`+encodings[i]+`": `+err.Error(),
+			)
+			return
+		}
+	}
+	if limit, limitName := r.Server.getMaxDecompressedBodySize(); limit > 0 {
+		reader = &decompressedBodyLimitReader{
+			Reader:    reader,
+			remaining: limit,
+			limit:     limit,
+			limitName: limitName,
+		}
+	}
+	r.Body = &decompressReadCloser{
+		Reader: reader,
+		Closer: r.Body,
+	}
+	r.ContentLength = -1
+	r.Header.Del("Content-Encoding")
+	r.Header.Del("Content-Length")
+	r.Middleware.Next()
+}
+
+// decompressReadCloser reads the decompressed content and closes the original request body.
+type decompressReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// compressedBodyReader reads the compressed request body, which names the exceeded limit
+// `ClientMaxBodySize` in the error if the compressed body is too large.
+type compressedBodyReader struct {
+	io.Reader
+}
+
+// Read implements the io.Reader interface.
+func (r *compressedBodyReader) Read(p []byte) (n int, err error) {
+	n, err = r.Reader.Read(p)
+	var maxBytesErr *http.MaxBytesError
+	if err != nil && errors.As(err, &maxBytesErr) {
+		err = gerror.WrapCodef(
+			gcode.CodeRequestTooLarge, err,
+			`compressed request body exceeds the limit ClientMaxBodySize of %d bytes`, maxBytesErr.Limit,
+		)
+	}
+	return
+}
+
+// decompressedBodyLimitReader limits the size of the decompressed request body, which fails with
+// error naming the exceeded limit if the decompressed body is too large.
+type decompressedBodyLimitReader struct {
+	io.Reader
+	remaining int64  // Remaining bytes that can be read.
+	limit     int64  // Max decompressed body size in bytes.
+	limitName string // Configuration name of the limit.
+	err       error  // Sticky error after the limit is exceeded.
+}
+
+// Read implements the io.Reader interface.
+func (r *decompressedBodyLimitReader) Read(p []byte) (n int, err error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	// It reads one more byte than the remaining for checking whether the limit is exceeded.
+	if int64(len(p))-1 > r.remaining {
+		p = p[:r.remaining+1]
+	}
+	n, err = r.Reader.Read(p)
+	if int64(n) <= r.remaining {
+		r.remaining -= int64(n)
+		return n, err
+	}
+	n = int(r.remaining)
+	r.remaining = 0
+	r.err = gerror.NewCodef(
+		gcode.CodeRequestTooLarge,
+		`decompressed request body exceeds the limit %s of %d bytes`, r.limitName, r.limit,
+	)
+	return n, r.err
+}
+
+// getMaxDecompressedBodySize returns the max decompressed request body size along with its configuration
+// name, which is MaxDecompressedBodySize, or ClientMaxBodySize if MaxDecompressedBodySize is not set.
+func (s *Server) getMaxDecompressedBodySize() (limit int64, limitName string) {
+	if s.config.MaxDecompressedBodySize > 0 {
+		return s.config.MaxDecompressedBodySize, "MaxDecompressedBodySize"
+	}
+	return s.config.ClientMaxBodySize, "ClientMaxBodySize"
+}
+
+// parseContentEncodings parses the `Content-Encoding` header value into lower-case encoding names,
+// ignoring the `identity` encoding.
+func parseContentEncodings(header string) []string {
+	var encodings []string
+	for _, item := range strings.Split(header, ",") {
+		item = strings.ToLower(strings.TrimSpace(item))
+		if item == "" || item == "identity" {
+			continue
+		}
+		encodings = append(encodings, item)
+	}
+	return encodings
+}
+
+// newDeflateReader creates reader for `deflate` encoding.
+// The `deflate` encoding should be zlib format as the HTTP specification defines,
+// but some clients send raw deflate data, so it also supports raw deflate data.
+func newDeflateReader(reader io.Reader) (io.Reader, error) {
+	var (
+		bufReader   = bufio.NewReader(reader)
+		header, err = bufReader.Peek(2)
+	)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	// Checks the zlib header: compression method 8, and the header checksum.
+	if len(header) == 2 && header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0 {
+		return zlib.NewReader(bufReader)
+	}
+	return flate.NewReader(bufReader), nil
+}
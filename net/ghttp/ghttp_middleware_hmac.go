@@ -0,0 +1,114 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/utils"
+)
+
+// HMACAlgorithm is the hash algorithm for HMAC signature of request body.
+type HMACAlgorithm string
+
+const (
+	HMACAlgorithmSHA256 HMACAlgorithm = "sha256" // HMAC-SHA256, which is the default algorithm.
+	HMACAlgorithmSHA1   HMACAlgorithm = "sha1"   // HMAC-SHA1, for legacy partners.
+)
+
+// MiddlewareHMACVerify returns a middleware handler verifying the HMAC signature of request body,
+// which is carried in request header `header`. The secret for signing is retrieved by `secretFunc`
+// for each request, so that it can select secret for different partners, eg: by key id in header.
+//
+// The signature in header can be hex or base64 encoded, and optionally prefixed with the algorithm
+// name like `sha256=`. The signature is compared in constant time, and it responds status 401 if the
+// signature is missing or mismatched, or `secretFunc` returns error.
+//
+// The request body is read with the size limit `ClientMaxBodySize` of server configuration, and it
+// responds status 413 if the body exceeds the limit. The body is buffered after verifying, so the
+// following handlers can still read the original body as usual.
+func MiddlewareHMACVerify(
+	secretFunc func(r *Request) ([]byte, error), header string, algo HMACAlgorithm,
+) HandlerFunc {
+	if algo == "" {
+		algo = HMACAlgorithmSHA256
+	}
+	var newHash func() hash.Hash
+	switch algo {
+	case HMACAlgorithmSHA256:
+		newHash = sha256.New
+	case HMACAlgorithmSHA1:
+		newHash = sha1.New
+	default:
+		panic(gerror.NewCodef(gcode.CodeInvalidParameter, `unsupported HMAC algorithm "%s"`, algo))
+	}
+	return func(r *Request) {
+		signature, ok := parseHMACSignature(r.Header.Get(header), algo)
+		if !ok {
+			r.Response.WriteStatusExit(http.StatusUnauthorized)
+		}
+		secret, err := secretFunc(r)
+		if err != nil || len(secret) == 0 {
+			r.Response.WriteStatusExit(http.StatusUnauthorized)
+		}
+		body, err := r.readBodyForHMAC()
+		if err != nil {
+			if isRequestBodyTooLarge(err) {
+				r.Response.WriteStatusExit(http.StatusRequestEntityTooLarge)
+			}
+			r.Response.WriteStatusExit(http.StatusBadRequest)
+		}
+		mac := hmac.New(newHash, secret)
+		mac.Write(body)
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			r.Response.WriteStatusExit(http.StatusUnauthorized)
+		}
+		r.Middleware.Next()
+	}
+}
+
+// readBodyForHMAC reads and buffers the request body, which can be read again by the following handlers.
+func (r *Request) readBodyForHMAC() ([]byte, error) {
+	if r.bodyContent == nil {
+		content, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		r.bodyContent = content
+	}
+	r.Body = utils.NewReadCloser(r.bodyContent, true)
+	return r.bodyContent, nil
+}
+
+// parseHMACSignature decodes the hex or base64 encoded signature from header value `value`,
+// which might be prefixed with algorithm name, eg: `sha256=`.
+func parseHMACSignature(value string, algo HMACAlgorithm) ([]byte, bool) {
+	value = strings.TrimSpace(value)
+	if len(value) > len(algo) && value[len(algo)] == '=' && strings.EqualFold(value[:len(algo)], string(algo)) {
+		value = value[len(algo)+1:]
+	}
+	if value == "" {
+		return nil, false
+	}
+	if b, err := hex.DecodeString(value); err == nil {
+		return b, true
+	}
+	if b, err := base64.StdEncoding.DecodeString(value); err == nil {
+		return b, true
+	}
+	return nil, false
+}
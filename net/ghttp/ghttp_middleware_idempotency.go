@@ -0,0 +1,150 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/gogf/gf/v2/os/gcache"
+)
+
+const (
+	// HeaderIdempotencyKey is the request header carrying the idempotency key of request.
+	HeaderIdempotencyKey = "Idempotency-Key"
+
+	// HeaderIdempotentReplayed is the response header marking the response is replayed from
+	// the stored response of the former request with the same idempotency key.
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+	// idempotencyCacheKeyPrefix is the prefix of the cache key for storing the idempotency records.
+	idempotencyCacheKeyPrefix = "ghttp.idempotency:"
+)
+
+// idempotencyRecord is the record of the request with idempotency key, which is stored in cache.
+// It uses string for body, as the record might be serialized by the cache adapter like redis.
+type idempotencyRecord struct {
+	Completed   bool        `json:"completed"`   // Whether the request is completed, or else it is in-flight.
+	Fingerprint string      `json:"fingerprint"` // Fingerprint of request method, path and body.
+	Status      int         `json:"status"`      // Response status.
+	Header      http.Header `json:"header"`      // Response header.
+	Body        string      `json:"body"`        // Response body.
+}
+
+// MiddlewareIdempotency returns a middleware handler deduplicating the requests having the same
+// idempotency key in header `Idempotency-Key`, which is usually used for payment-like POST requests.
+//
+// The first request with a key is served as usual, and its response is stored in `store` for `ttl`.
+// The later requests with the same key within `ttl` are responded with the stored response without
+// serving again, which have header `Idempotent-Replayed: true`. It uses an in-memory cache if `store`
+// is nil, and the distributed cache like redis is necessary for multiple server instances.
+//
+// It responds status 409 if the request with the same key is still in-flight, and status 422 if the
+// request with the same key has different method, path or body. The response is not stored if the
+// request fails with error or server error status, so that the request can be retried with the key.
+// The requests without idempotency key are served as usual.
+func MiddlewareIdempotency(store *gcache.Cache, ttl time.Duration) HandlerFunc {
+	if store == nil {
+		store = gcache.New()
+	}
+	return func(r *Request) {
+		var key = r.Header.Get(HeaderIdempotencyKey)
+		if key == "" {
+			r.Middleware.Next()
+			return
+		}
+		var (
+			ctx         = r.Context()
+			cacheKey    = idempotencyCacheKeyPrefix + key
+			fingerprint = makeIdempotencyFingerprint(r)
+		)
+		ok, err := store.SetIfNotExist(ctx, cacheKey, idempotencyRecord{Fingerprint: fingerprint}, ttl)
+		if err != nil {
+			r.Server.Logger().Warningf(ctx, `store idempotency record failed: %+v`, err)
+			r.Middleware.Next()
+			return
+		}
+		if !ok {
+			r.replayIdempotencyRecord(store, cacheKey, fingerprint)
+			return
+		}
+		r.Middleware.Next()
+
+		// The failed request is not stored, which can be retried with the same key.
+		var status = r.Response.Status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		if r.error != nil || status >= http.StatusInternalServerError {
+			if _, err = store.Remove(ctx, cacheKey); err != nil {
+				r.Server.Logger().Warningf(ctx, `remove idempotency record failed: %+v`, err)
+			}
+			return
+		}
+		var header = r.Response.Header().Clone()
+		header.Del(responseHeaderTraceID)
+		if err = store.Set(ctx, cacheKey, idempotencyRecord{
+			Completed:   true,
+			Fingerprint: fingerprint,
+			Status:      status,
+			Header:      header,
+			Body:        r.Response.BufferString(),
+		}, ttl); err != nil {
+			r.Server.Logger().Warningf(ctx, `store idempotency record failed: %+v`, err)
+		}
+	}
+}
+
+// replayIdempotencyRecord responds with the stored record of key `cacheKey`.
+func (r *Request) replayIdempotencyRecord(store *gcache.Cache, cacheKey, fingerprint string) {
+	var (
+		ctx    = r.Context()
+		record idempotencyRecord
+	)
+	v, err := store.Get(ctx, cacheKey)
+	if err == nil && !v.IsNil() {
+		err = v.Scan(&record)
+	}
+	if err != nil {
+		r.Server.Logger().Warningf(ctx, `retrieve idempotency record failed: %+v`, err)
+		r.Response.WriteStatus(http.StatusInternalServerError)
+		return
+	}
+	switch {
+	case v.IsNil():
+		// The record is removed as the former request failed, or it is expired.
+		r.Response.WriteStatus(http.StatusConflict)
+
+	case record.Fingerprint != fingerprint:
+		r.Response.WriteStatus(http.StatusUnprocessableEntity)
+
+	case !record.Completed:
+		r.Response.WriteStatus(http.StatusConflict)
+
+	default:
+		for k, values := range record.Header {
+			r.Response.Header()[k] = values
+		}
+		r.Response.Header().Set(HeaderIdempotentReplayed, "true")
+		r.Response.WriteHeader(record.Status)
+		r.Response.Write(record.Body)
+	}
+}
+
+// makeIdempotencyFingerprint makes and returns the fingerprint of request method, path and body,
+// which distinguishes the different requests using the same idempotency key.
+func makeIdempotencyFingerprint(r *Request) string {
+	var h = sha256.New()
+	h.Write([]byte(r.Method))
+	h.Write([]byte{' '})
+	h.Write([]byte(r.URL.Path))
+	h.Write([]byte{' '})
+	h.Write(r.GetBody())
+	return hex.EncodeToString(h.Sum(nil))
+}
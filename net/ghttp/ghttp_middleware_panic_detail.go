@@ -0,0 +1,75 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/util/gmode"
+)
+
+// PanicDetail is the error content rendered by MiddlewarePanicDetail for the panic of request.
+type PanicDetail struct {
+	Code    int                `json:"code"    dc:"Error code"`
+	Message string             `json:"message" dc:"Panic message"`
+	Stack   []string           `json:"stack"   dc:"Stack of the panic point"`
+	Request PanicDetailRequest `json:"request" dc:"Details of the request causing the panic"`
+}
+
+// PanicDetailRequest is the request details of PanicDetail.
+type PanicDetailRequest struct {
+	Method     string      `json:"method"`
+	Url        string      `json:"url"`
+	Route      string      `json:"route"`
+	ClientIp   string      `json:"clientIp"`
+	RemoteAddr string      `json:"remoteAddr"`
+	Header     http.Header `json:"header"`
+}
+
+// MiddlewarePanicDetail is the middleware rendering the panic of request as JSON content containing
+// the panic message, stack and request details, which is convenient for debugging in development.
+//
+// It takes effect only if the application is running in DEVELOP mode, which can be specified by
+// command option or environment variable `gf.gmode`. It does nothing in other modes, so that the
+// panic details are never exposed to clients in production.
+func MiddlewarePanicDetail(r *Request) {
+	r.Middleware.Next()
+
+	var err = r.GetError()
+	if err == nil || gerror.Code(err) != gcode.CodeInternalPanic || !gmode.IsDevelop() {
+		return
+	}
+	if r.Response.IsHeaderWrote() || r.Response.IsHijacked() {
+		return
+	}
+	var detail = PanicDetail{
+		Code:    gcode.CodeInternalPanic.Code(),
+		Message: err.Error(),
+		Request: PanicDetailRequest{
+			Method:     r.Method,
+			Url:        r.URL.String(),
+			ClientIp:   r.GetClientIp(),
+			RemoteAddr: r.RemoteAddr,
+			Header:     r.Header,
+		},
+	}
+	if r.Router != nil {
+		detail.Request.Route = r.Router.Uri
+	}
+	// The stack is captured by gerror at the panic point.
+	for _, line := range strings.Split(gerror.Stack(err), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			detail.Stack = append(detail.Stack, line)
+		}
+	}
+	r.Response.ClearBuffer()
+	r.Response.WriteHeader(http.StatusInternalServerError)
+	r.Response.WriteJson(detail)
+}
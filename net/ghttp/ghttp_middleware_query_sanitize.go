@@ -0,0 +1,81 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// MiddlewareQuerySanitize returns a middleware handler that normalizes the query string of request before
+// the following handlers, which is useful for the query string based caching. It rewrites `r.URL.RawQuery`:
+//  1. The parameters whose names are not in `allowed` are removed. The item of `allowed` ending with char '*'
+//     matches names by prefix, eg: `filter_*`. All parameters are kept if `allowed` is empty.
+//  2. The parameters are sorted by name, and the values of the same name keep their original order.
+//
+// It responds status 400 if the query string is invalid, or the count of kept parameters exceeds `maxParams`,
+// which is not limited if `maxParams` <= 0.
+func MiddlewareQuerySanitize(allowed []string, maxParams int) HandlerFunc {
+	var (
+		allowedNames    = make(map[string]struct{})
+		allowedPrefixes = make([]string, 0)
+	)
+	for _, v := range allowed {
+		if strings.HasSuffix(v, "*") {
+			allowedPrefixes = append(allowedPrefixes, v[:len(v)-1])
+		} else {
+			allowedNames[v] = struct{}{}
+		}
+	}
+	var isAllowed = func(name string) bool {
+		if len(allowed) == 0 {
+			return true
+		}
+		if _, ok := allowedNames[name]; ok {
+			return true
+		}
+		for _, prefix := range allowedPrefixes {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+	return func(r *Request) {
+		if r.URL.RawQuery == "" {
+			r.Middleware.Next()
+			return
+		}
+		values, err := url.ParseQuery(r.URL.RawQuery)
+		if err != nil {
+			r.Response.WriteStatus(http.StatusBadRequest, `invalid query string`)
+			return
+		}
+		var paramCount int
+		for name, items := range values {
+			if !isAllowed(name) {
+				delete(values, name)
+				continue
+			}
+			paramCount += len(items)
+		}
+		if maxParams > 0 && paramCount > maxParams {
+			r.Response.WriteStatus(
+				http.StatusBadRequest,
+				fmt.Sprintf(`too many query parameters: %d exceeds limit %d`, paramCount, maxParams),
+			)
+			return
+		}
+		// The query parameters are reparsed from the sanitized query string.
+		r.URL.RawQuery = values.Encode()
+		r.parsedQuery = false
+		r.queryMap = nil
+		r.Middleware.Next()
+	}
+}
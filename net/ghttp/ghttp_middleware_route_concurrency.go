@@ -0,0 +1,55 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"net/http"
+	"sync/atomic"
+)
+
+// MiddlewareRouteConcurrency returns a middleware handler limiting the concurrent requests of each
+// route to `max`, which responds status 503 if the route already has `max` requests in-flight.
+// It is usually bound to the routes of heavy handlers, eg: report exporting.
+//
+// The limit is applied to each route separately if it is bound to a group, and the slot of request
+// is released after the request is served, even if the handler panics. The in-flight count of route
+// can be retrieved by Server.GetRouteInFlight for metrics. It does not limit if `max` <= 0.
+func MiddlewareRouteConcurrency(max int) HandlerFunc {
+	return func(r *Request) {
+		if max <= 0 || r.serveHandler == nil || r.serveHandler.Handler.Router == nil {
+			r.Middleware.Next()
+			return
+		}
+		var (
+			router   = r.serveHandler.Handler.Router
+			routeKey = r.Server.serveHandlerKey(router.Method, router.Uri, router.Domain)
+			v, _     = r.Server.routeInFlights.LoadOrStore(routeKey, new(int64))
+			inFlight = v.(*int64)
+		)
+		if atomic.AddInt64(inFlight, 1) > int64(max) {
+			atomic.AddInt64(inFlight, -1)
+			r.Response.WriteStatus(http.StatusServiceUnavailable)
+			return
+		}
+		defer atomic.AddInt64(inFlight, -1)
+		r.Middleware.Next()
+	}
+}
+
+// GetRouteInFlight returns the in-flight request count of route `pattern` limited by
+// MiddlewareRouteConcurrency, which is the same pattern as route binding, eg: "POST:/report/export".
+// It returns 0 if the route has no request served yet.
+func (s *Server) GetRouteInFlight(pattern string) int {
+	domain, method, path, err := s.parsePattern(pattern)
+	if err != nil {
+		return 0
+	}
+	if v, ok := s.routeInFlights.Load(s.serveHandlerKey(method, path, domain)); ok {
+		return int(atomic.LoadInt64(v.(*int64)))
+	}
+	return 0
+}
@@ -0,0 +1,53 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// MiddlewareTransformBody returns a middleware that rewrites the buffered response body with
+// `transform` after all the later handlers are done, eg: injecting nonce into HTML or minifying JSON.
+// The `contentType` is the Content-Type header of the response, or the sniffed one if it is not set.
+// The Content-Length header is updated with the length of the transformed body if it is set.
+//
+// It does nothing for the empty body, the compressed body having Content-Encoding header, and the
+// streamed response whose header or content is already flushed to the client. It keeps the
+// original body and logs the error if `transform` fails.
+//
+// Note that it should be registered after the compressing middleware like MiddlewareGzip, so that
+// it transforms the uncompressed body.
+func MiddlewareTransformBody(transform func(contentType string, body []byte) ([]byte, error)) HandlerFunc {
+	return func(r *Request) {
+		r.Middleware.Next()
+
+		if r.Response.IsHijacked() || r.Response.IsHeaderWrote() || r.Response.BytesWritten() > 0 {
+			return
+		}
+		if r.Response.Header().Get("Content-Encoding") != "" {
+			return
+		}
+		var body = r.Response.Buffer()
+		if len(body) == 0 {
+			return
+		}
+		var contentType = r.Response.Header().Get("Content-Type")
+		if contentType == "" {
+			contentType = http.DetectContentType(body)
+		}
+		transformed, err := transform(contentType, body)
+		if err != nil {
+			r.Server.Logger().Warningf(r.Context(), "response body transforming failed: %+v", err)
+			return
+		}
+		r.Response.SetBuffer(transformed)
+		if r.Response.Header().Get("Content-Length") != "" {
+			r.Response.Header().Set("Content-Length", strconv.Itoa(len(transformed)))
+		}
+	}
+}
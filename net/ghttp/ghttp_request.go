@@ -7,6 +7,7 @@
 package ghttp
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -61,6 +62,7 @@ type Request struct {
 	viewObject      *gview.View          // Custom template view engine object for this response.
 	viewParams      gview.Params         // Custom template view variables for this response.
 	originUrlPath   string               // Original URL path that passed from client.
+	clientCtx       context.Context      // Original context of the request, which is canceled if the client disconnects.
 }
 
 // staticFile is the file struct for static file service.
@@ -78,6 +80,7 @@ func newRequest(s *Server, r *http.Request, w http.ResponseWriter) *Request {
 		Response:      newResponse(s, w),
 		EnterTime:     gtime.Now(),
 		originUrlPath: r.URL.Path,
+		clientCtx:     r.Context(),
 	}
 	request.Cookie = GetCookie(request)
 	request.Session = s.sessionManager.New(
@@ -7,6 +7,7 @@
 package ghttp
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"net/http"
 	"strings"
@@ -40,7 +41,9 @@ func (r *Request) BasicAuth(user, pass string, tips ...string) bool {
 			r.Response.WriteStatus(http.StatusForbidden)
 			return false
 		}
-		if authArray[0] != user || authArray[1] != pass {
+		// It compares in constant time, avoiding timing attack.
+		if subtle.ConstantTimeCompare([]byte(authArray[0]), []byte(user)) != 1 ||
+			subtle.ConstantTimeCompare([]byte(authArray[1]), []byte(pass)) != 1 {
 			r.setBasicAuth(tips...)
 			return false
 		}
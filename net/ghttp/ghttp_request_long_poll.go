@@ -0,0 +1,81 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const (
+	// longPollCheckInterval is the interval calling the check function of long-polling.
+	longPollCheckInterval = 100 * time.Millisecond
+
+	// longPollWriteTimeoutReserveRatio is the ratio of server WriteTimeout reserved for writing
+	// the response of long-polling.
+	longPollWriteTimeoutReserveRatio = 10
+)
+
+// LongPoll blocks current request until `check` returns ready or the `wait` duration elapses, which is
+// commonly used for long-polling endpoints. The `check` is called immediately and then periodically.
+//
+// If `check` returns ready, its returned `data` is written as JSON and flushed to the client.
+// If the `wait` duration elapses, it writes status 204 No Content without content.
+// The `wait` duration is shortened if the server has WriteTimeout configured, which leaves time for
+// writing the response before the connection is closed by the server.
+//
+// It returns the error of `ctx` or the request context if either of them is done before,
+// like the client disconnection, in which case nothing is written.
+func (r *Request) LongPoll(ctx context.Context, wait time.Duration, check func() (data any, ready bool)) error {
+	if writeTimeout := r.Server.config.WriteTimeout; writeTimeout > 0 {
+		var remaining = writeTimeout - writeTimeout/longPollWriteTimeoutReserveRatio - time.Since(r.EnterTime.Time)
+		if remaining < wait {
+			wait = remaining
+		}
+	}
+	if data, ready := check(); ready {
+		r.writeLongPollData(data)
+		return nil
+	}
+	if wait <= 0 {
+		r.Response.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+	var (
+		requestCtx = r.Context()
+		timer      = time.NewTimer(wait)
+		ticker     = time.NewTicker(longPollCheckInterval)
+	)
+	defer timer.Stop()
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-requestCtx.Done():
+			return requestCtx.Err()
+
+		case <-timer.C:
+			r.Response.WriteHeader(http.StatusNoContent)
+			return nil
+
+		case <-ticker.C:
+			if data, ready := check(); ready {
+				r.writeLongPollData(data)
+				return nil
+			}
+		}
+	}
+}
+
+// writeLongPollData writes `data` of long-polling as JSON and flushes it to the client.
+func (r *Request) writeLongPollData(data any) {
+	r.Response.WriteJson(data)
+	r.Response.Flush()
+}
@@ -33,6 +33,11 @@ func (m *middleware) Next() {
 		if m.request.IsExited() || m.handlerIndex >= len(m.request.handlers) {
 			break
 		}
+		// The remaining handlers are skipped if the client disconnects, which is enabled by SetClientDisconnectAbort.
+		if m.request.Server.config.ClientDisconnectAbort && m.request.IsClientDisconnected() {
+			m.request.exitAll = true
+			break
+		}
 		item = m.request.handlers[m.handlerIndex]
 		// Filter the HOOK handlers, which are designed to be called in another standalone procedure.
 		if item.Handler.Type == HandlerTypeHook {
@@ -109,17 +114,20 @@ func (m *middleware) Next() {
 				// of the real error point.
 				m.request.error = gerror.WrapCodeSkip(gcode.CodeInternalError, 1, exception, "")
 			}
-			m.request.Response.WriteStatus(http.StatusInternalServerError, exception)
+			if !m.request.Server.handleErrorByRouteHandler(m.request, m.request.error) {
+				m.request.Response.WriteStatus(m.request.Server.errorStatusCode(m.request.error), exception)
+			}
 			loop = false
 		})
 	}
 	// Check the http status code after all handlers and middleware done.
+	// The status of request whose client disconnects is left for the server to classify.
 	if m.request.IsExited() || m.handlerIndex >= len(m.request.handlers) {
-		if m.request.Response.Status == 0 {
+		if m.request.Response.Status == 0 && !m.request.IsClientDisconnected() {
 			if m.request.Middleware.served {
 				m.request.Response.WriteHeader(http.StatusOK)
 			} else {
-				m.request.Response.WriteHeader(http.StatusNotFound)
+				m.request.Server.handleNotFound(m.request)
 			}
 		}
 	}
@@ -12,6 +12,7 @@ import (
 	"io"
 	"mime/multipart"
 	"net/http"
+	"net/url"
 	"reflect"
 	"strings"
 
@@ -26,6 +27,8 @@ import (
 	"github.com/gogf/gf/v2/text/gregex"
 	"github.com/gogf/gf/v2/text/gstr"
 	"github.com/gogf/gf/v2/util/gconv"
+	"github.com/gogf/gf/v2/util/gmeta"
+	"github.com/gogf/gf/v2/util/gtag"
 	"github.com/gogf/gf/v2/util/gvalid"
 )
 
@@ -52,21 +55,35 @@ var (
 //
 // TODO: Improve the performance by reducing duplicated reflect usage on the same variable across packages.
 func (r *Request) Parse(pointer any) error {
-	return r.doParse(pointer, parseTypeRequest)
+	return r.doParse(pointer, parseTypeRequest, true)
+}
+
+// ParseValidate performs like function Parse, but it does not stop validation at the first
+// validation error. It collects and returns all the field errors as gvalid.Error, which is
+// in code gcode.CodeValidationFailed and is responded with status 400 by the default error handling.
+// The error messages are translated using the i18n language of the request context.
+func (r *Request) ParseValidate(pointer any) error {
+	return r.doParse(pointer, parseTypeRequest, false)
 }
 
 // ParseQuery performs like function Parse, but only parses the query parameters.
 func (r *Request) ParseQuery(pointer any) error {
-	return r.doParse(pointer, parseTypeQuery)
+	return r.doParse(pointer, parseTypeQuery, true)
 }
 
 // ParseForm performs like function Parse, but only parses the form parameters or the body content.
 func (r *Request) ParseForm(pointer any) error {
-	return r.doParse(pointer, parseTypeForm)
+	return r.doParse(pointer, parseTypeForm, true)
 }
 
 // doParse parses the request data to struct/structs according to request type.
-func (r *Request) doParse(pointer any, requestType int) error {
+// The parameter `bail` specifies whether stopping validation after the first validation error.
+// The validation is disabled if the serving route is marked with meta tag `nv`, eg:
+//
+//	type Req struct {
+//	    g.Meta `path:"/user" method:"post" nv:"true"`
+//	}
+func (r *Request) doParse(pointer any, requestType int, bail bool) error {
 	var (
 		reflectVal1  = reflect.ValueOf(pointer)
 		reflectKind1 = reflectVal1.Kind()
@@ -108,8 +125,10 @@ func (r *Request) doParse(pointer any, requestType int) error {
 			}
 		}
 		// Validation.
-		if err = gvalid.New().
-			Bail().
+		if !r.isValidationEnabled(pointer) {
+			return nil
+		}
+		if err = r.newParseValidator(bail).
 			Data(pointer).
 			Assoc(data).
 			Run(r.Context()); err != nil {
@@ -128,9 +147,11 @@ func (r *Request) doParse(pointer any, requestType int) error {
 		if err = j.Var().Scan(pointer); err != nil {
 			return err
 		}
+		if !r.isValidationEnabled(pointer) {
+			return nil
+		}
 		for i := 0; i < reflectVal2.Len(); i++ {
-			if err = gvalid.New().
-				Bail().
+			if err = r.newParseValidator(bail).
 				Data(reflectVal2.Index(i)).
 				Assoc(j.Get(gconv.String(i)).Map()).
 				Run(r.Context()); err != nil {
@@ -141,6 +162,29 @@ func (r *Request) doParse(pointer any, requestType int) error {
 	return nil
 }
 
+// newParseValidator creates and returns a validator for request parsing.
+func (r *Request) newParseValidator(bail bool) *gvalid.Validator {
+	var validator = gvalid.New()
+	if bail {
+		validator = validator.Bail()
+	}
+	return validator
+}
+
+// isValidationEnabled checks and returns whether the validation is enabled for parsing `pointer`,
+// which can be disabled for all parsing of the serving route registered with RouteNoValidation,
+// or using meta tag `nv` of the struct being parsed, or the element struct of the slice being parsed.
+// The meta tag of other structs parsed in the same request does not take effect.
+func (r *Request) isValidationEnabled(pointer any) bool {
+	if r.serveHandler != nil && r.serveHandler.Handler.NoValidation {
+		return false
+	}
+	if v := gmeta.Get(pointer, gtag.NoValidation); v != nil {
+		return !v.Bool()
+	}
+	return true
+}
+
 // Get is alias of GetRequest, which is one of the most commonly used functions for
 // retrieving parameter.
 // See r.GetRequest.
@@ -164,6 +208,9 @@ func (r *Request) MakeBodyRepeatableRead(repeatableRead bool) []byte {
 		var err error
 		if r.bodyContent, err = io.ReadAll(r.Body); err != nil {
 			errMsg := `Read from request Body failed`
+			if isRequestBodyTooLarge(err) {
+				panic(gerror.WrapCode(gcode.CodeRequestTooLarge, err, errMsg))
+			}
 			if gerror.Is(err, io.EOF) {
 				errMsg += `, the Body might be closed or read manually from middleware/hook/other package previously`
 			}
@@ -215,13 +262,54 @@ func (r *Request) parseQuery() {
 	r.parsedQuery = true
 	if r.URL.RawQuery != "" {
 		var err error
-		r.queryMap, err = gstr.Parse(r.URL.RawQuery)
+		r.queryMap, err = parseParams(r.URL.RawQuery)
 		if err != nil {
 			panic(gerror.WrapCode(gcode.CodeInvalidParameter, err, "Parse Query failed"))
 		}
 	}
 }
 
+// parseParams parses the url-encoded parameters string `s` into map like gstr.Parse,
+// but it also supports the indexed keys like `items[0].name` for slice of structs, which are
+// expanded into slice of maps, eg: `items[0].name=a&items[1].name=b` => {"items":[{"name":"a"},{"name":"b"}]}.
+func parseParams(s string) (map[string]any, error) {
+	if !strings.Contains(s, "].") && !strings.Contains(s, "%5D.") && !strings.Contains(s, "%5d.") {
+		return gstr.Parse(s)
+	}
+	var (
+		parts       = strings.Split(s, "&")
+		normalParts = make([]string, 0, len(parts))
+		indexedMap  = make(map[string]any)
+	)
+	for _, part := range parts {
+		pos := strings.Index(part, "=")
+		if pos <= 0 {
+			continue
+		}
+		key, err := url.QueryUnescape(part[:pos])
+		if err != nil || !strings.Contains(key, "].") {
+			normalParts = append(normalParts, part)
+			continue
+		}
+		value, err := url.QueryUnescape(part[pos+1:])
+		if err != nil {
+			return nil, gerror.Wrapf(err, `url.QueryUnescape failed for string "%s"`, part[pos+1:])
+		}
+		indexedMap[key] = value
+	}
+	result, err := gstr.Parse(strings.Join(normalParts, "&"))
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		result = make(map[string]any)
+	}
+	for key, value := range indexedMap {
+		result[key] = value
+	}
+	return utils.ExpandIndexedKeys(result), nil
+}
+
 // parseBody parses the request raw data into r.rawMap.
 // Note that it also supports JSON data from client request.
 func (r *Request) parseBody() {
@@ -249,7 +337,7 @@ func (r *Request) parseBody() {
 		}
 		// Default parameters decoding.
 		if contentType := r.Header.Get("Content-Type"); (contentType == "" || !gstr.Contains(contentType, "multipart/")) && r.bodyMap == nil {
-			r.bodyMap, _ = gstr.Parse(r.GetBodyString())
+			r.bodyMap, _ = parseParams(r.GetBodyString())
 		}
 	}
 }
@@ -281,12 +369,12 @@ func (r *Request) parseForm() {
 		if isMultiPartRequest {
 			// multipart/form-data, multipart/mixed
 			if err = r.ParseMultipartForm(r.Server.config.FormParsingMemory); err != nil {
-				panic(gerror.WrapCode(gcode.CodeInvalidRequest, err, "r.ParseMultipartForm failed"))
+				panic(wrapRequestBodyError(err, "r.ParseMultipartForm failed"))
 			}
 		} else if isFormRequest {
 			// application/x-www-form-urlencoded
 			if err = r.Request.ParseForm(); err != nil {
-				panic(gerror.WrapCode(gcode.CodeInvalidRequest, err, "r.Request.ParseForm failed"))
+				panic(wrapRequestBodyError(err, "r.Request.ParseForm failed"))
 			}
 		}
 		if len(r.PostForm) > 0 {
@@ -328,7 +416,7 @@ func (r *Request) parseForm() {
 				}
 			}
 			if params != "" {
-				if r.formMap, err = gstr.Parse(params); err != nil {
+				if r.formMap, err = parseParams(params); err != nil {
 					panic(gerror.WrapCode(gcode.CodeInvalidParameter, err, "Parse request parameters failed"))
 				}
 			}
@@ -345,6 +433,34 @@ func (r *Request) parseForm() {
 	}
 }
 
+// wrapRequestBodyError wraps `err` occurred in reading or parsing request body with error code,
+// which is gcode.CodeRequestTooLarge if the body exceeds the size limit, or else gcode.CodeInvalidRequest
+// for malformed body, eg: truncated multipart body.
+func wrapRequestBodyError(err error, text string) error {
+	// The error naming the exceeded limit, eg: from MiddlewareDecompress.
+	if gerror.HasCode(err, gcode.CodeRequestTooLarge) {
+		return gerror.WrapCode(gcode.CodeRequestTooLarge, err, text)
+	}
+	var maxBytesErr *http.MaxBytesError
+	if gerror.As(err, &maxBytesErr) {
+		return gerror.WrapCodef(
+			gcode.CodeRequestTooLarge, err,
+			`%s, request body exceeds the size limit of %d bytes`, text, maxBytesErr.Limit,
+		)
+	}
+	if gerror.Is(err, multipart.ErrMessageTooLarge) {
+		return gerror.WrapCodef(gcode.CodeRequestTooLarge, err, `%s, multipart message too large`, text)
+	}
+	return gerror.WrapCode(gcode.CodeInvalidRequest, err, text)
+}
+
+// isRequestBodyTooLarge checks and returns whether `err` is caused by request body exceeding the size limit.
+func isRequestBodyTooLarge(err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	return gerror.As(err, &maxBytesErr) || gerror.Is(err, multipart.ErrMessageTooLarge) ||
+		gerror.HasCode(err, gcode.CodeRequestTooLarge)
+}
+
 // GetMultipartForm parses and returns the form as multipart forms.
 func (r *Request) GetMultipartForm() *multipart.Form {
 	r.parseForm()
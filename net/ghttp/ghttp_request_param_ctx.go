@@ -10,6 +10,7 @@ import (
 	"context"
 
 	"github.com/gogf/gf/v2/container/gvar"
+	"github.com/gogf/gf/v2/net/gclient"
 	"github.com/gogf/gf/v2/os/gctx"
 )
 
@@ -52,6 +53,13 @@ func (r *Request) GetNeverDoneCtx() context.Context {
 	return gctx.NeverDone(r.Context())
 }
 
+// Client creates and returns a new HTTP client bound to the context of current request,
+// which propagates the cancellation, deadline and tracing information of current request
+// to the upstream requests of the client, even if another context is passed to the requests.
+func (r *Request) Client() *gclient.Client {
+	return gclient.New().BindContext(r.Context())
+}
+
 // SetCtx custom context for current request.
 func (r *Request) SetCtx(ctx context.Context) {
 	*r.Request = *r.WithContext(ctx)
@@ -145,6 +145,9 @@ func (r *Request) doGetQueryStruct(pointer any, mapping ...map[string]string) (d
 	if data == nil {
 		data = map[string]any{}
 	}
+	if err = r.mergeStyleTagStructValue(data, pointer); err != nil {
+		return data, nil
+	}
 	if err = r.mergeDefaultStructValue(data, pointer); err != nil {
 		return data, nil
 	}
@@ -183,6 +183,11 @@ func (r *Request) doGetRequestStruct(pointer any, mapping ...map[string]string)
 		return data, nil
 	}
 
+	// `style` Tag query array values.
+	if err = r.mergeStyleTagStructValue(data, pointer); err != nil {
+		return data, nil
+	}
+
 	// Default struct values.
 	if err = r.mergeDefaultStructValue(data, pointer); err != nil {
 		return data, nil
@@ -0,0 +1,117 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/gogf/gf/v2/os/gstructs"
+	"github.com/gogf/gf/v2/util/gconv"
+	"github.com/gogf/gf/v2/util/gtag"
+)
+
+// Query parameter serialization styles for array, which are the same as OpenAPI.
+const (
+	ParamStyleForm           = "form"           // ids=1&ids=2 if exploded, or else ids=1,2.
+	ParamStyleSpaceDelimited = "spaceDelimited" // ids=1%202.
+	ParamStylePipeDelimited  = "pipeDelimited"  // ids=1|2.
+)
+
+// paramStyle is the parsed serialization style of array parameter.
+type paramStyle struct {
+	Delimiter string // Delimiter for values in one parameter, which is empty if exploded.
+}
+
+// mergeStyleTagStructValue merges the query array parameters into `data` according to the `style`
+// and `explode` tags of struct fields, eg:
+//
+//	Ids []int `json:"ids" style:"form,explode"`            // ids=1&ids=2&ids=3
+//	Ids []int `json:"ids" style:"form" explode:"false"`     // ids=1,2,3
+//	Ids []int `json:"ids" style:"spaceDelimited"`          // ids=1%202%203
+//	Ids []int `json:"ids" style:"pipeDelimited"`           // ids=1|2|3
+//
+// The exploded parameters like `ids=1&ids=2` are always accepted for the fields having `style` tag.
+// The fields without `style` tag keep the default parsing behavior.
+func (r *Request) mergeStyleTagStructValue(data map[string]any, pointer any) error {
+	var fields []gstructs.Field
+	if r.serveHandler != nil && len(r.serveHandler.Handler.Info.ReqStructFields) > 0 {
+		fields = r.serveHandler.Handler.Info.ReqStructFields
+	} else {
+		var err error
+		if fields, err = gstructs.TagFields(pointer, []string{gtag.Style}); err != nil {
+			return err
+		}
+	}
+	var queryValues = r.URL.Query()
+	for _, field := range fields {
+		tagValue := field.Tag(gtag.Style)
+		if tagValue == "" {
+			continue
+		}
+		switch field.OriginalKind() {
+		case reflect.Slice, reflect.Array:
+		default:
+			continue
+		}
+		var (
+			style  = parseParamStyle(tagValue, field.Tag(gtag.Explode))
+			name   = strings.TrimSpace(strings.Split(field.TagPriorityName(), ",")[0])
+			values = queryValues[name]
+		)
+		if len(values) == 0 {
+			continue
+		}
+		var array = make([]string, 0, len(values))
+		for _, value := range values {
+			if style.Delimiter == "" {
+				array = append(array, value)
+				continue
+			}
+			for _, item := range strings.Split(value, style.Delimiter) {
+				if item != "" {
+					array = append(array, item)
+				}
+			}
+		}
+		mergeTagValueWithFoundKey(data, true, name, field.Name(), array)
+	}
+	return nil
+}
+
+// parseParamStyle parses the `style` and `explode` tag values into paramStyle.
+// The `explode` can also be specified in `style` tag, eg: `style:"form,explode"`.
+// As OpenAPI defines, the `explode` is true by default only for style `form`.
+func parseParamStyle(styleTag, explodeTag string) paramStyle {
+	var (
+		items   = strings.Split(styleTag, ",")
+		name    = strings.TrimSpace(items[0])
+		explode = name == "" || name == ParamStyleForm
+	)
+	for _, item := range items[1:] {
+		switch item = strings.TrimSpace(item); {
+		case item == gtag.Explode:
+			explode = true
+		case strings.HasPrefix(item, gtag.Explode+"="):
+			explode = gconv.Bool(strings.TrimPrefix(item, gtag.Explode+"="))
+		}
+	}
+	if explodeTag != "" {
+		explode = gconv.Bool(explodeTag)
+	}
+	if explode {
+		return paramStyle{}
+	}
+	switch name {
+	case ParamStyleSpaceDelimited:
+		return paramStyle{Delimiter: " "}
+	case ParamStylePipeDelimited:
+		return paramStyle{Delimiter: "|"}
+	default:
+		return paramStyle{Delimiter: ","}
+	}
+}
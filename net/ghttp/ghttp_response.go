@@ -24,9 +24,10 @@ import (
 // Response is the http response manager.
 // Note that it implements the http.ResponseWriter interface with buffering feature.
 type Response struct {
-	*response.BufferWriter          // Underlying ResponseWriter.
-	Server                 *Server  // Parent server.
-	Request                *Request // According request.
+	*response.BufferWriter                   // Underlying ResponseWriter.
+	Server                 *Server           // Parent server.
+	Request                *Request          // According request.
+	trailers               map[string]string // Trailers that are sent after the response body.
 }
 
 // newResponse creates and returns a new Response object.
@@ -126,16 +127,54 @@ func (r *Response) RedirectBack(code ...int) {
 // handles If-Match, If-Unmodified-Since, If-None-Match, If-Modified-Since,
 // and If-Range requests.
 //
+// It is usually used by handlers serving generated or remote content,
+// eg: media content from object storage that needs seeking support.
+// Note that the buffered content is cleared, and the cookies and common headers
+// are output along with the header of the content.
+//
 // See http.ServeContent
 func (r *Response) ServeContent(name string, modTime time.Time, content io.ReadSeeker) {
-	http.ServeContent(r.RawWriter(), r.Request.Request, name, modTime, content)
+	// The buffered content cannot be mixed with the content ranges.
+	r.ClearBuffer()
+	http.ServeContent(contentWriter{r}, r.Request.Request, name, modTime, content)
+}
+
+// contentWriter is the writer for ServeContent writing directly to the client,
+// which keeps the response status and headers consistent with the buffered response.
+type contentWriter struct {
+	*Response
+}
+
+// WriteHeader records the status and outputs the common headers and cookies
+// before writing the header to the client.
+func (w contentWriter) WriteHeader(status int) {
+	if w.IsHeaderWrote() {
+		return
+	}
+	w.Status = status
+	w.setCommonHeaders()
+	w.Request.Cookie.Flush()
+	w.RawWriter().WriteHeader(status)
+}
+
+// Write writes the content directly to the client.
+func (w contentWriter) Write(data []byte) (int, error) {
+	if !w.IsHeaderWrote() {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.RawWriter().Write(data)
 }
 
 // Flush outputs the buffer content to the client and clears the buffer.
 func (r *Response) Flush() {
+	r.setCommonHeaders()
+	r.BufferWriter.Flush()
+}
+
+// setCommonHeaders sets the headers that are output for every response.
+func (r *Response) setCommonHeaders() {
 	r.Header().Set(responseHeaderTraceID, gtrace.GetTraceID(r.Request.Context()))
 	if r.Server.config.ServerAgent != "" {
 		r.Header().Set("Server", r.Server.config.ServerAgent)
 	}
-	r.BufferWriter.Flush()
 }
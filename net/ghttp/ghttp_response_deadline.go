@@ -0,0 +1,28 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+//
+
+package ghttp
+
+import (
+	"net/http"
+	"time"
+)
+
+// SetWriteDeadline sets the deadline for writing the response of current request, which overrides
+// the server WriteTimeout for the request. It can be used by the long-running handlers to extend
+// their deadline, eg: report generation, or by the others to shorten it. A zero value for `t`
+// means writing does not time out.
+//
+// For HTTP/1.x, it sets the write deadline of the underlying connection, which is reset by the
+// server WriteTimeout for the next request on the same connection. For HTTP/2, it sets the deadline
+// of the request stream only, and the other streams on the same connection are not affected.
+//
+// It should be called before the response is flushed, and it returns an error wrapping
+// http.ErrNotSupported if the underlying writer does not support it, eg: hijacked connection.
+func (r *Response) SetWriteDeadline(t time.Time) error {
+	return http.NewResponseController(r.RawWriter()).SetWriteDeadline(t)
+}
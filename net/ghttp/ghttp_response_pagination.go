@@ -0,0 +1,61 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+//
+
+package ghttp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PaginationPageName is the query parameter name of page number in the pagination links.
+const PaginationPageName = "page"
+
+// SetPaginationLinks computes the pagination links for page `current` of `total` items in `pageSize`
+// and sets them as the `Link` header defined by RFC 5988, eg:
+//
+//	Link: <http://127.0.0.1/list?page=1&size=10>; rel="first", <http://127.0.0.1/list?page=2&size=10>; rel="prev",
+//	      <http://127.0.0.1/list?page=4&size=10>; rel="next", <http://127.0.0.1/list?page=9&size=10>; rel="last"
+//
+// The links are built from the URL of current request, which keep the existing query parameters and only
+// add or replace the page parameter named PaginationPageName. The "first" and "last" links are always set,
+// while the "prev" and "next" links are set only if the corresponding page exists. It does nothing if the
+// `pageSize` is not positive.
+func (r *Response) SetPaginationLinks(current, pageSize, total int) {
+	if pageSize <= 0 {
+		return
+	}
+	var totalPages = (total + pageSize - 1) / pageSize
+	if totalPages < 1 {
+		totalPages = 1
+	}
+	if current < 1 {
+		current = 1
+	}
+	var links = make([]string, 0, 4)
+	links = append(links, r.buildPaginationLink(1, "first"))
+	if current > 1 {
+		links = append(links, r.buildPaginationLink(min(current-1, totalPages), "prev"))
+	}
+	if current < totalPages {
+		links = append(links, r.buildPaginationLink(current+1, "next"))
+	}
+	links = append(links, r.buildPaginationLink(totalPages, "last"))
+	r.Header().Set("Link", strings.Join(links, ", "))
+}
+
+// buildPaginationLink builds the link of `page` with relation type `rel` from current request URL.
+func (r *Response) buildPaginationLink(page int, rel string) string {
+	var (
+		url    = *r.Request.URL
+		values = url.Query()
+	)
+	values.Set(PaginationPageName, strconv.Itoa(page))
+	url.RawQuery = values.Encode()
+	return fmt.Sprintf(`<%s://%s%s>; rel="%s"`, r.Request.GetSchema(), r.Request.Host, url.RequestURI(), rel)
+}
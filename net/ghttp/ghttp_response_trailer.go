@@ -0,0 +1,44 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+//
+
+package ghttp
+
+import (
+	"net/http"
+)
+
+// SetTrailer sets the trailer `key` with `value`, which is sent to the client after the response body.
+//
+// The trailer is announced using the `Trailer` header if the response header is not sent yet,
+// and its value is set after the response body is output. It can be called multiple times for the
+// same key, and the last value is used. It is usually used by handlers that stream the response
+// and flush it manually, eg: sending the checksum of streamed content.
+//
+// Note that trailers are only supported by chunked HTTP/1.1 and HTTP/2 responses,
+// which means they are dropped if `Content-Length` header is specified manually.
+func (r *Response) SetTrailer(key, value string) {
+	key = http.CanonicalHeaderKey(key)
+	if r.trailers == nil {
+		r.trailers = make(map[string]string)
+	}
+	if _, ok := r.trailers[key]; !ok && !r.IsHeaderWrote() {
+		r.Header().Add("Trailer", key)
+	}
+	r.trailers[key] = value
+}
+
+// flushTrailers sets the trailer values to the response after the response body is output.
+// The http server sends the trailers after the handler returns.
+func (r *Response) flushTrailers() {
+	if len(r.trailers) == 0 || r.IsHijacked() {
+		return
+	}
+	var header = r.Header()
+	for key, value := range r.trailers {
+		header.Set(http.TrailerPrefix+key, value)
+	}
+}
@@ -8,6 +8,7 @@
 package ghttp
 
 import (
+	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/os/gcfg"
 	"github.com/gogf/gf/v2/os/gview"
 	"github.com/gogf/gf/v2/util/gconv"
@@ -62,12 +63,21 @@ func (r *Response) WriteTplContent(content string, params ...gview.Params) error
 
 // ParseTpl parses given template file `tpl` with given template variables `params`
 // and returns the parsed template content.
+// It uses the custom template engine of the server if it is set.
 func (r *Response) ParseTpl(tpl string, params ...gview.Params) (string, error) {
+	if engine := r.getViewEngine(); engine != nil {
+		return r.renderWithViewEngine(engine, tpl, params...)
+	}
 	return r.Request.GetView().Parse(r.Request.Context(), tpl, r.buildInVars(params...))
 }
 
 // ParseTplDefault parses the default template file with params.
+// It uses the custom template engine of the server if it is set, which renders the default
+// template file of the gview object.
 func (r *Response) ParseTplDefault(params ...gview.Params) (string, error) {
+	if engine := r.getViewEngine(); engine != nil {
+		return r.renderWithViewEngine(engine, r.Request.GetView().GetDefaultFile(), params...)
+	}
 	return r.Request.GetView().ParseDefault(r.Request.Context(), r.buildInVars(params...))
 }
 
@@ -77,6 +87,24 @@ func (r *Response) ParseTplContent(content string, params ...gview.Params) (stri
 	return r.Request.GetView().ParseContent(r.Request.Context(), content, r.buildInVars(params...))
 }
 
+// getViewEngine returns the custom template engine for the response,
+// which is nil if there's custom gview object for the request.
+func (r *Response) getViewEngine() ViewEngine {
+	if r.Request.viewObject != nil {
+		return nil
+	}
+	return r.Server.config.ViewEngine
+}
+
+// renderWithViewEngine renders template `name` using the custom template engine `engine`.
+func (r *Response) renderWithViewEngine(engine ViewEngine, name string, params ...gview.Params) (string, error) {
+	b, err := engine.Render(r.Request.Context(), name, r.buildInVars(params...))
+	if err != nil {
+		return "", gerror.Wrapf(err, `render template "%s" failed`, name)
+	}
+	return string(b), nil
+}
+
 // buildInVars merges build-in variables into `params` and returns the new template variables.
 // TODO performance improving.
 func (r *Response) buildInVars(params ...map[string]any) map[string]any {
@@ -125,80 +125,12 @@ func GetServer(name ...any) *Server {
 func (s *Server) Start() error {
 	var ctx = gctx.GetInitCtx()
 
-	// Swagger UI.
-	if s.config.SwaggerPath != "" {
-		swaggerui.Init()
-		s.AddStaticPath(s.config.SwaggerPath, swaggerUIPackedPath)
-		s.BindHookHandler(s.config.SwaggerPath+"/*", HookBeforeServe, s.swaggerUI)
-	}
-
-	// OpenApi specification json producing handler.
-	if s.config.OpenApiPath != "" {
-		s.BindHandler(s.config.OpenApiPath, s.openapiSpec)
-	}
-
-	// Register group routes.
-	s.handlePreBindItems(ctx)
-
-	// Server process initialization, which can only be initialized once.
-	serverProcessInit()
-
 	// Server can only be run once.
 	if s.Status() == ServerStatusRunning {
 		return gerror.NewCode(gcode.CodeInvalidOperation, "server is already running")
 	}
-
-	// Logging path setting check.
-	if s.config.LogPath != "" && s.config.LogPath != s.config.Logger.GetPath() {
-		if err := s.config.Logger.SetPath(s.config.LogPath); err != nil {
-			return err
-		}
-	}
-	// Default session storage.
-	if s.config.SessionStorage == nil {
-		sessionStoragePath := ""
-		if s.config.SessionPath != "" {
-			sessionStoragePath = gfile.Join(s.config.SessionPath, s.config.Name)
-			if !gfile.Exists(sessionStoragePath) {
-				if err := gfile.Mkdir(sessionStoragePath); err != nil {
-					return gerror.Wrapf(err, `mkdir failed for "%s"`, sessionStoragePath)
-				}
-			}
-		}
-		s.config.SessionStorage = gsession.NewStorageFile(sessionStoragePath, s.config.SessionMaxAge)
-	}
-	// Initialize session manager when start running.
-	s.sessionManager = gsession.New(
-		s.config.SessionMaxAge,
-		s.config.SessionStorage,
-	)
-
-	// PProf feature.
-	if s.config.PProfEnabled {
-		s.EnablePProf(s.config.PProfPattern)
-	}
-
-	// Default HTTP handler.
-	if s.config.Handler == nil {
-		s.config.Handler = s.ServeHTTP
-	}
-
-	// Install external plugins.
-	for _, p := range s.plugins {
-		if err := p.Install(s); err != nil {
-			s.Logger().Fatalf(ctx, `%+v`, err)
-		}
-	}
-	// Check the group routes again for internally registered routes.
-	s.handlePreBindItems(ctx)
-
-	// If there's no route registered and no static service enabled,
-	// it then returns an error of invalid usage of server.
-	if len(s.routesMap) == 0 && !s.config.FileServerEnabled {
-		return gerror.NewCode(
-			gcode.CodeInvalidOperation,
-			`there's no route set or static feature enabled, did you forget import the router?`,
-		)
+	if err := s.prepareServe(ctx); err != nil {
+		return err
 	}
 	// ================================================================================================
 	// Start the HTTP server.
@@ -268,6 +200,86 @@ func (s *Server) Start() error {
 	return nil
 }
 
+// prepareServe initializes the server for serving requests, which registers the routes and
+// initializes the session manager, handler and plugins, but does not listen on any address.
+func (s *Server) prepareServe(ctx context.Context) error {
+	// Swagger UI.
+	if s.config.SwaggerPath != "" {
+		swaggerui.Init()
+		s.AddStaticPath(s.config.SwaggerPath, swaggerUIPackedPath)
+		s.BindHookHandler(s.config.SwaggerPath+"/*", HookBeforeServe, s.swaggerUI)
+	}
+
+	// OpenApi specification json producing handler.
+	if s.config.OpenApiPath != "" {
+		s.BindHandler(s.config.OpenApiPath, s.openapiSpec)
+	}
+
+	// Register group routes.
+	s.handlePreBindItems(ctx)
+
+	// Server process initialization, which can only be initialized once.
+	serverProcessInit()
+
+	// Logging path setting check.
+	if s.config.LogPath != "" && s.config.LogPath != s.config.Logger.GetPath() {
+		if err := s.config.Logger.SetPath(s.config.LogPath); err != nil {
+			return err
+		}
+	}
+	// Default cookie options check.
+	if err := s.GetCookieOptions().Validate(); err != nil {
+		return err
+	}
+	// Default session storage.
+	if s.config.SessionStorage == nil {
+		sessionStoragePath := ""
+		if s.config.SessionPath != "" {
+			sessionStoragePath = gfile.Join(s.config.SessionPath, s.config.Name)
+			if !gfile.Exists(sessionStoragePath) {
+				if err := gfile.Mkdir(sessionStoragePath); err != nil {
+					return gerror.Wrapf(err, `mkdir failed for "%s"`, sessionStoragePath)
+				}
+			}
+		}
+		s.config.SessionStorage = gsession.NewStorageFile(sessionStoragePath, s.config.SessionMaxAge)
+	}
+	// Initialize session manager when start running.
+	s.sessionManager = gsession.New(
+		s.config.SessionMaxAge,
+		s.config.SessionStorage,
+	)
+
+	// PProf feature.
+	if s.config.PProfEnabled {
+		s.EnablePProf(s.config.PProfPattern)
+	}
+
+	// Default HTTP handler.
+	if s.config.Handler == nil {
+		s.config.Handler = s.ServeHTTP
+	}
+
+	// Install external plugins.
+	for _, p := range s.plugins {
+		if err := p.Install(s); err != nil {
+			s.Logger().Fatalf(ctx, `%+v`, err)
+		}
+	}
+	// Check the group routes again for internally registered routes.
+	s.handlePreBindItems(ctx)
+
+	// If there's no route registered and no static service enabled,
+	// it then returns an error of invalid usage of server.
+	if len(s.routesMap) == 0 && !s.config.FileServerEnabled {
+		return gerror.NewCode(
+			gcode.CodeInvalidOperation,
+			`there's no route set or static feature enabled, did you forget import the router?`,
+		)
+	}
+	return nil
+}
+
 func (s *Server) getLocalListenedAddress() string {
 	return fmt.Sprintf(`http://127.0.0.1:%d`, s.GetListenedPort())
 }
@@ -358,6 +370,8 @@ func (s *Server) GetOpenApi() *goai.OpenApiV3 {
 
 // GetRoutes retrieves and returns the router array.
 func (s *Server) GetRoutes() []RouterItem {
+	s.routerMu.RLock()
+	defer s.routerMu.RUnlock()
 	var (
 		m              = make(map[string]*garray.SortedArray)
 		routeFilterSet = gset.NewStrSet()
@@ -571,6 +585,9 @@ func (s *Server) startServer(fdMap listenerFdMap) {
 			s.servers = append(s.servers, s.newGracefulServer(itemFunc, 0))
 		}
 	}
+	// Additional listeners.
+	s.startListeners(fdMap)
+
 	// Start listening asynchronously.
 	serverRunning.Add(1)
 	var wg = &sync.WaitGroup{}
@@ -633,7 +650,15 @@ func (s *Server) getListenerFdMap() map[string]string {
 		"https": "",
 		"http":  "",
 	}
+	for _, item := range s.listeners {
+		if item.Server != nil {
+			m[listenerFdMapKeyPrefix+item.Option.Name] = item.Server.GetAddress() + "#" + gconv.String(item.Server.Fd())
+		}
+	}
 	for _, v := range s.servers {
+		if s.isListenerServer(v) {
+			continue
+		}
 		str := v.GetAddress() + "#" + gconv.String(v.Fd()) + ","
 		if v.IsHttps() {
 			if len(m["https"]) > 0 {
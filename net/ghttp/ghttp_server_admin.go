@@ -91,6 +91,8 @@ func (s *Server) EnableAdmin(pattern ...string) {
 // Shutdown shuts the current server.
 func (s *Server) Shutdown() error {
 	var ctx = context.TODO()
+	// Report of in-flight requests at shutdown start.
+	s.handleShutdownReport(ctx)
 	// Remove plugins.
 	if len(s.plugins) > 0 {
 		for _, p := range s.plugins {
@@ -0,0 +1,52 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gogf/gf/v2/internal/intlog"
+)
+
+// StatusClientClosedRequest is the non-standard status for the request whose client disconnects
+// before the response is sent, which follows the convention of nginx.
+const StatusClientClosedRequest = 499
+
+// SetClientDisconnectAbort enables or disables aborting the request early if its client disconnects,
+// which skips the remaining middleware and handlers that are not called yet. The running handler
+// is not interrupted, which can check Request.IsClientDisconnected or the request context itself.
+func (s *Server) SetClientDisconnectAbort(enabled bool) {
+	s.config.ClientDisconnectAbort = enabled
+}
+
+// IsClientDisconnected checks and returns whether the client of the request disconnects, which is
+// detected by the cancellation of the original request context created by the underlying server.
+// The context replaced by SetCtx or canceled by deadline is not considered client disconnection.
+//
+// The request whose client disconnects is logged with status StatusClientClosedRequest in access log,
+// and its error is not logged as server error, as the response cannot be delivered anyway.
+func (r *Request) IsClientDisconnected() bool {
+	if r.clientCtx == nil {
+		return false
+	}
+	return errors.Is(r.clientCtx.Err(), context.Canceled)
+}
+
+// handleClientDisconnect classifies the request whose client disconnects with status
+// StatusClientClosedRequest. It returns true if the client of request disconnects.
+// It is only called for the request having no status written or having error.
+func (s *Server) handleClientDisconnect(r *Request) bool {
+	if !r.IsClientDisconnected() {
+		return false
+	}
+	r.Response.WriteHeader(StatusClientClosedRequest)
+	if r.error != nil {
+		intlog.Printf(r.Context(), `client disconnected: %s %s: %v`, r.Method, r.URL.Path, r.error)
+	}
+	return true
+}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"strconv"
@@ -83,6 +84,15 @@ type ServerConfig struct {
 	// Handler the handler for HTTP request.
 	Handler func(w http.ResponseWriter, r *http.Request) `json:"-"`
 
+	// ConnStateHandler specifies an optional callback function that is called when a client
+	// connection changes state, which is usually used for connection tracking and diagnostics.
+	// See http.ConnState for details of the states.
+	ConnStateHandler func(conn net.Conn, state http.ConnState) `json:"-"`
+
+	// ContextHeaderBindings specifies the bindings from request headers to context values,
+	// which are applied for every request before any hook or middleware is called.
+	ContextHeaderBindings []HeaderBinding `json:"-"`
+
 	// ReadTimeout is the maximum duration for reading the entire
 	// request, including the body.
 	//
@@ -111,11 +121,29 @@ type ServerConfig struct {
 	//
 	// It can be configured in configuration file using string like: 1m, 10m, 500kb etc.
 	// It's 10240 bytes in default.
+	//
+	// Note that the underlying net/http server allows some more bytes than MaxHeaderBytes,
+	// so it is also checked strictly by the server before request handling, which responds
+	// status 431 if exceeded.
 	MaxHeaderBytes int `json:"maxHeaderBytes"`
 
+	// MaxHeaderCount controls the maximum count of the request header values.
+	// The server responds status 431 if exceeded. It's 0 in default, which means no limit.
+	MaxHeaderCount int `json:"maxHeaderCount"`
+
 	// KeepAlive enables HTTP keep-alive.
 	KeepAlive bool `json:"keepAlive"`
 
+	// ProxyProtocol enables accepting PROXY protocol v1/v2 header for each connection,
+	// which is usually sent by the load balancer in front of the server.
+	// The source address in header is used as the remote address of request.
+	// The connection without valid PROXY protocol header is rejected if it is enabled.
+	ProxyProtocol bool `json:"proxyProtocol"`
+
+	// ProxyProtocolTrustedUpstreams specifies the IP or CIDR of upstreams that are allowed to
+	// connect when ProxyProtocol is enabled, like: 10.0.0.0/8. All upstreams are allowed if it is empty.
+	ProxyProtocolTrustedUpstreams []string `json:"proxyProtocolTrustedUpstreams"`
+
 	// ServerAgent specifies the server agent information, which is wrote to
 	// HTTP response header as "Server".
 	ServerAgent string `json:"serverAgent"`
@@ -123,6 +151,10 @@ type ServerConfig struct {
 	// View specifies the default template view object for the server.
 	View *gview.View `json:"view"`
 
+	// ViewEngine specifies the custom template engine for the server, which renders the template files
+	// in replace of View if it is set.
+	ViewEngine ViewEngine `json:"-"`
+
 	// ======================================================================================================
 	// Static.
 	// ======================================================================================================
@@ -137,6 +169,14 @@ type ServerConfig struct {
 	// The server responses HTTP status code 403 if it is false.
 	IndexFolder bool `json:"indexFolder"`
 
+	// DirectoryListingDisabled specifies whether disabling the directory listing entirely, which responses
+	// HTTP status code 403 for directories even if IndexFolder is true or index is allowed by ServeFile.
+	DirectoryListingDisabled bool `json:"directoryListingDisabled"`
+
+	// DirectoryListingRenderer specifies the custom renderer for directory listing.
+	// See SetDirectoryListingRenderer.
+	DirectoryListingRenderer DirectoryListingRenderer `json:"-"`
+
 	// ServerRoot specifies the root directory for static service.
 	ServerRoot string `json:"serverRoot"`
 
@@ -150,6 +190,16 @@ type ServerConfig struct {
 	// It is automatically set enabled if any static path is set.
 	FileServerEnabled bool `json:"fileServerEnabled"`
 
+	// StaticCacheControl specifies the "Cache-Control" header value for static files,
+	// which is a map from URL path pattern to header value, like:
+	// {"*.js": "public, max-age=31536000, immutable", "/index.html": "no-cache"}.
+	StaticCacheControl map[string]string `json:"staticCacheControl"`
+
+	// StaticFallbacks specifies the fallback static files for missing paths under URI roots,
+	// which is a map from URI root to the URI of fallback file, like: {"/app": "/app/index.html"}.
+	// It is usually used for the history mode routing of single page application.
+	StaticFallbacks map[string]string `json:"staticFallbacks"`
+
 	// ======================================================================================================
 	// Cookie.
 	// ======================================================================================================
@@ -177,6 +227,14 @@ type ServerConfig struct {
 	// It also affects the default storage for session id.
 	CookieHttpOnly bool `json:"cookieHttpOnly"`
 
+	// CookiePartitioned specifies cookie Partitioned property, which requires CookieSecure.
+	// It also affects the default storage for session id.
+	CookiePartitioned bool `json:"cookiePartitioned"`
+
+	// CookiePriority specifies cookie Priority property, which is one of "low", "medium" and "high".
+	// It also affects the default storage for session id.
+	CookiePriority string `json:"cookiePriority"`
+
 	// ======================================================================================================
 	// Session.
 	// ======================================================================================================
@@ -215,6 +273,32 @@ type ServerConfig struct {
 	AccessLogEnabled bool         `json:"accessLogEnabled"` // AccessLogEnabled enables access logging content to files.
 	AccessLogPattern string       `json:"accessLogPattern"` // AccessLogPattern specifies the error log file pattern like: access-{Ymd}.log
 
+	// SlowRequestThreshold specifies the latency threshold for slow request logging.
+	// The request whose latency exceeds the threshold is logged at WARN level with its
+	// matched route, status and latency. It is disabled if it is 0.
+	SlowRequestThreshold time.Duration `json:"slowRequestThreshold"`
+
+	// AccessLogSampler specifies the sampling function for access logging, which returns whether
+	// the request should be logged. The request having error is always logged regardless of it.
+	AccessLogSampler func(r *Request) bool `json:"-"`
+
+	// AccessLogger specifies the dedicated logger for access logging, which has its own path, file pattern
+	// and rotation configurations separated from Logger. The configurations AccessLogPattern, AccessLogWriter
+	// and AccessLogRotate* are not applied to it. It uses a clone of Logger if it is nil.
+	AccessLogger *glog.Logger `json:"accessLogger"`
+
+	// AccessLogWriter specifies the writer for access logging content, which replaces the access log files.
+	AccessLogWriter io.Writer `json:"-"`
+
+	// AccessLogRotateSize rotates the access log file if its size exceeds this value in bytes.
+	// It can be configured in configuration file using string like: 1m, 10m, 500kb etc.
+	// The access log file is rotated daily by the default AccessLogPattern `access-{Ymd}.log`.
+	AccessLogRotateSize int64 `json:"accessLogRotateSize"`
+
+	// AccessLogRotateBackupLimit specifies the max count of the rotated access log files to keep.
+	// It's 0 in default, which means no backups.
+	AccessLogRotateBackupLimit int `json:"accessLogRotateBackupLimit"`
+
 	// ======================================================================================================
 	// PProf.
 	// ======================================================================================================
@@ -243,6 +327,15 @@ type ServerConfig struct {
 	// GracefulShutdownTimeout set the maximum survival time (seconds) before stopping the server.
 	GracefulShutdownTimeout int `json:"gracefulShutdownTimeout"`
 
+	// ShutdownReport enables the report of in-flight requests at shutdown start, which is retrieved
+	// by GetShutdownReport. It is also enabled if ShutdownHandler is set.
+	// The in-flight requests are not tracked if it is disabled, which is the default.
+	ShutdownReport bool `json:"shutdownReport"`
+
+	// ShutdownHandler specifies an optional callback function that is called with the report of
+	// in-flight requests at shutdown start, which is useful for tuning the shutdown timeout.
+	ShutdownHandler func(ctx context.Context, report *ShutdownReport) `json:"-"`
+
 	// ======================================================================================================
 	// Other.
 	// ======================================================================================================
@@ -252,6 +345,12 @@ type ServerConfig struct {
 	// It's `8MB` in default.
 	ClientMaxBodySize int64 `json:"clientMaxBodySize"`
 
+	// MaxDecompressedBodySize specifies the max decompressed body size limit in bytes for compressed client
+	// request, which is enforced by MiddlewareDecompress, while ClientMaxBodySize limits the compressed size.
+	// It can be configured in configuration file using string like: 1m, 10m, 500kb etc.
+	// It uses ClientMaxBodySize if it is not set.
+	MaxDecompressedBodySize int64 `json:"maxDecompressedBodySize"`
+
 	// FormParsingMemory specifies max memory buffer size in bytes which can be used for
 	// parsing multimedia form.
 	// It can be configured in configuration file using string like: 1m, 10m, 500kb etc.
@@ -267,6 +366,29 @@ type ServerConfig struct {
 
 	// DumpRouterMap specifies whether automatically dumps router map when server starts.
 	DumpRouterMap bool `json:"dumpRouterMap"`
+
+	// NotFoundDetail specifies whether rendering the closest routes for request matching no route,
+	// which takes effect only in DEVELOP mode. See SetNotFoundDetail.
+	NotFoundDetail bool `json:"notFoundDetail"`
+
+	// MethodOverride specifies whether overriding the method of POST request using header
+	// `X-HTTP-Method-Override` or form field `_method` before routing. See SetMethodOverride.
+	MethodOverride bool `json:"methodOverride"`
+
+	// ClientDisconnectAbort specifies whether skipping the remaining middleware and handlers of the request
+	// if its client disconnects. See SetClientDisconnectAbort.
+	ClientDisconnectAbort bool `json:"clientDisconnectAbort"`
+
+	// ResponseValidation specifies whether validating the JSON response body of strict route handler against
+	// its declared response type, which takes effect only in DEVELOP mode. See EnableResponseValidation.
+	ResponseValidation bool `json:"responseValidation"`
+
+	// ErrorStatusMapping specifies whether responding the request error with http status mapped from its
+	// error code by the default error handling, eg: status 400 for gcode.CodeInvalidRequest and status 413
+	// for gcode.CodeRequestTooLarge. The request error is responded with status 500 if it is disabled,
+	// which is the default. Note that the validation error of gcode.CodeValidationFailed is always responded
+	// with status 400 despite this configuration. See SetErrorStatusMapping.
+	ErrorStatusMapping bool `json:"errorStatusMapping"`
 }
 
 // NewConfig creates and returns a ServerConfig object with default configurations.
@@ -340,9 +462,15 @@ func (s *Server) SetConfigWithMap(m map[string]any) error {
 	if k, v := gutil.MapPossibleItemByKey(m, "ClientMaxBodySize"); k != "" {
 		m[k] = gfile.StrToSize(gconv.String(v))
 	}
+	if k, v := gutil.MapPossibleItemByKey(m, "MaxDecompressedBodySize"); k != "" {
+		m[k] = gfile.StrToSize(gconv.String(v))
+	}
 	if k, v := gutil.MapPossibleItemByKey(m, "FormParsingMemory"); k != "" {
 		m[k] = gfile.StrToSize(gconv.String(v))
 	}
+	if k, v := gutil.MapPossibleItemByKey(m, "AccessLogRotateSize"); k != "" {
+		m[k] = gfile.StrToSize(gconv.String(v))
+	}
 	if _, v := gutil.MapPossibleItemByKey(m, "Logger"); v == nil {
 		intlog.Printf(context.TODO(), "SetConfigWithMap: set Logger nil")
 	}
@@ -521,6 +649,11 @@ func (s *Server) SetMaxHeaderBytes(b int) {
 	s.config.MaxHeaderBytes = b
 }
 
+// SetMaxHeaderCount sets the MaxHeaderCount for the server.
+func (s *Server) SetMaxHeaderCount(count int) {
+	s.config.MaxHeaderCount = count
+}
+
 // SetServerAgent sets the ServerAgent for the server.
 func (s *Server) SetServerAgent(agent string) {
 	s.config.ServerAgent = agent
@@ -531,6 +664,16 @@ func (s *Server) SetKeepAlive(enabled bool) {
 	s.config.KeepAlive = enabled
 }
 
+// SetProxyProtocol sets the ProxyProtocol for the server.
+func (s *Server) SetProxyProtocol(enabled bool) {
+	s.config.ProxyProtocol = enabled
+}
+
+// SetProxyProtocolTrustedUpstreams sets the ProxyProtocolTrustedUpstreams for the server.
+func (s *Server) SetProxyProtocolTrustedUpstreams(upstreams []string) {
+	s.config.ProxyProtocolTrustedUpstreams = upstreams
+}
+
 // SetView sets the View for the server.
 func (s *Server) SetView(view *gview.View) {
 	s.config.View = view
@@ -556,6 +699,11 @@ func (s *Server) SetHandler(h func(w http.ResponseWriter, r *http.Request)) {
 	s.config.Handler = h
 }
 
+// SetConnStateHandler sets the callback function that is called when a client connection changes state.
+func (s *Server) SetConnStateHandler(h func(conn net.Conn, state http.ConnState)) {
+	s.config.ConnStateHandler = h
+}
+
 // GetHandler returns the request handler of the server.
 func (s *Server) GetHandler() func(w http.ResponseWriter, r *http.Request) {
 	if s.config.Handler == nil {
@@ -8,6 +8,7 @@ package ghttp
 
 import (
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -55,10 +56,43 @@ func (s *Server) GetCookieSameSite() http.SameSite {
 	}
 }
 
+// GetCookieSecure returns CookieSecure of server.
 func (s *Server) GetCookieSecure() bool {
 	return s.config.CookieSecure
 }
 
+// GetCookieHttpOnly returns CookieHttpOnly of server.
 func (s *Server) GetCookieHttpOnly() bool {
 	return s.config.CookieHttpOnly
 }
+
+// GetCookiePartitioned returns CookiePartitioned of server.
+func (s *Server) GetCookiePartitioned() bool {
+	return s.config.CookiePartitioned
+}
+
+// GetCookiePriority returns CookiePriority of server.
+func (s *Server) GetCookiePriority() CookiePriority {
+	switch strings.ToLower(s.config.CookiePriority) {
+	case "low":
+		return CookiePriorityLow
+	case "medium":
+		return CookiePriorityMedium
+	case "high":
+		return CookiePriorityHigh
+	default:
+		return CookiePriority(s.config.CookiePriority)
+	}
+}
+
+// GetCookieOptions returns the default cookie options of server, which are used for cookie items
+// set by Cookie.Set and the session id cookie.
+func (s *Server) GetCookieOptions() CookieOptions {
+	return CookieOptions{
+		SameSite:    s.GetCookieSameSite(),
+		Secure:      s.GetCookieSecure(),
+		HttpOnly:    s.GetCookieHttpOnly(),
+		Partitioned: s.GetCookiePartitioned(),
+		Priority:    s.GetCookiePriority(),
+	}
+}
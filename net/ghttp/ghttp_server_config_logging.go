@@ -6,7 +6,12 @@
 
 package ghttp
 
-import "github.com/gogf/gf/v2/os/glog"
+import (
+	"io"
+	"time"
+
+	"github.com/gogf/gf/v2/os/glog"
+)
 
 // SetLogPath sets the log path for server.
 // It logs content to file only if the log path is set.
@@ -51,6 +56,52 @@ func (s *Server) SetAccessLogEnabled(enabled bool) {
 	s.config.AccessLogEnabled = enabled
 }
 
+// SetAccessLogSampler sets the sampling function for access logging, which is consulted for each request
+// to reduce the logging volume of high-QPS routes. The request having error is always logged.
+//
+// Example:
+//
+//	s.SetAccessLogSampler(func(r *ghttp.Request) bool {
+//	    if r.URL.Path == "/health" {
+//	        return grand.Meet(1, 100)
+//	    }
+//	    return true
+//	})
+func (s *Server) SetAccessLogSampler(sampler func(r *Request) bool) {
+	s.config.AccessLogSampler = sampler
+}
+
+// SetAccessLogger sets the dedicated logger for access logging, which writes the access logs to its own
+// path with its own rotation configurations, separated from the error logs of Logger.
+//
+// Example:
+//
+//	accessLogger := glog.New()
+//	accessLogger.SetConfigWithMap(g.Map{
+//	    "path":              "/var/log/app",
+//	    "file":              "access-{Ymd}.log",
+//	    "rotateSize":        "100M",
+//	    "rotateBackupLimit": 10,
+//	})
+//	s.SetAccessLogger(accessLogger)
+func (s *Server) SetAccessLogger(logger *glog.Logger) {
+	s.config.AccessLogger = logger
+}
+
+// SetAccessLogWriter sets the writer for access logging content, which replaces the access log files.
+// It does not take effect if the dedicated logger is set by SetAccessLogger.
+func (s *Server) SetAccessLogWriter(writer io.Writer) {
+	s.config.AccessLogWriter = writer
+}
+
+// SetAccessLogRotateSize sets the size in bytes for rotating the access log file,
+// which keeps at most `backupLimit` rotated files.
+// It does not take effect if the dedicated logger is set by SetAccessLogger.
+func (s *Server) SetAccessLogRotateSize(size int64, backupLimit int) {
+	s.config.AccessLogRotateSize = size
+	s.config.AccessLogRotateBackupLimit = backupLimit
+}
+
 // SetErrorLogEnabled enables/disables the error log.
 func (s *Server) SetErrorLogEnabled(enabled bool) {
 	s.config.ErrorLogEnabled = enabled
@@ -61,6 +112,12 @@ func (s *Server) SetErrorStack(enabled bool) {
 	s.config.ErrorStack = enabled
 }
 
+// SetSlowRequestThreshold sets the latency threshold for slow request logging.
+// It disables the slow request logging if `threshold` is 0.
+func (s *Server) SetSlowRequestThreshold(threshold time.Duration) {
+	s.config.SlowRequestThreshold = threshold
+}
+
 // GetLogPath returns the log path.
 func (s *Server) GetLogPath() string {
 	return s.config.LogPath
@@ -68,7 +125,12 @@ func (s *Server) GetLogPath() string {
 
 // IsAccessLogEnabled checks whether the access log enabled.
 func (s *Server) IsAccessLogEnabled() bool {
-	return s.config.AccessLogEnabled && s.config.Logger != nil
+	return s.config.AccessLogEnabled && (s.config.Logger != nil || s.config.AccessLogger != nil)
+}
+
+// IsSlowRequestLogEnabled checks whether the slow request log enabled.
+func (s *Server) IsSlowRequestLogEnabled() bool {
+	return s.config.SlowRequestThreshold > 0 && s.config.Logger != nil
 }
 
 // IsErrorLogEnabled checks whether the error log enabled.
@@ -22,6 +22,17 @@ func (s *Server) SetClientMaxBodySize(maxSize int64) {
 	s.config.ClientMaxBodySize = maxSize
 }
 
+// SetMaxDecompressedBodySize sets the MaxDecompressedBodySize for server.
+func (s *Server) SetMaxDecompressedBodySize(maxSize int64) {
+	s.config.MaxDecompressedBodySize = maxSize
+}
+
+// SetErrorStatusMapping enables or disables responding the request error with http status mapped
+// from its error code, which is status 500 for all request errors except validation failure if it is disabled.
+func (s *Server) SetErrorStatusMapping(enabled bool) {
+	s.config.ErrorStatusMapping = enabled
+}
+
 // SetFormParsingMemory sets the FormParsingMemory for server.
 func (s *Server) SetFormParsingMemory(maxMemory int64) {
 	s.config.FormParsingMemory = maxMemory
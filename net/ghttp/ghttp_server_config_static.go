@@ -10,11 +10,15 @@ package ghttp
 
 import (
 	"context"
+	"net/http"
+	"path"
 	"strings"
+	"time"
 
 	"github.com/gogf/gf/v2/container/garray"
 	"github.com/gogf/gf/v2/os/gfile"
 	"github.com/gogf/gf/v2/os/gres"
+	"github.com/gogf/gf/v2/text/gregex"
 	"github.com/gogf/gf/v2/util/gconv"
 )
 
@@ -130,3 +134,122 @@ func (s *Server) AddStaticPath(prefix string, path string) {
 	}
 	s.config.FileServerEnabled = true
 }
+
+// SetStaticCacheControl sets the "Cache-Control" header values for static files by URL path patterns.
+// The key of `patterns` is the pattern matched against the URL path of the requested static file,
+// and its value is the "Cache-Control" header value.
+//
+// The pattern uses the syntax of path.Match. If the pattern contains no char '/', it is matched
+// against the base name of the URL path, or else it is matched against the whole URL path.
+// If there are multiple patterns matched, the longest pattern is used.
+//
+// Example:
+//
+//	s.SetStaticCacheControl(map[string]string{
+//	    "*.*.js":      "public, max-age=31536000, immutable",
+//	    "/index.html": "no-cache",
+//	})
+func (s *Server) SetStaticCacheControl(patterns map[string]string) {
+	if s.config.StaticCacheControl == nil {
+		s.config.StaticCacheControl = make(map[string]string)
+	}
+	for k, v := range patterns {
+		s.config.StaticCacheControl[k] = v
+	}
+}
+
+// SetStaticFallback sets the fallback static file `file` for the missing paths under URI root `root`,
+// which is the classic fallback for the history mode routing of single page application.
+// The parameter `file` is the URI of the fallback file, which is searched like the other static files.
+//
+// The fallback file is served only if there's neither static file nor dynamic handler for the request,
+// and the requested path has no file extension, so the missing assets like "/app/main.js" still
+// respond status 404. If there are multiple roots matched, the longest root is used.
+//
+// Example:
+//
+//	s.SetServerRoot("dist")
+//	s.SetStaticFallback("/", "/index.html")
+func (s *Server) SetStaticFallback(root, file string) {
+	if s.config.StaticFallbacks == nil {
+		s.config.StaticFallbacks = make(map[string]string)
+	}
+	s.config.StaticFallbacks["/"+strings.Trim(root, "/")] = file
+	s.config.FileServerEnabled = true
+}
+
+// searchStaticFallback searches the fallback static file for missing path `uri`.
+// It returns nil if there's no fallback file for `uri`.
+func (s *Server) searchStaticFallback(uri string) *staticFile {
+	if len(s.config.StaticFallbacks) == 0 || path.Ext(uri) != "" {
+		return nil
+	}
+	var matchedRoot, fallbackFile string
+	for root, file := range s.config.StaticFallbacks {
+		if root != "/" {
+			if len(uri) < len(root) || !strings.EqualFold(root, uri[:len(root)]) {
+				continue
+			}
+			// To avoid case like: /app -> /application
+			if len(uri) > len(root) && uri[len(root)] != '/' {
+				continue
+			}
+		}
+		if len(root) > len(matchedRoot) {
+			matchedRoot, fallbackFile = root, file
+		}
+	}
+	if fallbackFile == "" {
+		return nil
+	}
+	if f := s.searchStaticFile(fallbackFile); f != nil && !f.IsDir {
+		return f
+	}
+	return nil
+}
+
+// setStaticCacheControl sets the "Cache-Control" and "Expires" headers for static file serving
+// according to the StaticCacheControl configuration.
+func (s *Server) setStaticCacheControl(r *Request) {
+	if len(s.config.StaticCacheControl) == 0 {
+		return
+	}
+	var (
+		urlPath        = r.URL.Path
+		baseName       = path.Base(urlPath)
+		matchedPattern string
+		cacheControl   string
+	)
+	for pattern, value := range s.config.StaticCacheControl {
+		var target = urlPath
+		if !strings.Contains(pattern, "/") {
+			target = baseName
+		}
+		if matched, _ := path.Match(pattern, target); !matched {
+			continue
+		}
+		if len(pattern) > len(matchedPattern) ||
+			(len(pattern) == len(matchedPattern) && pattern < matchedPattern) {
+			matchedPattern = pattern
+			cacheControl = value
+		}
+	}
+	if matchedPattern == "" {
+		return
+	}
+	var header = r.Response.Header()
+	header.Set("Cache-Control", cacheControl)
+	switch {
+	case gregex.IsMatchString(`(?i)no-cache|no-store`, cacheControl):
+		header.Set("Expires", time.Unix(0, 0).UTC().Format(http.TimeFormat))
+
+	default:
+		match, _ := gregex.MatchString(`(?i)max-age=(\d+)`, cacheControl)
+		if len(match) > 1 {
+			header.Set(
+				"Expires",
+				time.Now().Add(time.Duration(gconv.Int64(match[1]))*time.Second).UTC().Format(http.TimeFormat),
+			)
+		}
+	}
+}
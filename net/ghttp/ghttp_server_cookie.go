@@ -11,6 +11,8 @@ import (
 	"time"
 
 	"github.com/gogf/gf/v2/container/gvar"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
 )
 
 // Cookie for HTTP COOKIE management.
@@ -23,15 +25,40 @@ type Cookie struct {
 
 // CookieOptions provides security config for cookies
 type CookieOptions struct {
-	SameSite http.SameSite // cookie SameSite property
-	Secure   bool          // cookie Secure property
-	HttpOnly bool          // cookie HttpOnly property
+	SameSite    http.SameSite  // cookie SameSite property
+	Secure      bool           // cookie Secure property
+	HttpOnly    bool           // cookie HttpOnly property
+	Partitioned bool           // cookie Partitioned property, which requires Secure.
+	Priority    CookiePriority // cookie Priority property.
 }
 
+// CookiePriority is the Priority property of cookie.
+type CookiePriority string
+
+const (
+	CookiePriorityLow    CookiePriority = "Low"
+	CookiePriorityMedium CookiePriority = "Medium"
+	CookiePriorityHigh   CookiePriority = "High"
+)
+
 // cookieItem is the item stored in Cookie.
 type cookieItem struct {
-	*http.Cookie      // Underlying cookie items.
-	FromClient   bool // Mark this cookie received from the client.
+	*http.Cookie                // Underlying cookie items.
+	FromClient   bool           // Mark this cookie received from the client.
+	Priority     CookiePriority // Priority property, which is not supported by http.Cookie.
+}
+
+// Validate checks the combination of cookie properties.
+func (o CookieOptions) Validate() error {
+	if o.Partitioned && !o.Secure {
+		return gerror.NewCode(gcode.CodeInvalidParameter, `cookie property Partitioned requires Secure`)
+	}
+	switch o.Priority {
+	case "", CookiePriorityLow, CookiePriorityMedium, CookiePriorityHigh:
+	default:
+		return gerror.NewCodef(gcode.CodeInvalidParameter, `invalid cookie property Priority "%s"`, o.Priority)
+	}
+	return nil
 }
 
 // GetCookie creates or retrieves a cookie object with given request.
@@ -95,37 +122,42 @@ func (c *Cookie) Set(key, value string) {
 		c.request.Server.GetCookieDomain(),
 		c.request.Server.GetCookiePath(),
 		c.request.Server.GetCookieMaxAge(),
-		CookieOptions{
-			SameSite: c.request.Server.GetCookieSameSite(),
-			Secure:   c.request.Server.GetCookieSecure(),
-			HttpOnly: c.request.Server.GetCookieHttpOnly(),
-		},
+		c.request.Server.GetCookieOptions(),
 	)
 }
 
 // SetCookie sets cookie item with given domain, path and expiration age.
 // The optional parameter `options` specifies extra security configurations,
 // which is usually empty.
+//
+// The cookie item is not set if the `options` is invalid, eg: Partitioned without Secure,
+// and the error is logged.
 func (c *Cookie) SetCookie(key, value, domain, path string, maxAge time.Duration, options ...CookieOptions) {
 	c.init()
 	config := CookieOptions{}
 	if len(options) > 0 {
 		config = options[0]
 	}
+	if err := config.Validate(); err != nil {
+		c.server.Logger().Errorf(c.request.Context(), `set cookie "%s" failed: %+v`, key, err)
+		return
+	}
 	httpCookie := &http.Cookie{
-		Name:     key,
-		Value:    value,
-		Path:     path,
-		Domain:   domain,
-		HttpOnly: config.HttpOnly,
-		SameSite: config.SameSite,
-		Secure:   config.Secure,
+		Name:        key,
+		Value:       value,
+		Path:        path,
+		Domain:      domain,
+		HttpOnly:    config.HttpOnly,
+		SameSite:    config.SameSite,
+		Secure:      config.Secure,
+		Partitioned: config.Partitioned,
 	}
 	if maxAge != 0 {
 		httpCookie.Expires = time.Now().Add(maxAge)
 	}
 	c.data[key] = &cookieItem{
-		Cookie: httpCookie,
+		Cookie:   httpCookie,
+		Priority: config.Priority,
 	}
 }
 
@@ -150,11 +182,7 @@ func (c *Cookie) SetSessionId(id string) {
 		c.request.Server.GetCookieDomain(),
 		c.request.Server.GetCookiePath(),
 		c.server.GetSessionCookieMaxAge(),
-		CookieOptions{
-			SameSite: c.request.Server.GetCookieSameSite(),
-			Secure:   c.request.Server.GetCookieSecure(),
-			HttpOnly: c.request.Server.GetCookieHttpOnly(),
-		},
+		c.request.Server.GetCookieOptions(),
 	)
 }
 
@@ -200,6 +228,13 @@ func (c *Cookie) Flush() {
 		if v.FromClient {
 			continue
 		}
-		http.SetCookie(c.response.Writer, v.Cookie)
+		if v.Priority == "" {
+			http.SetCookie(c.response.Writer, v.Cookie)
+			continue
+		}
+		// The Priority property is appended manually as it is not supported by http.Cookie.
+		if cookieStr := v.Cookie.String(); cookieStr != "" {
+			c.response.Header().Add("Set-Cookie", cookieStr+"; Priority="+string(v.Priority))
+		}
 	}
 }
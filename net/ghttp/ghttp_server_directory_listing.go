@@ -0,0 +1,75 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"mime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FileInfo is the information of sub file in directory listing.
+type FileInfo struct {
+	Name    string    `json:"name"`    // File name, eg: index.html.
+	Path    string    `json:"path"`    // URI path of the file, which ends with "/" for directory.
+	Size    int64     `json:"size"`    // File size in bytes.
+	ModTime time.Time `json:"modTime"` // Modification time.
+	IsDir   bool      `json:"isDir"`   // Whether it is a directory.
+}
+
+// DirectoryListingRenderer is the custom renderer for directory listing, which writes the sub files
+// `files` of the requested directory to the response. The `files` are sorted with directories first
+// and then by name. If it returns error, the server responses HTTP status code 500.
+type DirectoryListingRenderer func(r *Request, files []FileInfo) error
+
+// SetDirectoryListingRenderer sets the custom renderer for directory listing, which controls the
+// output of listing entirely, like rendering with custom template. The default renderer is used if
+// it is nil, which renders as JSON if the client prefers JSON by `Accept` header, or else HTML.
+func (s *Server) SetDirectoryListingRenderer(renderer DirectoryListingRenderer) {
+	s.config.DirectoryListingRenderer = renderer
+}
+
+// SetDirectoryListingDisabled disables or enables the directory listing entirely. If it is disabled,
+// the server responses HTTP status code 403 for directories, even if IndexFolder is enabled or index
+// is allowed by Response.ServeFile.
+func (s *Server) SetDirectoryListingDisabled(disabled bool) {
+	s.config.DirectoryListingDisabled = disabled
+}
+
+// isDirectoryListingAllowed checks and returns whether listing the sub files of directory is allowed.
+func (s *Server) isDirectoryListingAllowed(allowIndex ...bool) bool {
+	if s.config.DirectoryListingDisabled {
+		return false
+	}
+	return s.config.IndexFolder || (len(allowIndex) > 0 && allowIndex[0])
+}
+
+// isJsonAccepted checks and returns whether the client prefers JSON to HTML by the `Accept` header,
+// which compares the quality values of media types.
+func isJsonAccepted(r *Request) bool {
+	var jsonQuality, htmlQuality float64
+	for _, item := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(item))
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		switch {
+		case mediaType == contentTypeJson || strings.HasSuffix(mediaType, "+json"):
+			jsonQuality = max(jsonQuality, quality)
+		case mediaType == "text/html" || mediaType == "text/*" || mediaType == "*/*":
+			htmlQuality = max(htmlQuality, quality)
+		}
+	}
+	return jsonQuality > htmlQuality
+}
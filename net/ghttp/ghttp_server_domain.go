@@ -30,9 +30,9 @@ func (s *Server) Domain(domains string) *Domain {
 }
 
 // BindHandler binds the handler for the specified pattern.
-func (d *Domain) BindHandler(pattern string, handler any) {
+func (d *Domain) BindHandler(pattern string, handler any, options ...RouteOption) {
 	for domain := range d.domains {
-		d.server.BindHandler(patternBindDomain(pattern, domain), handler)
+		d.server.BindHandler(patternBindDomain(pattern, domain), handler, options...)
 	}
 }
 
@@ -44,6 +44,7 @@ func (d *Domain) doBindHandler(ctx context.Context, in doBindHandlerInput) {
 			FuncInfo:   in.FuncInfo,
 			Middleware: in.Middleware,
 			Source:     in.Source,
+			Options:    in.Options,
 		})
 	}
 }
@@ -64,6 +65,7 @@ func (d *Domain) doBindObject(ctx context.Context, in doBindObjectInput) {
 			Method:     in.Method,
 			Middleware: in.Middleware,
 			Source:     in.Source,
+			Options:    in.Options,
 		})
 	}
 }
@@ -84,6 +86,7 @@ func (d *Domain) doBindObjectMethod(ctx context.Context, in doBindObjectMethodIn
 			Method:     in.Method,
 			Middleware: in.Middleware,
 			Source:     in.Source,
+			Options:    in.Options,
 		})
 	}
 }
@@ -104,6 +107,7 @@ func (d *Domain) doBindObjectRest(ctx context.Context, in doBindObjectInput) {
 			Method:     in.Method,
 			Middleware: in.Middleware,
 			Source:     in.Source,
+			Options:    in.Options,
 		})
 	}
 }
@@ -148,6 +152,20 @@ func (d *Domain) BindStatusHandlerByMap(handlerMap map[int]HandlerFunc) {
 	}
 }
 
+// BindStatusHandlerByPattern binds the fallback status handler for the status range `pattern` like "5xx".
+func (d *Domain) BindStatusHandlerByPattern(pattern string, handler HandlerFunc) {
+	statusPattern, ok := checkStatusPattern(pattern)
+	if !ok {
+		d.server.Logger().Fatalf(
+			context.TODO(), `invalid status pattern "%s", it should be like "4xx" or "5xx"`, pattern,
+		)
+		return
+	}
+	for domain := range d.domains {
+		d.server.addStatusHandler(d.server.statusPatternHandlerKey(statusPattern, domain), handler)
+	}
+}
+
 // BindMiddleware binds the middleware for the specified pattern.
 func (d *Domain) BindMiddleware(pattern string, handlers ...HandlerFunc) {
 	for domain := range d.domains {
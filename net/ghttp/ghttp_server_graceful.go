@@ -6,23 +6,37 @@
 
 package ghttp
 
-import "github.com/gogf/gf/v2/net/ghttp/internal/graceful"
+import (
+	"net/http"
+
+	"github.com/gogf/gf/v2/net/ghttp/internal/graceful"
+)
 
 // newGracefulServer creates and returns a graceful http server with a given address.
 // The optional parameter `fd` specifies the file descriptor which is passed from parent server.
 func (s *Server) newGracefulServer(address string, fd int) *graceful.Server {
+	return s.newGracefulServerWithHandler(address, fd, s.config.Handler)
+}
+
+// newGracefulServerWithHandler creates and returns a graceful http server using custom `handler`.
+func (s *Server) newGracefulServerWithHandler(
+	address string, fd int, handler func(w http.ResponseWriter, r *http.Request),
+) *graceful.Server {
 	var (
 		loggerWriter = &errorLogger{logger: s.config.Logger}
 		serverConfig = graceful.ServerConfig{
-			Listeners:               s.config.Listeners,
-			Handler:                 s.config.Handler,
-			ReadTimeout:             s.config.ReadTimeout,
-			WriteTimeout:            s.config.WriteTimeout,
-			IdleTimeout:             s.config.IdleTimeout,
-			GracefulShutdownTimeout: s.config.GracefulTimeout,
-			MaxHeaderBytes:          s.config.MaxHeaderBytes,
-			KeepAlive:               s.config.KeepAlive,
-			Logger:                  s.config.Logger,
+			Listeners:                     s.config.Listeners,
+			Handler:                       handler,
+			ConnState:                     s.config.ConnStateHandler,
+			ReadTimeout:                   s.config.ReadTimeout,
+			WriteTimeout:                  s.config.WriteTimeout,
+			IdleTimeout:                   s.config.IdleTimeout,
+			GracefulShutdownTimeout:       s.config.GracefulTimeout,
+			MaxHeaderBytes:                s.config.MaxHeaderBytes,
+			KeepAlive:                     s.config.KeepAlive,
+			Logger:                        s.config.Logger,
+			ProxyProtocol:                 s.config.ProxyProtocol,
+			ProxyProtocolTrustedUpstreams: s.config.ProxyProtocolTrustedUpstreams,
 		}
 	)
 	return graceful.New(address, fd, loggerWriter, serverConfig)
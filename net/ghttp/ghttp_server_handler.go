@@ -7,6 +7,7 @@
 package ghttp
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
@@ -32,6 +33,7 @@ import (
 	"github.com/gogf/gf/v2/os/gtime"
 	"github.com/gogf/gf/v2/text/gstr"
 	"github.com/gogf/gf/v2/util/gconv"
+	"github.com/gogf/gf/v2/util/gutil"
 )
 
 // ServeHTTP is the default handler for http request.
@@ -68,6 +70,14 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		attribute.String(tracingEventHttpRequestBaggage, gtrace.GetBaggageMap(ctx).String()),
 	))
 
+	// Header size and count limit, which is checked before any heavy processing.
+	if err := s.checkRequestHeaderLimit(r); err != nil {
+		s.Logger().Warningf(ctx, `%s %s: %v`, r.Method, r.URL.Path, err)
+		span.SetStatus(codes.Error, err.Error())
+		http.Error(w, http.StatusText(http.StatusRequestHeaderFieldsTooLarge), http.StatusRequestHeaderFieldsTooLarge)
+		return
+	}
+
 	// Max body size limit.
 	if s.config.ClientMaxBodySize > 0 {
 		r.Body = http.MaxBytesReader(w, r.Body, s.config.ClientMaxBodySize)
@@ -86,6 +96,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	)
 	defer s.handleAfterRequestDone(request)
 
+	// Request headers to context values.
+	s.handleContextHeaderBindings(request)
+
+	// Method overriding for the clients supporting only GET/POST, eg: HTML forms.
+	s.handleMethodOverride(request)
+
 	// ============================================================
 	// Priority:
 	// Static File > Dynamic Service > Static Directory
@@ -106,6 +122,29 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		request.hasHookHandler,
 		request.hasServeHandler = s.getHandlersWithCache(request)
 
+	// Middleware of the additional listener that the request arrives on, which are called firstly.
+	if listener := request.getListener(); listener != nil && len(listener.Handlers) > 0 {
+		handlers := make([]*HandlerItemParsed, 0, len(listener.Handlers)+len(request.handlers))
+		handlers = append(handlers, listener.Handlers...)
+		request.handlers = append(handlers, request.handlers...)
+	}
+
+	// Fallback static file for missing path, which has the lowest priority.
+	if s.config.FileServerEnabled && request.StaticFile == nil && !request.hasServeHandler {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead:
+			if request.StaticFile = s.searchStaticFallback(r.URL.Path); request.StaticFile != nil {
+				request.isFileRequest = true
+			}
+		}
+	}
+
+	// In-flight request tracking for shutdown report.
+	if s.isShutdownReportEnabled() {
+		s.trackInFlightRequest(request)
+		defer s.inFlightRequests.Delete(request)
+	}
+
 	// Check the service type static or dynamic for current request.
 	if request.StaticFile != nil && request.StaticFile.IsDir && request.hasServeHandler {
 		request.isFileRequest = false
@@ -115,7 +154,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	s.handleMetricsBeforeRequest(request)
 
 	// HOOK - BeforeServe
-	s.callHookHandler(HookBeforeServe, request)
+	if !request.IsExited() {
+		s.callHookHandler(HookBeforeServe, request)
+	}
 
 	// Core serving handling.
 	if !request.IsExited() {
@@ -134,13 +175,16 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					if len(request.Response.Header()) == 0 &&
 						request.Response.Status == 0 &&
 						request.Response.BufferLength() == 0 {
-						request.Response.WriteHeader(http.StatusNotFound)
+						s.handleNotFound(request)
 					}
 				}
 			}
 		}
 	}
 
+	// Deprecation headers for deprecated route.
+	s.handleDeprecatedRoute(request)
+
 	// HOOK - AfterServe
 	if !request.IsExited() {
 		s.callHookHandler(HookAfterServe, request)
@@ -171,16 +215,108 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	))
 }
 
+// checkRequestHeaderLimit checks the size and count of the request header against the
+// configuration MaxHeaderBytes and MaxHeaderCount.
+func (s *Server) checkRequestHeaderLimit(r *http.Request) error {
+	var (
+		maxBytes = s.config.MaxHeaderBytes
+		maxCount = s.config.MaxHeaderCount
+	)
+	if maxBytes <= 0 && maxCount <= 0 {
+		return nil
+	}
+	var (
+		count int
+		// Request line, like: GET /index HTTP/1.1\r\n
+		size = len(r.Method) + len(r.RequestURI) + len(r.Proto) + 4
+	)
+	// The "Host" header is removed from header map by net/http.
+	if r.Host != "" {
+		count++
+		size += len("Host") + len(r.Host) + 4
+	}
+	for key, values := range r.Header {
+		for _, value := range values {
+			count++
+			// Header line, like: Key: Value\r\n
+			size += len(key) + len(value) + 4
+		}
+	}
+	if maxCount > 0 && count > maxCount {
+		return gerror.NewCodef(
+			gcode.CodeInvalidRequest,
+			`request header count %d exceeds the limit %d`, count, maxCount,
+		)
+	}
+	if maxBytes > 0 && size > maxBytes {
+		return gerror.NewCodef(
+			gcode.CodeInvalidRequest,
+			`request header size %d bytes exceeds the limit %d bytes`, size, maxBytes,
+		)
+	}
+	return nil
+}
+
+// errorStatusCode returns the http status code for `err` according to its error code.
+// The validation failure is always mapped to http.StatusBadRequest, and the other error codes
+// are mapped only if ErrorStatusMapping is enabled, or else it returns http.StatusInternalServerError.
+func (s *Server) errorStatusCode(err error) int {
+	var code = gerror.Code(err)
+	if code == gcode.CodeValidationFailed {
+		return http.StatusBadRequest
+	}
+	if !s.config.ErrorStatusMapping {
+		return http.StatusInternalServerError
+	}
+	switch code {
+	case gcode.CodeInvalidRequest:
+		return http.StatusBadRequest
+	case gcode.CodeRequestTooLarge:
+		return http.StatusRequestEntityTooLarge
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// handleErrorByRouteHandler renders `err` using the custom error handler of the serving route,
+// which is usually set by RouterGroup.SetErrorHandler. It returns false if there's no custom
+// error handler for the serving route, or the custom error handler panics.
+func (s *Server) handleErrorByRouteHandler(request *Request, err error) bool {
+	if request.serveHandler == nil || request.serveHandler.Handler.ErrorHandler == nil {
+		return false
+	}
+	var errorHandler = request.serveHandler.Handler.ErrorHandler
+	// The status can be changed by the custom error handler.
+	if request.Response.Status == 0 {
+		request.Response.WriteHeader(s.errorStatusCode(err))
+	}
+	if e := gutil.Try(request.Context(), func(ctx context.Context) {
+		niceCallFunc(func() {
+			errorHandler(request, err)
+		})
+	}); e != nil {
+		s.Logger().Errorf(request.Context(), `custom error handler failed: %+v`, e)
+		return false
+	}
+	return true
+}
+
 func (s *Server) handleResponse(request *Request, sessionId string) {
 	// HTTP status checking.
-	if request.Response.Status == 0 {
+	// The request whose client disconnects is classified distinctly instead of server error,
+	// but the status written by handler without error is kept.
+	var disconnected = (request.Response.Status == 0 || request.GetError() != nil) && s.handleClientDisconnect(request)
+	if !disconnected && request.Response.Status == 0 {
 		if request.StaticFile != nil || request.Middleware.served || request.Response.BufferLength() > 0 {
 			request.Response.WriteHeader(http.StatusOK)
 		} else if err := request.GetError(); err != nil {
-			if request.Response.BufferLength() == 0 {
-				request.Response.Write(err.Error())
+			// The custom error handler of the route has priority over the default error rendering.
+			if !s.handleErrorByRouteHandler(request, err) {
+				if request.Response.BufferLength() == 0 {
+					request.Response.Write(err.Error())
+				}
+				request.Response.WriteHeader(s.errorStatusCode(err))
 			}
-			request.Response.WriteHeader(http.StatusInternalServerError)
 		} else {
 			request.Response.WriteHeader(http.StatusNotFound)
 		}
@@ -214,17 +350,25 @@ func (s *Server) handleResponse(request *Request, sessionId string) {
 			}
 		}
 	}
+	// Response body validation against the declared response type in development.
+	s.handleResponseValidation(request)
+
 	// Output the cookie content to the client.
 	request.Cookie.Flush()
 	// Output the buffer content to the client.
 	request.Response.Flush()
+	// Output the trailers after the response body.
+	request.Response.flushTrailers()
 }
 
 func (s *Server) handleAfterRequestDone(request *Request) {
 	request.LeaveTime = gtime.Now()
 	// error log handling.
 	if request.error != nil {
-		s.handleErrorLog(request.error, request)
+		// The error caused by client disconnection is not logged as server error.
+		if !request.IsClientDisconnected() {
+			s.handleErrorLog(request.error, request)
+		}
 	} else {
 		if exception := recover(); exception != nil {
 			request.Response.WriteStatus(http.StatusInternalServerError)
@@ -247,6 +391,8 @@ func (s *Server) handleAfterRequestDone(request *Request) {
 	}
 	// access log handling.
 	s.handleAccessLog(request)
+	// slow request log handling.
+	s.handleSlowRequestLog(request)
 	// Close the session, which automatically update the TTL
 	// of the session if it exists.
 	if err := request.Session.Close(); err != nil {
@@ -339,13 +485,14 @@ func (s *Server) serveFile(r *Request, f *staticFile, allowIndex ...bool) {
 	// Use resource file from memory.
 	if f.File != nil {
 		if f.IsDir {
-			if s.config.IndexFolder || (len(allowIndex) > 0 && allowIndex[0]) {
+			if s.isDirectoryListingAllowed(allowIndex...) {
 				s.listDir(r, f.File)
 			} else {
 				r.Response.WriteStatus(http.StatusForbidden)
 			}
 		} else {
 			info := f.File.FileInfo()
+			s.setStaticCacheControl(r)
 			r.Response.ServeContent(info.Name(), info.ModTime(), f.File)
 		}
 		return
@@ -366,17 +513,20 @@ func (s *Server) serveFile(r *Request, f *staticFile, allowIndex ...bool) {
 
 	info, _ := file.Stat()
 	if info.IsDir() {
-		if s.config.IndexFolder || (len(allowIndex) > 0 && allowIndex[0]) {
+		if s.isDirectoryListingAllowed(allowIndex...) {
 			s.listDir(r, file)
 		} else {
 			r.Response.WriteStatus(http.StatusForbidden)
 		}
 	} else {
+		s.setStaticCacheControl(r)
 		r.Response.ServeContent(info.Name(), info.ModTime(), file)
 	}
 }
 
-// listDir lists the sub files of specified directory as HTML content to the client.
+// listDir lists the sub files of specified directory to the client, which uses the custom renderer
+// if it is set by SetDirectoryListingRenderer, or else renders as JSON or HTML content according to
+// the `Accept` header of the request.
 func (s *Server) listDir(r *Request, f http.File) {
 	files, err := f.Readdir(-1)
 	if err != nil {
@@ -393,6 +543,41 @@ func (s *Server) listDir(r *Request, f http.File) {
 		}
 		return files[i].Name() < files[j].Name()
 	})
+	var (
+		prefix    = gstr.TrimRight(r.URL.Path, "/")
+		fileInfos = make([]FileInfo, 0, len(files))
+	)
+	for _, file := range files {
+		info := FileInfo{
+			Name:    file.Name(),
+			Path:    prefix + "/" + file.Name(),
+			Size:    file.Size(),
+			ModTime: file.ModTime(),
+			IsDir:   file.IsDir(),
+		}
+		if info.IsDir {
+			info.Path += "/"
+		}
+		fileInfos = append(fileInfos, info)
+	}
+	if s.config.DirectoryListingRenderer != nil {
+		if err = s.config.DirectoryListingRenderer(r, fileInfos); err != nil {
+			r.SetError(err)
+			r.Response.ClearBuffer()
+			r.Response.WriteStatus(http.StatusInternalServerError)
+		}
+		return
+	}
+	if isJsonAccepted(r) {
+		r.Response.WriteJson(fileInfos)
+		return
+	}
+	s.listDirAsHTML(r, fileInfos)
+}
+
+// listDirAsHTML renders the sub files of specified directory as HTML content, which is the default
+// directory listing output.
+func (s *Server) listDirAsHTML(r *Request, files []FileInfo) {
 	if r.Response.Header().Get("Content-Type") == "" {
 		r.Response.Header().Set("Content-Type", "text/html; charset=utf-8")
 	}
@@ -413,17 +598,16 @@ func (s *Server) listDir(r *Request, f http.File) {
 	}
 	name := ""
 	size := ""
-	prefix := gstr.TrimRight(r.URL.Path, "/")
 	for _, file := range files {
-		name = file.Name()
-		size = gfile.FormatSize(file.Size())
-		if file.IsDir() {
+		name = file.Name
+		size = gfile.FormatSize(file.Size)
+		if file.IsDir {
 			name += "/"
 			size = "-"
 		}
 		r.Response.Write(`<tr>`)
-		r.Response.Writef(`<td><a href="%s/%s">%s</a></td>`, prefix, name, ghtml.SpecialChars(name))
-		r.Response.Writef(`<td style="width:300px;text-align:center;">%s</td>`, gtime.New(file.ModTime()).ISO8601())
+		r.Response.Writef(`<td><a href="%s">%s</a></td>`, file.Path, ghtml.SpecialChars(name))
+		r.Response.Writef(`<td style="width:300px;text-align:center;">%s</td>`, gtime.New(file.ModTime).ISO8601())
 		r.Response.Writef(`<td style="width:80px;text-align:right;">%s</td>`, size)
 		r.Response.Write(`</tr>`)
 	}
@@ -0,0 +1,79 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"context"
+	"net/http"
+)
+
+// HeaderBinding binds the value of request header to the request context, which is done for every
+// request before any hook or middleware is called, eg: tenant id from header `X-Tenant`.
+type HeaderBinding struct {
+	// Header is the name of request header, eg: `X-Tenant`.
+	Header string
+
+	// CtxKey is the key of the value in request context.
+	CtxKey any
+
+	// Parser parses the header value to the typed context value.
+	// The raw header value string is stored if it is nil.
+	Parser func(value string) (any, error)
+
+	// RejectOnError specifies responding status 400 if the Parser returns error.
+	// In default, the parsing error is logged and the binding is skipped.
+	RejectOnError bool
+}
+
+// SetContextHeaderBindings sets the bindings from request headers to context values for the server,
+// so the handlers can retrieve the parsed values from request context directly, which centralizes
+// the header extraction. The binding is skipped if the header is absent or empty.
+//
+// Example:
+//
+//	s.SetContextHeaderBindings([]ghttp.HeaderBinding{
+//	    {Header: "X-Tenant", CtxKey: ctxKeyTenant, Parser: parseTenantId, RejectOnError: true},
+//	    {Header: "Accept-Language", CtxKey: ctxKeyLocale},
+//	})
+func (s *Server) SetContextHeaderBindings(bindings []HeaderBinding) {
+	s.config.ContextHeaderBindings = bindings
+}
+
+// handleContextHeaderBindings binds the request headers to request context according to the
+// configured header bindings. The request is exited with status 400 if the parsing fails
+// for binding having RejectOnError set.
+func (s *Server) handleContextHeaderBindings(r *Request) {
+	if len(s.config.ContextHeaderBindings) == 0 {
+		return
+	}
+	var ctx = r.Context()
+	for _, binding := range s.config.ContextHeaderBindings {
+		var headerValue = r.Header.Get(binding.Header)
+		if headerValue == "" {
+			continue
+		}
+		var value any = headerValue
+		if binding.Parser != nil {
+			parsedValue, err := binding.Parser(headerValue)
+			if err != nil {
+				if binding.RejectOnError {
+					r.Response.WriteStatus(
+						http.StatusBadRequest,
+						`invalid value of header "`+binding.Header+`": `+err.Error(),
+					)
+					r.exitAll = true
+					return
+				}
+				s.Logger().Warningf(ctx, `parse value of header "%s" failed: %+v`, binding.Header, err)
+				continue
+			}
+			value = parsedValue
+		}
+		ctx = context.WithValue(ctx, binding.CtxKey, value)
+	}
+	r.SetCtx(ctx)
+}
@@ -0,0 +1,176 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"context"
+	"net/http"
+	"runtime"
+	"strings"
+
+	"github.com/gogf/gf/v2/net/ghttp/internal/graceful"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+// ListenerOption is the option for additional listener of server, which is added by AddListener.
+type ListenerOption struct {
+	// Name is the unique name of the listener, which is used for branching in middleware
+	// using Request.GetListenerName. It uses the listening address if it's empty.
+	Name string
+
+	// Middleware are the middleware only for requests arriving on this listener,
+	// which are called before the global middleware.
+	Middleware []HandlerFunc
+
+	// Prefixes specifies the allowed path prefixes of requests arriving on this listener,
+	// the requests of other paths are responded with status 404. All paths are allowed if empty.
+	Prefixes []string
+}
+
+// listenerItem is the additional listener added by AddListener.
+type listenerItem struct {
+	Address  string               // Listening address, like: ":8080".
+	Option   ListenerOption       // Listener option.
+	Handlers []*HandlerItemParsed // Parsed middleware handlers of the listener.
+	Server   *graceful.Server     // Underlying server, which is created when server starts.
+}
+
+const (
+	// listenerFdMapKeyPrefix is the key prefix of additional listener for graceful reload fd passing.
+	listenerFdMapKeyPrefix = "listener:"
+)
+
+// AddListener adds an additional listening address `address` to the server, which shares the same
+// routes and lifecycle including graceful shutdown/reload with the server, but can have its own
+// middleware and allowed path prefixes specified by `option`.
+// The listener name of the request can be retrieved by Request.GetListenerName for branching.
+//
+// Note that it should be called before the server starts.
+//
+// Example:
+//
+//	s.SetAddress(":8000")
+//	s.AddListener(":8001", ghttp.ListenerOption{
+//	    Name:       "admin",
+//	    Middleware: []ghttp.HandlerFunc{AdminAuth},
+//	    Prefixes:   []string{"/admin"},
+//	})
+func (s *Server) AddListener(address string, option ...ListenerOption) {
+	var item = &listenerItem{
+		Address: address,
+	}
+	if len(option) > 0 {
+		item.Option = option[0]
+	}
+	if item.Option.Name == "" {
+		item.Option.Name = address
+	}
+	for _, v := range s.listeners {
+		if v.Option.Name == item.Option.Name {
+			s.Logger().Fatalf(context.TODO(), `duplicated listener name "%s"`, item.Option.Name)
+			return
+		}
+	}
+	for _, middleware := range item.Option.Middleware {
+		item.Handlers = append(item.Handlers, &HandlerItemParsed{
+			Handler: &HandlerItem{
+				Name: item.Option.Name,
+				Type: HandlerTypeMiddleware,
+				Info: handlerFuncInfo{
+					Func: middleware,
+				},
+				Router: &Router{
+					Uri:    "/*",
+					Method: defaultMethod,
+				},
+			},
+		})
+	}
+	s.listeners = append(s.listeners, item)
+}
+
+// GetListenerPort returns the port listened by the additional listener of name `name`.
+// It returns -1 if the listener is not found or not started.
+func (s *Server) GetListenerPort(name string) int {
+	for _, item := range s.listeners {
+		if item.Option.Name == name && item.Server != nil {
+			return item.Server.GetListenedPort()
+		}
+	}
+	return -1
+}
+
+// GetListenerName returns the name of the listener that the request arrives on.
+// It returns an empty string if the request arrives on the default listeners of server.
+func (r *Request) GetListenerName() string {
+	if item := r.getListener(); item != nil {
+		return item.Option.Name
+	}
+	return ""
+}
+
+// getListener returns the additional listener that the request arrives on.
+func (r *Request) getListener() *listenerItem {
+	if v := r.Request.Context().Value(ctxKeyForListener); v != nil {
+		return v.(*listenerItem)
+	}
+	return nil
+}
+
+// startListeners creates the underlying servers for additional listeners.
+// The file descriptors in `fdMap` are used if it's a graceful reload.
+func (s *Server) startListeners(fdMap listenerFdMap) {
+	for _, item := range s.listeners {
+		var (
+			fd      = 0
+			address = item.Address
+		)
+		if v, ok := fdMap[listenerFdMapKeyPrefix+item.Option.Name]; ok && len(v) > 0 {
+			// The Windows OS does not support socket file descriptor passing
+			// from the parent process.
+			if addrAndFd := strings.Split(v, "#"); len(addrAndFd) > 1 {
+				address = addrAndFd[0]
+				if runtime.GOOS != "windows" {
+					fd = gconv.Int(addrAndFd[1])
+				}
+			}
+		}
+		item.Server = s.newGracefulServerWithHandler(address, fd, s.newListenerHandler(item))
+		s.servers = append(s.servers, item.Server)
+	}
+}
+
+// newListenerHandler returns the http handler for additional listener `item`, which marks
+// the listener in request context and filters the request path.
+func (s *Server) newListenerHandler(item *listenerItem) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if len(item.Option.Prefixes) > 0 {
+			var allowed bool
+			for _, prefix := range item.Option.Prefixes {
+				if strings.HasPrefix(r.URL.Path, prefix) {
+					allowed = true
+					break
+				}
+			}
+			if !allowed {
+				http.NotFound(w, r)
+				return
+			}
+		}
+		s.config.Handler(w, r.WithContext(context.WithValue(r.Context(), ctxKeyForListener, item)))
+	}
+}
+
+// isListenerServer checks whether `server` is the underlying server of additional listener.
+func (s *Server) isListenerServer(server *graceful.Server) bool {
+	for _, item := range s.listeners {
+		if item.Server == server {
+			return true
+		}
+	}
+	return false
+}
@@ -7,10 +7,12 @@
 package ghttp
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/internal/instance"
+	"github.com/gogf/gf/v2/internal/intlog"
 	"github.com/gogf/gf/v2/os/glog"
 	"github.com/gogf/gf/v2/text/gstr"
 )
@@ -20,6 +22,10 @@ func (s *Server) handleAccessLog(r *Request) {
 	if !s.IsAccessLogEnabled() {
 		return
 	}
+	// The request having error is always logged regardless of the sampler.
+	if r.error == nil && s.config.AccessLogSampler != nil && !s.config.AccessLogSampler(r) {
+		return
+	}
 	var (
 		scheme            = r.GetSchema()
 		loggerInstanceKey = fmt.Sprintf(`Acccess Logger Of Server:%s`, s.instance)
@@ -30,14 +36,70 @@ func (s *Server) handleAccessLog(r *Request) {
 		float64(r.LeaveTime.Sub(r.EnterTime).Milliseconds())/1000,
 		r.GetClientIp(), r.Referer(), r.UserAgent(),
 	)
+	logger := instance.GetOrSetFuncLock(loggerInstanceKey, func() any {
+		return s.newAccessLogger()
+	}).(*glog.Logger)
+	logger.Print(r.Context(), content)
+}
+
+// newAccessLogger creates and returns the logger for access logging, which is the dedicated AccessLogger
+// if it is set, or else a clone of Logger configured with the access log configurations.
+func (s *Server) newAccessLogger() *glog.Logger {
+	if s.config.AccessLogger != nil {
+		return s.config.AccessLogger
+	}
+	l := s.Logger().Clone()
+	l.SetFile(s.config.AccessLogPattern)
+	l.SetStdoutPrint(s.config.LogStdout)
+	l.SetLevelPrint(false)
+	if s.config.AccessLogWriter != nil || s.config.AccessLogRotateSize > 0 {
+		config := l.GetConfig()
+		if s.config.AccessLogWriter != nil {
+			// The writer replaces the logging files.
+			config.Path = ""
+			config.Writer = s.config.AccessLogWriter
+		}
+		if s.config.AccessLogRotateSize > 0 {
+			config.RotateSize = s.config.AccessLogRotateSize
+			config.RotateBackupLimit = s.config.AccessLogRotateBackupLimit
+		}
+		if err := l.SetConfig(config); err != nil {
+			intlog.Errorf(context.TODO(), `%+v`, err)
+		}
+	}
+	return l
+}
+
+// handleSlowRequestLog handles the slow request logging for server,
+// which logs the request whose latency exceeds the configured threshold.
+func (s *Server) handleSlowRequestLog(r *Request) {
+	if !s.IsSlowRequestLogEnabled() {
+		return
+	}
+	latency := r.LeaveTime.Sub(r.EnterTime)
+	if latency < s.config.SlowRequestThreshold {
+		return
+	}
+	var (
+		route             string
+		loggerInstanceKey = fmt.Sprintf(`Slow Request Logger Of Server:%s`, s.instance)
+	)
+	if r.Router != nil {
+		route = r.Router.Uri
+	}
+	content := fmt.Sprintf(
+		`slow request: %d "%s %s %s %s %s" %.3f, route "%s", threshold %s, %s, "%s", "%s"`,
+		r.Response.Status, r.Method, r.GetSchema(), r.Host, r.URL.String(), r.Proto,
+		latency.Seconds(), route, s.config.SlowRequestThreshold,
+		r.GetClientIp(), r.Referer(), r.UserAgent(),
+	)
 	logger := instance.GetOrSetFuncLock(loggerInstanceKey, func() any {
 		l := s.Logger().Clone()
-		l.SetFile(s.config.AccessLogPattern)
+		l.SetStack(false)
 		l.SetStdoutPrint(s.config.LogStdout)
-		l.SetLevelPrint(false)
 		return l
 	}).(*glog.Logger)
-	logger.Print(r.Context(), content)
+	logger.Warning(r.Context(), content)
 }
 
 // handleErrorLog handles the error logging for server.
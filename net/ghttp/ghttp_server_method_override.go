@@ -0,0 +1,65 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"net/http"
+	"strings"
+)
+
+const (
+	// HeaderMethodOverride is the request header specifying the overriding method of POST request.
+	HeaderMethodOverride = "X-HTTP-Method-Override"
+
+	// FormFieldMethodOverride is the form field specifying the overriding method of POST request.
+	FormFieldMethodOverride = "_method"
+)
+
+// methodOverrideTargets are the methods that POST request can be overridden to.
+var methodOverrideTargets = map[string]struct{}{
+	http.MethodPut:    {},
+	http.MethodPatch:  {},
+	http.MethodDelete: {},
+}
+
+// SetMethodOverride enables or disables overriding the method of POST request before routing, using
+// header `X-HTTP-Method-Override` or form field `_method`, in which the header has priority.
+// It lets the clients supporting only GET/POST like HTML forms reach the PUT/PATCH/DELETE handlers.
+//
+// Only POST request can be overridden, and only to method PUT, PATCH or DELETE, the other values
+// are ignored and the request is served as POST. The form field is read only for the form content
+// types, so the other request bodies like JSON are not parsed before routing.
+func (s *Server) SetMethodOverride(enabled bool) {
+	s.config.MethodOverride = enabled
+}
+
+// handleMethodOverride overrides the method of POST request `r` if it is enabled.
+func (s *Server) handleMethodOverride(r *Request) {
+	if !s.config.MethodOverride || r.Method != http.MethodPost {
+		return
+	}
+	var method = r.Header.Get(HeaderMethodOverride)
+	if method == "" && strings.Contains(r.Header.Get("Content-Type"), "form") {
+		method = r.getMethodOverrideFormValue()
+	}
+	method = strings.ToUpper(strings.TrimSpace(method))
+	if _, ok := methodOverrideTargets[method]; ok {
+		r.Method = method
+	}
+}
+
+// getMethodOverrideFormValue retrieves and returns the form field of overriding method.
+// The form parsing error is left to the handlers, which is reported when they retrieve the form.
+func (r *Request) getMethodOverrideFormValue() (method string) {
+	defer func() {
+		if exception := recover(); exception != nil {
+			r.parsedForm = false
+			method = ""
+		}
+	}()
+	return r.GetForm(FormFieldMethodOverride).String()
+}
@@ -17,12 +17,13 @@ import (
 )
 
 type localMetricManager struct {
-	HttpServerRequestActive        gmetric.UpDownCounter
-	HttpServerRequestTotal         gmetric.Counter
-	HttpServerRequestDuration      gmetric.Histogram
-	HttpServerRequestDurationTotal gmetric.Counter
-	HttpServerRequestBodySize      gmetric.Counter
-	HttpServerResponseBodySize     gmetric.Counter
+	HttpServerRequestActive          gmetric.UpDownCounter
+	HttpServerRequestTotal           gmetric.Counter
+	HttpServerRequestDuration        gmetric.Histogram
+	HttpServerRequestDurationTotal   gmetric.Counter
+	HttpServerRequestBodySize        gmetric.Counter
+	HttpServerResponseBodySize       gmetric.Counter
+	HttpServerRequestDeprecatedTotal gmetric.Counter
 }
 
 const (
@@ -114,6 +115,14 @@ func newMetricManager() *localMetricManager {
 				Attributes: gmetric.Attributes{},
 			},
 		),
+		HttpServerRequestDeprecatedTotal: meter.MustCounter(
+			"http.server.request.deprecated_total",
+			gmetric.MetricOption{
+				Help:       "Total processed request number of deprecated routes.",
+				Unit:       "",
+				Attributes: gmetric.Attributes{},
+			},
+		),
 	}
 	return mm
 }
@@ -235,6 +244,9 @@ func (s *Server) handleMetricsAfterRequestDone(r *Request) {
 		histogramOption = metricManager.GetMetricOptionForRequestDurationByMap(attrMap)
 	)
 	metricManager.HttpServerRequestTotal.Inc(ctx, responseOption)
+	if handler := r.GetServeHandler(); handler != nil && handler.Handler.Deprecation != nil {
+		metricManager.HttpServerRequestDeprecatedTotal.Inc(ctx, responseOption)
+	}
 	metricManager.HttpServerRequestActive.Dec(
 		ctx,
 		metricManager.GetMetricOptionForRequestByMap(attrMap),
@@ -0,0 +1,92 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gmode"
+)
+
+// maxRouteSuggestions is the max count of closest routes in NotFoundDetail.
+const maxRouteSuggestions = 5
+
+// NotFoundDetail is the details of request matching no route, which is rendered as JSON content
+// if the not found details are enabled, see SetNotFoundDetail.
+type NotFoundDetail struct {
+	Code        int               `json:"code"        dc:"Error code"`
+	Message     string            `json:"message"     dc:"Error message"`
+	Method      string            `json:"method"      dc:"Attempted request method"`
+	Path        string            `json:"path"        dc:"Attempted request path"`
+	Suggestions []RouteSuggestion `json:"suggestions" dc:"Closest registered routes"`
+}
+
+// RouteSuggestion is the registered route close to the request path of NotFoundDetail.
+type RouteSuggestion struct {
+	Method   string `json:"method"   dc:"HTTP method, eg: GET, POST, ALL"`
+	Pattern  string `json:"pattern"  dc:"Route URI pattern, eg: /user/{id}"`
+	Distance int    `json:"distance" dc:"Edit distance between the pattern and the request path"`
+}
+
+// SetNotFoundDetail enables or disables rendering the details of request matching no route as JSON
+// content, which contains the attempted method and path and the closest registered routes by edit
+// distance on the path, which is convenient for debugging in development.
+//
+// It takes effect only if the application is running in DEVELOP mode, which can be specified by
+// command option or environment variable `gf.gmode`. The route table is never exposed in other modes.
+func (s *Server) SetNotFoundDetail(enabled bool) {
+	s.config.NotFoundDetail = enabled
+}
+
+// handleNotFound responds the request matching no route with http.StatusNotFound, along with the
+// not found details if it is enabled.
+func (s *Server) handleNotFound(r *Request) {
+	r.Response.WriteHeader(http.StatusNotFound)
+	if !s.config.NotFoundDetail || !gmode.IsDevelop() || r.Response.BufferLength() > 0 {
+		return
+	}
+	r.Response.WriteJson(NotFoundDetail{
+		Code:        gcode.CodeNotFound.Code(),
+		Message:     gcode.CodeNotFound.Message(),
+		Method:      r.Method,
+		Path:        r.URL.Path,
+		Suggestions: s.getRouteSuggestions(r),
+	})
+}
+
+// getRouteSuggestions retrieves and returns the registered routes of the request domain that are
+// closest to the request path, which are sorted by edit distance in ASC order.
+func (s *Server) getRouteSuggestions(r *Request) []RouteSuggestion {
+	var (
+		host        = r.GetHost()
+		path        = r.URL.Path
+		suggestions = make([]RouteSuggestion, 0)
+	)
+	for _, route := range s.Routes() {
+		if route.Type == HandlerTypeMiddleware || route.Type == HandlerTypeHook {
+			continue
+		}
+		if route.Domain != DefaultDomainName && route.Domain != host {
+			continue
+		}
+		suggestions = append(suggestions, RouteSuggestion{
+			Method:   route.Method,
+			Pattern:  route.Pattern,
+			Distance: gstr.Levenshtein(path, route.Pattern, 1, 1, 1),
+		})
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].Distance < suggestions[j].Distance
+	})
+	if len(suggestions) > maxRouteSuggestions {
+		suggestions = suggestions[:maxRouteSuggestions]
+	}
+	return suggestions
+}
@@ -8,6 +8,7 @@ package ghttp
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/gogf/gf/v2/net/goai"
 	"github.com/gogf/gf/v2/text/gstr"
@@ -33,15 +34,20 @@ func (s *Server) initOpenApi() {
 			if gstr.Equal(item.Method, defaultMethod) {
 				methods = SupportedMethods()
 			}
+			// The regular expression constraints are not a part of OpenAPI path.
+			path, _ := trimRouterConstraints(item.Route)
 			for _, method := range methods {
 				err = s.openapi.Add(goai.AddInput{
-					Path:   item.Route,
+					Path:   path,
 					Method: method,
 					Object: item.Handler.Info.Value.Interface(),
 				})
 				if err != nil {
 					s.Logger().Fatalf(ctx, `%+v`, err)
 				}
+				if item.Handler.Deprecation != nil {
+					s.markOpenApiOperationDeprecated(path, method)
+				}
 			}
 		}
 	}
@@ -55,3 +61,35 @@ func (s *Server) openapiSpec(r *Request) {
 		r.Response.WriteJson(s.openapi)
 	}
 }
+
+// markOpenApiOperationDeprecated marks the operation of `path` and `method` deprecated in OpenAPI specification.
+func (s *Server) markOpenApiOperationDeprecated(path, method string) {
+	pathItem, ok := s.openapi.Paths[path]
+	if !ok {
+		return
+	}
+	var operation *goai.Operation
+	switch gstr.ToUpper(method) {
+	case http.MethodGet:
+		operation = pathItem.Get
+	case http.MethodPut:
+		operation = pathItem.Put
+	case http.MethodPost:
+		operation = pathItem.Post
+	case http.MethodDelete:
+		operation = pathItem.Delete
+	case http.MethodConnect:
+		operation = pathItem.Connect
+	case http.MethodHead:
+		operation = pathItem.Head
+	case http.MethodOptions:
+		operation = pathItem.Options
+	case http.MethodPatch:
+		operation = pathItem.Patch
+	case http.MethodTrace:
+		operation = pathItem.Trace
+	}
+	if operation != nil {
+		operation.Deprecated = true
+	}
+}
@@ -7,6 +7,7 @@
 package ghttp
 
 import (
+	"net/http"
 	netpprof "net/http/pprof"
 	runpprof "runtime/pprof"
 	"strings"
@@ -37,16 +38,43 @@ func (s *Server) EnablePProf(pattern ...string) {
 	s.Domain(DefaultDomainName).EnablePProf(pattern...)
 }
 
+// EnablePProfWithGuard enables PProf feature for server, which is guarded by `guard`.
+// See Domain.EnablePProfWithGuard.
+func (s *Server) EnablePProfWithGuard(pattern string, guard func(r *Request) bool) {
+	s.Domain(DefaultDomainName).EnablePProfWithGuard(pattern, guard)
+}
+
 // EnablePProf enables PProf feature for server of specified domain.
 func (d *Domain) EnablePProf(pattern ...string) {
 	p := defaultPProfPattern
-	if len(pattern) > 0 && pattern[0] != "" {
+	if len(pattern) > 0 {
 		p = pattern[0]
 	}
+	d.EnablePProfWithGuard(p, nil)
+}
+
+// EnablePProfWithGuard enables PProf feature for server of specified domain, in which the PProf
+// handlers are served only if `guard` returns true for the request, or else it responds with
+// http.StatusForbidden. The `guard` is called after the global and domain middlewares, so it can
+// make use of the identity that the authentication middleware stores in the request context.
+// It is the same as EnablePProf if `guard` is nil.
+func (d *Domain) EnablePProfWithGuard(pattern string, guard func(r *Request) bool) {
+	if pattern == "" {
+		pattern = defaultPProfPattern
+	}
 	up := &utilPProf{}
-	_, _, uri, _ := d.server.parsePattern(p)
+	_, _, uri, _ := d.server.parsePattern(pattern)
 	uri = strings.TrimRight(uri, "/")
 	d.Group(uri, func(group *RouterGroup) {
+		if guard != nil {
+			group.Middleware(func(r *Request) {
+				if !guard(r) {
+					r.Response.WriteStatus(http.StatusForbidden)
+					return
+				}
+				r.Middleware.Next()
+			})
+		}
 		group.ALL("/*action", up.Index)
 		group.ALL("/cmdline", up.Cmdline)
 		group.ALL("/profile", up.Profile)
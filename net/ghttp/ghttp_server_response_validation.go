@@ -0,0 +1,296 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"encoding"
+	"fmt"
+	"math"
+	"mime"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gogf/gf/v2/internal/json"
+	"github.com/gogf/gf/v2/util/gmode"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*interface{ MarshalJSON() ([]byte, error) })(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// EnableResponseValidation enables validating the JSON response body of the strict route handler
+// like `func(context.Context, *BizReq)(*BizRes, error)` against its declared response type `BizRes`,
+// which logs a warning for each mismatch, like missing or extra fields and wrong value types.
+// It helps catching the drift between the API definition and its implementation in development.
+//
+// The response body is validated before it is output. If the body is wrapped by common response
+// like DefaultHandlerResponse, its `data` field is validated, or the field specified by
+// CommonResponseDataField of the OpenApi configuration.
+//
+// It takes effect only if the application is running in DEVELOP mode, which can be specified by
+// command option or environment variable `gf.gmode`. It is skipped entirely in other modes.
+func (s *Server) EnableResponseValidation() {
+	s.config.ResponseValidation = true
+}
+
+// handleResponseValidation validates the buffered JSON response body of the request against the
+// declared response type of its handler, and logs the mismatches as warning.
+func (s *Server) handleResponseValidation(r *Request) {
+	if !s.config.ResponseValidation || !gmode.IsDevelop() {
+		return
+	}
+	if r.serveHandler == nil || !r.serveHandler.Handler.Info.IsStrictRoute {
+		return
+	}
+	if r.Response.Status != http.StatusOK || r.GetError() != nil || r.Response.BufferLength() == 0 {
+		return
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Response.Header().Get("Content-Type"))
+	if mediaType != contentTypeJson {
+		return
+	}
+	var body any
+	if err := json.Unmarshal(r.Response.Buffer(), &body); err != nil {
+		s.Logger().Warningf(
+			r.Context(), `response validation failed: %s %s: invalid JSON body: %v`,
+			r.Method, r.URL.Path, err,
+		)
+		return
+	}
+	var (
+		data, path = s.getResponseValidationData(body)
+		mismatches = validateResponseValue(
+			path, data, r.serveHandler.Handler.Info.Type.Out(0), make([]string, 0),
+		)
+	)
+	if len(mismatches) == 0 {
+		return
+	}
+	s.Logger().Warningf(
+		r.Context(), `response validation failed: %s %s: %s`,
+		r.Method, r.URL.Path, strings.Join(mismatches, "; "),
+	)
+}
+
+// getResponseValidationData retrieves and returns the response data of `body` to be validated
+// along with its path, which unwraps the common response if necessary.
+func (s *Server) getResponseValidationData(body any) (data any, path string) {
+	bodyMap, ok := body.(map[string]any)
+	if !ok {
+		return body, ""
+	}
+	if s.openapi != nil && s.openapi.Config.CommonResponseDataField != "" {
+		data = bodyMap
+		for _, name := range strings.Split(s.openapi.Config.CommonResponseDataField, ".") {
+			if m, ok := data.(map[string]any); ok {
+				data = m[name]
+			} else {
+				return body, ""
+			}
+		}
+		return data, s.openapi.Config.CommonResponseDataField
+	}
+	// It is considered as DefaultHandlerResponse.
+	if len(bodyMap) == 3 {
+		_, hasCode := bodyMap["code"]
+		_, hasMessage := bodyMap["message"]
+		if data, hasData := bodyMap["data"]; hasCode && hasMessage && hasData {
+			return data, "data"
+		}
+	}
+	return body, ""
+}
+
+// validateResponseValue validates the JSON decoded `value` against type `t` recursively,
+// and returns the mismatches appended to `mismatches`.
+func validateResponseValue(path string, value any, t reflect.Type, mismatches []string) []string {
+	// The type marshals itself, its JSON content is not predictable.
+	if t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType) {
+		return mismatches
+	}
+	if t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType) {
+		if _, ok := value.(string); !ok && value != nil {
+			return appendTypeMismatch(mismatches, path, "string", value)
+		}
+		return mismatches
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		if value == nil {
+			return mismatches
+		}
+		return validateResponseValue(path, value, t.Elem(), mismatches)
+
+	case reflect.Interface:
+		return mismatches
+
+	case reflect.Struct:
+		m, ok := value.(map[string]any)
+		if !ok {
+			return appendTypeMismatch(mismatches, path, "object", value)
+		}
+		fields := make(map[string]struct{})
+		mismatches = validateResponseStruct(path, m, t, fields, mismatches)
+		extraKeys := make([]string, 0)
+		for key := range m {
+			if _, ok = fields[key]; !ok {
+				extraKeys = append(extraKeys, key)
+			}
+		}
+		sort.Strings(extraKeys)
+		for _, key := range extraKeys {
+			mismatches = append(mismatches, fmt.Sprintf(`%s: extra field`, joinResponsePath(path, key)))
+		}
+		return mismatches
+
+	case reflect.Map:
+		if value == nil {
+			return mismatches
+		}
+		m, ok := value.(map[string]any)
+		if !ok {
+			return appendTypeMismatch(mismatches, path, "object", value)
+		}
+		keys := make([]string, 0, len(m))
+		for key := range m {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			mismatches = validateResponseValue(joinResponsePath(path, key), m[key], t.Elem(), mismatches)
+		}
+		return mismatches
+
+	case reflect.Slice, reflect.Array:
+		if value == nil && t.Kind() == reflect.Slice {
+			return mismatches
+		}
+		// The []byte is encoded as base64 string.
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			if _, ok := value.(string); !ok {
+				return appendTypeMismatch(mismatches, path, "string", value)
+			}
+			return mismatches
+		}
+		array, ok := value.([]any)
+		if !ok {
+			return appendTypeMismatch(mismatches, path, "array", value)
+		}
+		for i, item := range array {
+			mismatches = validateResponseValue(fmt.Sprintf(`%s[%d]`, path, i), item, t.Elem(), mismatches)
+		}
+		return mismatches
+
+	case reflect.String:
+		if _, ok := value.(string); !ok {
+			return appendTypeMismatch(mismatches, path, "string", value)
+		}
+
+	case reflect.Bool:
+		if _, ok := value.(bool); !ok {
+			return appendTypeMismatch(mismatches, path, "boolean", value)
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if f, ok := value.(float64); !ok || f != math.Trunc(f) {
+			return appendTypeMismatch(mismatches, path, "integer", value)
+		}
+
+	case reflect.Float32, reflect.Float64:
+		if _, ok := value.(float64); !ok {
+			return appendTypeMismatch(mismatches, path, "number", value)
+		}
+
+	default:
+	}
+	return mismatches
+}
+
+// validateResponseStruct validates the attributes of struct type `t` against `m`, which records the
+// JSON names of the attributes in `fields`. The embedded struct attributes are validated as promoted.
+func validateResponseStruct(
+	path string, m map[string]any, t reflect.Type, fields map[string]struct{}, mismatches []string,
+) []string {
+	for i := 0; i < t.NumField(); i++ {
+		var (
+			field         = t.Field(i)
+			name          = field.Name
+			fieldType     = field.Type
+			tag, hasTag   = field.Tag.Lookup("json")
+			tagName, opts = tag, ""
+		)
+		if index := strings.Index(tag, ","); index != -1 {
+			tagName, opts = tag[:index], tag[index:]
+		}
+		if tagName == "-" && opts == "" {
+			continue
+		}
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && tagName == "" && fieldType.Kind() == reflect.Struct {
+			mismatches = validateResponseStruct(path, m, fieldType, fields, mismatches)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if hasTag && tagName != "" {
+			name = tagName
+		}
+		fields[name] = struct{}{}
+		value, ok := m[name]
+		if !ok {
+			if !strings.Contains(opts, ",omitempty") && !strings.Contains(opts, ",omitzero") {
+				mismatches = append(mismatches, fmt.Sprintf(`%s: missing field`, joinResponsePath(path, name)))
+			}
+			continue
+		}
+		if strings.Contains(opts, ",string") {
+			continue
+		}
+		mismatches = validateResponseValue(joinResponsePath(path, name), value, field.Type, mismatches)
+	}
+	return mismatches
+}
+
+// appendTypeMismatch appends the mismatch of wrong value type to `mismatches`.
+func appendTypeMismatch(mismatches []string, path, expect string, value any) []string {
+	var actual string
+	switch value.(type) {
+	case nil:
+		actual = "null"
+	case map[string]any:
+		actual = "object"
+	case []any:
+		actual = "array"
+	case string:
+		actual = "string"
+	case bool:
+		actual = "boolean"
+	case float64:
+		actual = "number"
+	default:
+		actual = fmt.Sprintf(`%T`, value)
+	}
+	if path == "" {
+		path = "(root)"
+	}
+	return append(mismatches, fmt.Sprintf(`%s: expect %s but got %s`, path, expect, actual))
+}
+
+// joinResponsePath joins the parent path and attribute name as dotted path.
+func joinResponsePath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
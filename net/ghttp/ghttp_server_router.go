@@ -7,9 +7,11 @@
 package ghttp
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"reflect"
+	"regexp"
 	"runtime"
 	"strings"
 
@@ -19,6 +21,7 @@ import (
 	"github.com/gogf/gf/v2/errors/gcode"
 	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/internal/consts"
+	"github.com/gogf/gf/v2/internal/intlog"
 	"github.com/gogf/gf/v2/text/gregex"
 	"github.com/gogf/gf/v2/text/gstr"
 	"github.com/gogf/gf/v2/util/gmeta"
@@ -42,7 +45,7 @@ func (s *Server) parsePattern(pattern string) (domain, method, path string, err
 	path = strings.TrimSpace(pattern)
 	domain = DefaultDomainName
 	method = defaultMethod
-	if array, err := gregex.MatchString(`([a-zA-Z]+):(.+)`, pattern); len(array) > 1 && err == nil {
+	if array, err := gregex.MatchString(`^\s*([a-zA-Z]+):(.+)`, pattern); len(array) > 1 && err == nil {
 		path = strings.TrimSpace(array[2])
 		if v := strings.TrimSpace(array[1]); v != "" {
 			method = v
@@ -148,6 +151,10 @@ func (s *Server) doSetHandler(
 		s.Logger().Fatalf(ctx, `invalid pattern "%s", URI should lead with '/'`, pattern)
 	}
 
+	// The route tree might be searched by requests under serving if it's registering at runtime.
+	s.routerMu.Lock()
+	defer s.routerMu.Unlock()
+
 	// Repeated router checks, this feature can be disabled by server configuration.
 	var routerKey = s.routerMapKey(handler.HookName, method, uri, domain)
 	if !s.config.RouteOverWrite {
@@ -184,7 +191,11 @@ func (s *Server) doSetHandler(
 		Method:   strings.ToUpper(method),
 		Priority: strings.Count(uri[1:], "/"),
 	}
-	handler.Router.RegRule, handler.Router.RegNames = s.patternToRegular(uri)
+	var err error
+	handler.Router.RegRule, handler.Router.RegNames, err = s.patternToRegular(uri)
+	if err != nil {
+		s.Logger().Fatalf(ctx, `invalid pattern "%s", %+v`, pattern, err)
+	}
 
 	if _, ok := s.serveTree[domain]; !ok {
 		s.serveTree[domain] = make(map[string]any)
@@ -219,7 +230,7 @@ func (s *Server) doSetHandler(
 			continue
 		}
 		// Check if it's a fuzzy node.
-		if gregex.IsMatchString(`^[:\*]|\{[\w\.\-]+\}|\*`, part) {
+		if gregex.IsMatchString(`^[:\*]|\{[\w\.\-]+(:.+)?\}|\*`, part) {
 			part = "*fuzz"
 			// If it's a fuzzy node, it creates a "*list" item - which is a list - in the hash map.
 			// All the sub router items from this fuzzy node will also be added to its "*list" item.
@@ -279,6 +290,77 @@ func (s *Server) doSetHandler(
 
 	// Append the route.
 	s.routesMap[routerKey] = append(s.routesMap[routerKey], handler)
+	// Clear the searching cache in case that the route is registered when server is running.
+	s.clearServeCache(ctx)
+}
+
+// unsetHandler removes the serving handlers registered with given method, uri and domain from the
+// route tree, and returns whether any handler is removed. It does not remove the middleware and
+// hook handlers registered with the same route.
+func (s *Server) unsetHandler(ctx context.Context, method, uri, domain string) bool {
+	if uri != "/" {
+		uri = strings.TrimRight(uri, "/")
+	}
+	s.routerMu.Lock()
+	defer s.routerMu.Unlock()
+	var (
+		routerKey      = s.routerMapKey("", method, uri, domain)
+		items, ok      = s.routesMap[routerKey]
+		removedItemMap = make(map[*HandlerItem]struct{})
+		remainingItems = make([]*HandlerItem, 0, len(items))
+	)
+	if !ok {
+		return false
+	}
+	for _, item := range items {
+		switch item.Type {
+		case HandlerTypeHandler, HandlerTypeObject:
+			removedItemMap[item] = struct{}{}
+		default:
+			remainingItems = append(remainingItems, item)
+		}
+	}
+	if len(removedItemMap) == 0 {
+		return false
+	}
+	if len(remainingItems) > 0 {
+		s.routesMap[routerKey] = remainingItems
+	} else {
+		delete(s.routesMap, routerKey)
+	}
+	if node, ok := s.serveTree[domain]; ok {
+		s.removeHandlersFromTreeNode(node.(map[string]any), removedItemMap)
+	}
+	s.clearServeCache(ctx)
+	return true
+}
+
+// removeHandlersFromTreeNode removes the handler items in `itemMap` from the lists of tree `node`
+// and all its sub-nodes, as the item might be added to the lists of multiple fuzzy nodes.
+func (s *Server) removeHandlersFromTreeNode(node map[string]any, itemMap map[*HandlerItem]struct{}) {
+	for key, value := range node {
+		if key == "*list" {
+			var (
+				list = value.(*glist.List)
+				next *glist.Element
+			)
+			for e := list.Front(); e != nil; e = next {
+				next = e.Next()
+				if _, ok := itemMap[e.Value.(*HandlerItem)]; ok {
+					list.Remove(e)
+				}
+			}
+			continue
+		}
+		s.removeHandlersFromTreeNode(value.(map[string]any), itemMap)
+	}
+}
+
+// clearServeCache clears the cached handlers of route searching.
+func (s *Server) clearServeCache(ctx context.Context) {
+	if err := s.serveCache.Clear(ctx); err != nil {
+		intlog.Errorf(ctx, `%+v`, err)
+	}
 }
 
 func (s *Server) isValidMethod(method string) bool {
@@ -296,7 +378,7 @@ func (s *Server) isValidMethod(method string) bool {
 // Comparison rules:
 // 1. The middleware has the most high priority.
 // 2. URI: The deeper, the higher (simply check the count of char '/' in the URI).
-// 3. Route type: {xxx} > :xxx > *xxx.
+// 3. Route type: {xxx:regex} > {xxx} > :xxx > *xxx.
 func (s *Server) compareRouterPriority(newItem *HandlerItem, oldItem *HandlerItem) bool {
 	// If they're all types of middleware, the priority is according to their registered sequence.
 	if newItem.Type == HandlerTypeMiddleware && oldItem.Type == HandlerTypeMiddleware {
@@ -320,9 +402,13 @@ func (s *Server) compareRouterPriority(newItem *HandlerItem, oldItem *HandlerIte
 	// Example:
 	// /admin-goods-{page} > /admin-{page}
 	// /{hash}.{type}      > /{hash}
-	var uriNew, uriOld string
-	uriNew, _ = gregex.ReplaceString(`\{[^/]+?\}`, "", newItem.Router.Uri)
-	uriOld, _ = gregex.ReplaceString(`\{[^/]+?\}`, "", oldItem.Router.Uri)
+	var (
+		uriNew, uriOld                    string
+		trimmedUriNew, constraintCountNew = trimRouterConstraints(newItem.Router.Uri)
+		trimmedUriOld, constraintCountOld = trimRouterConstraints(oldItem.Router.Uri)
+	)
+	uriNew, _ = gregex.ReplaceString(`\{[^/]+?\}`, "", trimmedUriNew)
+	uriOld, _ = gregex.ReplaceString(`\{[^/]+?\}`, "", trimmedUriOld)
 	uriNew, _ = gregex.ReplaceString(`:[^/]+?`, "", uriNew)
 	uriOld, _ = gregex.ReplaceString(`:[^/]+?`, "", uriOld)
 	uriNew, _ = gregex.ReplaceString(`\*[^/]*`, "", uriNew) // Replace "/*" and "/*any".
@@ -334,7 +420,7 @@ func (s *Server) compareRouterPriority(newItem *HandlerItem, oldItem *HandlerIte
 		return false
 	}
 
-	// Route type checks: {xxx} > :xxx > *xxx.
+	// Route type checks: {xxx:regex} > {xxx} > :xxx > *xxx.
 	// Example:
 	// /name/act > /{name}/:act
 	var (
@@ -347,7 +433,7 @@ func (s *Server) compareRouterPriority(newItem *HandlerItem, oldItem *HandlerIte
 		fuzzyCountTotalNew int
 		fuzzyCountTotalOld int
 	)
-	for _, v := range newItem.Router.Uri {
+	for _, v := range trimmedUriNew {
 		switch v {
 		case '{':
 			fuzzyCountFieldNew++
@@ -357,7 +443,7 @@ func (s *Server) compareRouterPriority(newItem *HandlerItem, oldItem *HandlerIte
 			fuzzyCountAnyNew++
 		}
 	}
-	for _, v := range oldItem.Router.Uri {
+	for _, v := range trimmedUriOld {
 		switch v {
 		case '{':
 			fuzzyCountFieldOld++
@@ -378,6 +464,13 @@ func (s *Server) compareRouterPriority(newItem *HandlerItem, oldItem *HandlerIte
 
 	// If the counts of their fuzzy rules are equal.
 
+	// Eg: /name/{id:\d+} > /name/{id}
+	if constraintCountNew > constraintCountOld {
+		return true
+	}
+	if constraintCountNew < constraintCountOld {
+		return false
+	}
 	// Eg: /name/{act} > /name/:act
 	if fuzzyCountFieldNew > fuzzyCountFieldOld {
 		return true
@@ -414,9 +507,11 @@ func (s *Server) compareRouterPriority(newItem *HandlerItem, oldItem *HandlerIte
 }
 
 // patternToRegular converts route rule to according to regular expression.
-func (s *Server) patternToRegular(rule string) (regular string, names []string) {
+// The field in rule can have a regular expression constraint, eg: `/user/{id:\d+}`,
+// which should not contain capturing group and char '/'.
+func (s *Server) patternToRegular(rule string) (regular string, names []string, err error) {
 	if len(rule) < 2 {
-		return rule, nil
+		return rule, nil, nil
 	}
 	regular = "^"
 	var array = strings.Split(rule[1:], "/")
@@ -440,23 +535,109 @@ func (s *Server) patternToRegular(rule string) (regular string, names []string)
 				regular += `/{0,1}.*`
 			}
 		default:
-			// Special chars replacement.
-			v = gstr.ReplaceByMap(v, map[string]string{
-				`.`: `\.`,
-				`+`: `\+`,
-				`*`: `.*`,
-			})
-			s, _ := gregex.ReplaceStringFunc(`\{[\w\.\-]+\}`, v, func(s string) string {
-				names = append(names, s[1:len(s)-1])
-				return `([^/]+)`
-			})
-			if strings.EqualFold(s, v) {
-				regular += "/" + v
-			} else {
-				regular += "/" + s
+			var (
+				partRegular string
+				partNames   []string
+			)
+			if partRegular, partNames, err = fieldPartToRegular(v); err != nil {
+				return "", nil, err
 			}
+			regular += "/" + partRegular
+			names = append(names, partNames...)
 		}
 	}
 	regular += `$`
 	return
 }
+
+// fieldPartToRegular converts the route part that might contain fields like `{name}` and
+// `{name:regex}` to regular expression. The special chars out of fields are escaped.
+func fieldPartToRegular(part string) (regular string, names []string, err error) {
+	var buffer = bytes.NewBuffer(nil)
+	for i := 0; i < len(part); i++ {
+		if part[i] == '{' {
+			if end := searchFieldEnd(part, i); end > 0 {
+				name, constraint, hasConstraint := strings.Cut(part[i+1:end], ":")
+				if gregex.IsMatchString(`^[\w\.\-]+$`, name) {
+					if !hasConstraint {
+						buffer.WriteString(`([^/]+)`)
+					} else {
+						reg, err := regexp.Compile(constraint)
+						if err != nil {
+							return "", nil, gerror.Wrapf(err, `invalid regular expression constraint "%s"`, constraint)
+						}
+						if reg.NumSubexp() > 0 {
+							return "", nil, gerror.NewCodef(
+								gcode.CodeInvalidParameter,
+								`regular expression constraint "%s" should not contain capturing group, use "(?:...)" instead`,
+								constraint,
+							)
+						}
+						buffer.WriteString(`(` + constraint + `)`)
+					}
+					names = append(names, name)
+					i = end
+					continue
+				}
+			}
+		}
+		// Special chars replacement.
+		switch part[i] {
+		case '.':
+			buffer.WriteString(`\.`)
+		case '+':
+			buffer.WriteString(`\+`)
+		case '*':
+			buffer.WriteString(`.*`)
+		default:
+			buffer.WriteByte(part[i])
+		}
+	}
+	return buffer.String(), names, nil
+}
+
+// searchFieldEnd searches and returns the index of char '}' matching the char '{' at `start` of `part`,
+// which considers the nested braces in regular expression constraint, eg: `{id:\d{3}}`.
+// It returns -1 if not found.
+func searchFieldEnd(part string, start int) int {
+	var depth = 0
+	for i := start; i < len(part); i++ {
+		switch part[i] {
+		case '\\':
+			// Escaped char.
+			i++
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// trimRouterConstraints removes the regular expression constraints of fields from `uri`,
+// and returns the trimmed uri and the count of removed constraints, eg:
+// `/user/{id:\d+}` -> `/user/{id}`.
+func trimRouterConstraints(uri string) (trimmed string, count int) {
+	if !strings.Contains(uri, ":") {
+		return uri, 0
+	}
+	var buffer = bytes.NewBuffer(nil)
+	for i := 0; i < len(uri); i++ {
+		if uri[i] == '{' {
+			if end := searchFieldEnd(uri, i); end > 0 {
+				if name, _, ok := strings.Cut(uri[i+1:end], ":"); ok {
+					buffer.WriteString("{" + name + "}")
+					count++
+					i = end
+					continue
+				}
+			}
+		}
+		buffer.WriteByte(uri[i])
+	}
+	return buffer.String(), count
+}
@@ -21,11 +21,12 @@ import (
 type (
 	// RouterGroup is a group wrapping multiple routes and middleware.
 	RouterGroup struct {
-		parent     *RouterGroup  // Parent group.
-		server     *Server       // Server.
-		domain     *Domain       // Domain.
-		prefix     string        // Prefix for sub-route.
-		middleware []HandlerFunc // Middleware array.
+		parent       *RouterGroup     // Parent group.
+		server       *Server          // Server.
+		domain       *Domain          // Domain.
+		prefix       string           // Prefix for sub-route.
+		middleware   []HandlerFunc    // Middleware array.
+		errorHandler ErrorHandlerFunc // Custom error handler for routes of the group.
 	}
 
 	// preBindItem is item for lazy registering feature of router group. preBindItem is not really registered
@@ -140,11 +141,12 @@ func (g *RouterGroup) Group(prefix string, groups ...func(group *RouterGroup)) *
 // Clone returns a new router group which is a clone of the current group.
 func (g *RouterGroup) Clone() *RouterGroup {
 	newGroup := &RouterGroup{
-		parent:     g.parent,
-		server:     g.server,
-		domain:     g.domain,
-		prefix:     g.prefix,
-		middleware: make([]HandlerFunc, len(g.middleware)),
+		parent:       g.parent,
+		server:       g.server,
+		domain:       g.domain,
+		prefix:       g.prefix,
+		middleware:   make([]HandlerFunc, len(g.middleware)),
+		errorHandler: g.errorHandler,
 	}
 	copy(newGroup.middleware, g.middleware)
 	return newGroup
@@ -259,6 +261,15 @@ func (g *RouterGroup) Hook(pattern string, hook HookName, handler HandlerFunc) *
 	return g.Clone().preBindToLocalArray(groupBindTypeHandler, pattern, handler, hook)
 }
 
+// SetErrorHandler sets the custom error handler for the routes of the group, which overrides the
+// default error handling of the server, eg: rendering the error of handler panics.
+// The error handler of the most specific group takes effect if there are multiple error handlers
+// set in nested groups. Note that the error is still recorded in the request for logging.
+func (g *RouterGroup) SetErrorHandler(handler ErrorHandlerFunc) *RouterGroup {
+	g.errorHandler = handler
+	return g
+}
+
 // Middleware binds one or more middleware to the router group.
 func (g *RouterGroup) Middleware(handlers ...HandlerFunc) *RouterGroup {
 	g.middleware = append(g.middleware, handlers...)
@@ -290,6 +301,17 @@ func (g *RouterGroup) getPrefix() string {
 	return prefix
 }
 
+// getErrorHandler retrieves the error handler of the group, which searches the parent groups
+// if the group has no error handler. It returns nil if there's no error handler found.
+func (g *RouterGroup) getErrorHandler() ErrorHandlerFunc {
+	for group := g; group != nil; group = group.parent {
+		if group.errorHandler != nil {
+			return group.errorHandler
+		}
+	}
+	return nil
+}
+
 // doBindRoutersToServer does really register for the group.
 func (g *RouterGroup) doBindRoutersToServer(ctx context.Context, item *preBindItem) *RouterGroup {
 	var (
@@ -321,8 +343,12 @@ func (g *RouterGroup) doBindRoutersToServer(ctx context.Context, item *preBindIt
 	// Filter repeated char '/'.
 	pattern = gstr.Replace(pattern, "//", "/")
 
-	// Convert params to a string array.
+	// Convert params to a string array, the route options are separated in advance.
+	params, options := splitRouteOptions(params)
 	extras := gconv.Strings(params)
+	if errorHandler := g.getErrorHandler(); errorHandler != nil {
+		options = append(options, routeErrorHandler(errorHandler))
+	}
 
 	// Check whether it's a hook handler.
 	if _, ok := object.(HandlerFunc); ok && len(extras) > 0 {
@@ -342,6 +368,7 @@ func (g *RouterGroup) doBindRoutersToServer(ctx context.Context, item *preBindIt
 				FuncInfo:   funcInfo,
 				Middleware: g.middleware,
 				Source:     source,
+				Options:    options,
 			}
 			if g.domain != nil {
 				g.domain.doBindHandler(ctx, in)
@@ -358,6 +385,7 @@ func (g *RouterGroup) doBindRoutersToServer(ctx context.Context, item *preBindIt
 						Method:     extras[0],
 						Middleware: g.middleware,
 						Source:     source,
+						Options:    options,
 					}
 					if g.domain != nil {
 						g.domain.doBindObject(ctx, in)
@@ -372,6 +400,7 @@ func (g *RouterGroup) doBindRoutersToServer(ctx context.Context, item *preBindIt
 						Method:     extras[0],
 						Middleware: g.middleware,
 						Source:     source,
+						Options:    options,
 					}
 					if g.domain != nil {
 						g.domain.doBindObjectMethod(ctx, in)
@@ -387,6 +416,7 @@ func (g *RouterGroup) doBindRoutersToServer(ctx context.Context, item *preBindIt
 					Method:     "",
 					Middleware: g.middleware,
 					Source:     source,
+					Options:    options,
 				}
 				// Finally, it treats the `object` as the Object registering type.
 				if g.domain != nil {
@@ -405,6 +435,7 @@ func (g *RouterGroup) doBindRoutersToServer(ctx context.Context, item *preBindIt
 			Method:     "",
 			Middleware: g.middleware,
 			Source:     source,
+			Options:    options,
 		}
 		if g.domain != nil {
 			g.domain.doBindObjectRest(ctx, in)
@@ -0,0 +1,78 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+// Routes retrieves and returns the route table of the server, sorted by domain, pattern, method
+// and handler type in ASC order.
+//
+// It can be called before the server starts, as it registers the pending group routes to the server
+// in advance, so the returned table reflects all routes that will be served without starting any listener.
+func (s *Server) Routes() []RouteInfo {
+	s.handlePreBindItems(context.TODO())
+	s.routerMu.RLock()
+	defer s.routerMu.RUnlock()
+	var (
+		routes    = make([]RouteInfo, 0, len(s.routesMap))
+		filterMap = make(map[string]struct{})
+	)
+	for _, handlerItems := range s.routesMap {
+		for _, handlerItem := range handlerItems {
+			if handlerItem.Router == nil {
+				continue
+			}
+			var info = RouteInfo{
+				Domain:          handlerItem.Router.Domain,
+				Method:          handlerItem.Router.Method,
+				Pattern:         handlerItem.Router.Uri,
+				Type:            handlerItem.Type,
+				HookName:        handlerItem.HookName,
+				HandlerName:     gstr.TrimRightStr(handlerItem.Name, "-fm"),
+				MiddlewareCount: len(handlerItem.Middleware),
+				Source:          handlerItem.Source,
+			}
+			// Repeated route filtering, as the same handler might be bound multiple times
+			// if route overwriting is enabled.
+			var filterKey = fmt.Sprintf(
+				`%s|%s|%s|%s|%s|%s`,
+				info.Domain, info.Method, info.Pattern, info.Type, info.HookName, info.Source,
+			)
+			if _, ok := filterMap[filterKey]; ok {
+				continue
+			}
+			filterMap[filterKey] = struct{}{}
+			routes = append(routes, info)
+		}
+	}
+	sort.SliceStable(routes, func(i, j int) bool {
+		if r := strings.Compare(routes[i].Domain, routes[j].Domain); r != 0 {
+			return r < 0
+		}
+		if r := strings.Compare(routes[i].Pattern, routes[j].Pattern); r != 0 {
+			return r < 0
+		}
+		if r := strings.Compare(routes[i].Method, routes[j].Method); r != 0 {
+			return r < 0
+		}
+		if r := strings.Compare(string(routes[i].Type), string(routes[j].Type)); r != 0 {
+			return r < 0
+		}
+		if r := strings.Compare(string(routes[i].HookName), string(routes[j].HookName)); r != 0 {
+			return r < 0
+		}
+		return routes[i].Source < routes[j].Source
+	})
+	return routes
+}
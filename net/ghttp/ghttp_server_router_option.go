@@ -0,0 +1,93 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"net/http"
+	"time"
+)
+
+// RouteOption is the option for route registering, which customizes the registered handler item.
+type RouteOption func(item *HandlerItem)
+
+// RouteDeprecation is the deprecation information of route.
+type RouteDeprecation struct {
+	Sunset time.Time // Time when the route becomes unresponsive, it's optional.
+}
+
+// RouteDeprecated returns a RouteOption that marks the route deprecated, for which the `Deprecation`
+// header and the `Sunset` header if `sunset` is not zero are responded, and the operations are marked
+// deprecated in the OpenAPI specification.
+//
+// Example:
+//
+//	s.BindHandler("/v1/user", handler, ghttp.RouteDeprecated(sunset))
+//	group.GET("/v1/user", handler, ghttp.RouteDeprecated(sunset))
+func RouteDeprecated(sunset time.Time) RouteOption {
+	return func(item *HandlerItem) {
+		item.Deprecation = &RouteDeprecation{
+			Sunset: sunset,
+		}
+	}
+}
+
+// RouteNoValidation returns a RouteOption that disables the validation of all request parsing of the route,
+// like the meta tag `nv` of request struct, which is usually used for the route handler of
+// func(*ghttp.Request) that has no request struct.
+//
+// Example:
+//
+//	s.BindHandler("/user", handler, ghttp.RouteNoValidation())
+//	group.POST("/user", handler, ghttp.RouteNoValidation())
+func RouteNoValidation() RouteOption {
+	return func(item *HandlerItem) {
+		item.NoValidation = true
+	}
+}
+
+// routeErrorHandler returns a RouteOption that sets the custom error handler of the route.
+func routeErrorHandler(handler ErrorHandlerFunc) RouteOption {
+	return func(item *HandlerItem) {
+		item.ErrorHandler = handler
+	}
+}
+
+// applyRouteOptions applies `options` to all the handler items of `handlerMap`.
+func applyRouteOptions(handlerMap map[string]*HandlerItem, options []RouteOption) {
+	if len(options) == 0 {
+		return
+	}
+	for _, item := range handlerMap {
+		for _, option := range options {
+			option(item)
+		}
+	}
+}
+
+// splitRouteOptions separates the RouteOption items from `params`.
+func splitRouteOptions(params []any) (others []any, options []RouteOption) {
+	for _, param := range params {
+		if option, ok := param.(RouteOption); ok {
+			options = append(options, option)
+		} else {
+			others = append(others, param)
+		}
+	}
+	return
+}
+
+// handleDeprecatedRoute sets the deprecation headers to response if the serving route is deprecated.
+func (s *Server) handleDeprecatedRoute(r *Request) {
+	if r.serveHandler == nil || r.serveHandler.Handler.Deprecation == nil {
+		return
+	}
+	var header = r.Response.Header()
+	header.Set("Deprecation", "true")
+	if sunset := r.serveHandler.Handler.Deprecation.Sunset; !sunset.IsZero() {
+		header.Set("Sunset", sunset.UTC().Format(http.TimeFormat))
+	}
+}
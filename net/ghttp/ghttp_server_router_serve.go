@@ -72,13 +72,27 @@ func (s *Server) getHandlersWithCache(r *Request) (parsedItems []*HandlerItemPar
 		path = xUrlPath
 	}
 	var handlerCacheKey = s.serveHandlerKey(method, path, host)
-	value, err := s.serveCache.GetOrSetFunc(ctx, handlerCacheKey, func(ctx context.Context) (any, error) {
+	// The cached item is immutable and the cache is cleared after the route tree changed,
+	// so the cache hit needs no lock.
+	value, err := s.serveCache.Get(ctx, handlerCacheKey)
+	if err != nil {
+		intlog.Errorf(ctx, `%+v`, err)
+	}
+	if value != nil {
+		item := value.Val().(*handlerCacheItem)
+		return item.parsedItems, item.serveItem, item.hasHook, item.hasServe
+	}
+	// The read lock covers the searching and cache setting on cache miss, so that the cache cannot be
+	// set with the searching result of a route tree that is changed during searching.
+	s.routerMu.RLock()
+	value, err = s.serveCache.GetOrSetFunc(ctx, handlerCacheKey, func(ctx context.Context) (any, error) {
 		parsedItems, serveItem, hasHook, hasServe = s.searchHandlers(method, path, host)
 		if parsedItems != nil {
 			return &handlerCacheItem{parsedItems, serveItem, hasHook, hasServe}, nil
 		}
 		return nil, nil
 	}, routeCacheDuration)
+	s.routerMu.RUnlock()
 	if err != nil {
 		intlog.Errorf(ctx, `%+v`, err)
 	}
@@ -89,6 +103,27 @@ func (s *Server) getHandlersWithCache(r *Request) (parsedItems []*HandlerItemPar
 	return
 }
 
+// searchAllowedMethods retrieves and returns the http methods that have serving handler
+// for the path of given request, which is used for CORS preflight request.
+func (s *Server) searchAllowedMethods(r *Request) []string {
+	var path = r.URL.Path
+	if r.URL.RawPath != "" {
+		path = r.URL.RawPath
+	}
+	if xUrlPath := r.Header.Get(HeaderXUrlPath); xUrlPath != "" {
+		path = xUrlPath
+	}
+	var methods = make([]string, 0)
+	s.routerMu.RLock()
+	defer s.routerMu.RUnlock()
+	for _, method := range SupportedMethods() {
+		if _, _, _, hasServe := s.searchHandlers(method, path, r.GetHost()); hasServe {
+			methods = append(methods, method)
+		}
+	}
+	return methods
+}
+
 // searchHandlers retrieve and returns the routers with given parameters.
 // Note that the returned routers contain serving handler, middleware handlers and hook handlers.
 func (s *Server) searchHandlers(method, path, domain string) (parsedItems []*HandlerItemParsed, serveItem *HandlerItemParsed, hasHook, hasServe bool) {
@@ -9,6 +9,7 @@ package ghttp
 import (
 	"bytes"
 	"context"
+	"net/http"
 	"reflect"
 	"strings"
 
@@ -23,7 +24,9 @@ import (
 // Note that the parameter `handler` can be type of:
 // 1. func(*ghttp.Request)
 // 2. func(context.Context, BizRequest)(BizResponse, error)
-func (s *Server) BindHandler(pattern string, handler any) {
+//
+// The optional parameter `options` customizes the registered route, eg: RouteDeprecated.
+func (s *Server) BindHandler(pattern string, handler any, options ...RouteOption) {
 	var ctx = context.TODO()
 	funcInfo, err := s.checkAndCreateFuncInfo(handler, "", "", "")
 	if err != nil {
@@ -35,15 +38,37 @@ func (s *Server) BindHandler(pattern string, handler any) {
 		FuncInfo:   funcInfo,
 		Middleware: nil,
 		Source:     "",
+		Options:    options,
 	})
 }
 
+// UnbindHandler removes the serving handler registered with given `method` and `pattern` from the server,
+// and returns whether the handler is removed. The parameter `pattern` is the same as the one of BindHandler,
+// and the parameter `method` overwrites the method specified in `pattern` if it is not empty.
+// The middleware and hook handlers of the route are not removed.
+//
+// It can be called when the server is running, as well as BindHandler, which updates the route tree
+// and clears the route searching cache atomically, so the requests under serving are not affected.
+func (s *Server) UnbindHandler(method, pattern string) bool {
+	var ctx = context.TODO()
+	domain, patternMethod, uri, err := s.parsePattern(pattern)
+	if err != nil {
+		s.Logger().Errorf(ctx, `invalid pattern "%s", %+v`, pattern, err)
+		return false
+	}
+	if method == "" {
+		method = patternMethod
+	}
+	return s.unsetHandler(ctx, method, uri, domain)
+}
+
 type doBindHandlerInput struct {
 	Prefix     string
 	Pattern    string
 	FuncInfo   handlerFuncInfo
 	Middleware []HandlerFunc
 	Source     string
+	Options    []RouteOption
 }
 
 // doBindHandler registers a handler function to server with given pattern.
@@ -51,15 +76,19 @@ type doBindHandlerInput struct {
 // The parameter `pattern` is like:
 // /user/list, put:/user, delete:/user, post:/user@goframe.org
 func (s *Server) doBindHandler(ctx context.Context, in doBindHandlerInput) {
+	handlerItem := &HandlerItem{
+		Type:       HandlerTypeHandler,
+		Info:       in.FuncInfo,
+		Middleware: in.Middleware,
+		Source:     in.Source,
+	}
+	for _, option := range in.Options {
+		option(handlerItem)
+	}
 	s.setHandler(ctx, setHandlerInput{
-		Prefix:  in.Prefix,
-		Pattern: in.Pattern,
-		HandlerItem: &HandlerItem{
-			Type:       HandlerTypeHandler,
-			Info:       in.FuncInfo,
-			Middleware: in.Middleware,
-			Source:     in.Source,
-		},
+		Prefix:      in.Prefix,
+		Pattern:     in.Pattern,
+		HandlerItem: handlerItem,
 	})
 }
 
@@ -258,6 +287,13 @@ func createRouterFunc(funcInfo handlerFuncInfo) func(r *Request) {
 				r.error = r.Parse(inputObject.Addr().Interface())
 			}
 			if r.error != nil {
+				// The parsing error like validation failure is responded with the status mapped from
+				// its error code, as the response body might be written by middleware.
+				if r.Response.Status == 0 {
+					if status := r.Server.errorStatusCode(r.error); status != http.StatusInternalServerError {
+						r.Response.WriteHeader(status)
+					}
+				}
 				return
 			}
 			inputValues = append(inputValues, inputObject)
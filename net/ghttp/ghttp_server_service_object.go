@@ -70,6 +70,7 @@ type doBindObjectInput struct {
 	Method     string
 	Middleware []HandlerFunc
 	Source     string
+	Options    []RouteOption
 }
 
 func (s *Server) doBindObject(ctx context.Context, in doBindObjectInput) {
@@ -114,6 +115,7 @@ func (s *Server) doBindObject(ctx context.Context, in doBindObjectInput) {
 	if reflectValue.MethodByName(specialMethodNameShut).IsValid() {
 		shutFunc = reflectValue.MethodByName(specialMethodNameShut).Interface().(func(*Request))
 	}
+	routeMeta := s.getObjectRouteMeta(ctx, reflectValue)
 	pkgPath := reflectType.Elem().PkgPath()
 	pkgName := gfile.Basename(pkgPath)
 	for i := 0; i < reflectValue.NumMethod(); i++ {
@@ -121,7 +123,8 @@ func (s *Server) doBindObject(ctx context.Context, in doBindObjectInput) {
 		if methodMap != nil && !methodMap[methodName] {
 			continue
 		}
-		if methodName == specialMethodNameInit || methodName == specialMethodNameShut {
+		if methodName == specialMethodNameInit || methodName == specialMethodNameShut ||
+			methodName == specialMethodNameRouteMeta {
 			continue
 		}
 		objName := gstr.Replace(reflectType.String(), fmt.Sprintf(`%s.`, pkgName), "")
@@ -141,7 +144,7 @@ func (s *Server) doBindObject(ctx context.Context, in doBindObjectInput) {
 			Info:       funcInfo,
 			InitFunc:   initFunc,
 			ShutFunc:   shutFunc,
-			Middleware: in.Middleware,
+			Middleware: mergeRouteMetaMiddleware(in.Middleware, routeMeta, methodName),
 			Source:     in.Source,
 		}
 		// If there's "Index" method, then an additional route is automatically added
@@ -168,11 +171,12 @@ func (s *Server) doBindObject(ctx context.Context, in doBindObjectInput) {
 				Info:       funcInfo,
 				InitFunc:   initFunc,
 				ShutFunc:   shutFunc,
-				Middleware: in.Middleware,
+				Middleware: mergeRouteMetaMiddleware(in.Middleware, routeMeta, methodName),
 				Source:     in.Source,
 			}
 		}
 	}
+	applyRouteOptions(handlerMap, in.Options)
 	s.bindHandlerByMap(ctx, in.Prefix, handlerMap)
 }
 
@@ -183,6 +187,7 @@ type doBindObjectMethodInput struct {
 	Method     string
 	Middleware []HandlerFunc
 	Source     string
+	Options    []RouteOption
 }
 
 func (s *Server) doBindObjectMethod(ctx context.Context, in doBindObjectMethodInput) {
@@ -216,6 +221,7 @@ func (s *Server) doBindObjectMethod(ctx context.Context, in doBindObjectMethodIn
 	if reflectValue.MethodByName(specialMethodNameShut).IsValid() {
 		shutFunc = reflectValue.MethodByName(specialMethodNameShut).Interface().(func(*Request))
 	}
+	routeMeta := s.getObjectRouteMeta(ctx, reflectValue)
 	var (
 		pkgPath = reflectType.Elem().PkgPath()
 		pkgName = gfile.Basename(pkgPath)
@@ -237,10 +243,11 @@ func (s *Server) doBindObjectMethod(ctx context.Context, in doBindObjectMethodIn
 		Info:       funcInfo,
 		InitFunc:   initFunc,
 		ShutFunc:   shutFunc,
-		Middleware: in.Middleware,
+		Middleware: mergeRouteMetaMiddleware(in.Middleware, routeMeta, methodName),
 		Source:     in.Source,
 	}
 
+	applyRouteOptions(handlerMap, in.Options)
 	s.bindHandlerByMap(ctx, in.Prefix, handlerMap)
 }
 
@@ -267,6 +274,7 @@ func (s *Server) doBindObjectRest(ctx context.Context, in doBindObjectInput) {
 	if reflectValue.MethodByName(specialMethodNameShut).IsValid() {
 		shutFunc = reflectValue.MethodByName(specialMethodNameShut).Interface().(func(*Request))
 	}
+	routeMeta := s.getObjectRouteMeta(ctx, reflectValue)
 	pkgPath := reflectType.Elem().PkgPath()
 	for i := 0; i < reflectValue.NumMethod(); i++ {
 		methodName := reflectType.Method(i).Name
@@ -296,9 +304,10 @@ func (s *Server) doBindObjectRest(ctx context.Context, in doBindObjectInput) {
 			Info:       funcInfo,
 			InitFunc:   initFunc,
 			ShutFunc:   shutFunc,
-			Middleware: in.Middleware,
+			Middleware: mergeRouteMetaMiddleware(in.Middleware, routeMeta, methodName),
 			Source:     in.Source,
 		}
 	}
+	applyRouteOptions(handlerMap, in.Options)
 	s.bindHandlerByMap(ctx, in.Prefix, handlerMap)
 }
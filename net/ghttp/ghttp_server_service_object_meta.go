@@ -0,0 +1,73 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"context"
+	"reflect"
+)
+
+const (
+	// specialMethodNameRouteMeta is the method name of object declaring the metadata of its action methods,
+	// which is not bound as route.
+	specialMethodNameRouteMeta = "RouteMeta"
+)
+
+// RouteMeta is the metadata of an action method of the object bound to server routes,
+// which is declared by the object implementing method `RouteMeta() map[string]RouteMeta`,
+// the key of which is the action method name, case-sensitive, eg:
+//
+//	func (c *Controller) RouteMeta() map[string]ghttp.RouteMeta {
+//		return map[string]ghttp.RouteMeta{
+//			"Delete": {Middleware: []ghttp.HandlerFunc{MiddlewareAuth}},
+//		}
+//	}
+type RouteMeta struct {
+	// Middleware is the middleware bound to the action, which are called after the
+	// middleware bound to the object.
+	Middleware []HandlerFunc
+}
+
+// iRouteMeta is the interface for the object declaring the metadata of its action methods.
+type iRouteMeta interface {
+	RouteMeta() map[string]RouteMeta
+}
+
+// getObjectRouteMeta retrieves and returns the metadata of action methods declared by object `reflectValue`.
+// It returns nil if the object does not implement method RouteMeta.
+func (s *Server) getObjectRouteMeta(ctx context.Context, reflectValue reflect.Value) map[string]RouteMeta {
+	v, ok := reflectValue.Interface().(iRouteMeta)
+	if !ok {
+		return nil
+	}
+	var metaMap = v.RouteMeta()
+	for methodName := range metaMap {
+		if methodName == specialMethodNameRouteMeta || !reflectValue.MethodByName(methodName).IsValid() {
+			s.Logger().Fatalf(
+				ctx,
+				`invalid method name "%s" in route meta of object "%s"`,
+				methodName, reflectValue.Type().String(),
+			)
+		}
+	}
+	return metaMap
+}
+
+// mergeRouteMetaMiddleware returns the middleware of action `methodName`, which are the object
+// middleware `middleware` appended with the ones declared in `metaMap` for the action.
+func mergeRouteMetaMiddleware(
+	middleware []HandlerFunc, metaMap map[string]RouteMeta, methodName string,
+) []HandlerFunc {
+	meta, ok := metaMap[methodName]
+	if !ok || len(meta.Middleware) == 0 {
+		return middleware
+	}
+	var merged = make([]HandlerFunc, 0, len(middleware)+len(meta.Middleware))
+	merged = append(merged, middleware...)
+	merged = append(merged, meta.Middleware...)
+	return merged
+}
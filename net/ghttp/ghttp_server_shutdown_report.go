@@ -0,0 +1,99 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// ShutdownReport is the report of server shutdown, which contains the in-flight requests at shutdown start.
+type ShutdownReport struct {
+	StartTime        time.Time         // Shutdown starting time.
+	InFlightRequests []InFlightRequest // In-flight requests at shutdown start, which are sorted by elapsed time descending.
+}
+
+// InFlightRequest is the information of request that is still being served at shutdown start.
+type InFlightRequest struct {
+	Method    string        // HTTP method, eg: GET.
+	Route     string        // Route URI, eg: /user/{id}. It is the request path if no route is matched.
+	Path      string        // Request path, eg: /user/1.
+	ClientIp  string        // Client ip.
+	EnterTime time.Time     // Request starting time.
+	Elapsed   time.Duration // Elapsed time of the request at shutdown start.
+}
+
+// SetShutdownReport enables or disables the report of in-flight requests at shutdown start.
+func (s *Server) SetShutdownReport(enabled bool) {
+	s.config.ShutdownReport = enabled
+}
+
+// SetShutdownHandler sets the callback function that is called with the report of in-flight
+// requests at shutdown start, which also enables the shutdown report.
+func (s *Server) SetShutdownHandler(handler func(ctx context.Context, report *ShutdownReport)) {
+	s.config.ShutdownHandler = handler
+}
+
+// GetShutdownReport returns the report of the latest shutdown, which is still available after
+// the server is closed forcibly. It returns nil if the server has not been shut down or the shutdown
+// report is not enabled.
+func (s *Server) GetShutdownReport() *ShutdownReport {
+	if v := s.shutdownReport.Load(); v != nil {
+		return v.(*ShutdownReport)
+	}
+	return nil
+}
+
+// isShutdownReportEnabled checks and returns whether the report of in-flight requests is enabled,
+// as the in-flight requests are tracked only for the report.
+func (s *Server) isShutdownReportEnabled() bool {
+	return s.config.ShutdownReport || s.config.ShutdownHandler != nil
+}
+
+// trackInFlightRequest marks the request as in-flight, which is reported at shutdown start.
+func (s *Server) trackInFlightRequest(r *Request) {
+	var route = r.URL.Path
+	if r.serveHandler != nil && r.serveHandler.Handler.Router != nil {
+		route = r.serveHandler.Handler.Router.Uri
+	}
+	s.inFlightRequests.Store(r, &InFlightRequest{
+		Method:    r.Method,
+		Route:     route,
+		Path:      r.URL.Path,
+		ClientIp:  r.GetClientIp(),
+		EnterTime: r.EnterTime.Time,
+	})
+}
+
+// handleShutdownReport makes the report of in-flight requests at shutdown start, and calls the
+// shutdown handler if it is configured.
+func (s *Server) handleShutdownReport(ctx context.Context) {
+	if !s.isShutdownReportEnabled() {
+		return
+	}
+	var report = &ShutdownReport{
+		StartTime:        time.Now(),
+		InFlightRequests: make([]InFlightRequest, 0),
+	}
+	s.inFlightRequests.Range(func(key, value any) bool {
+		item := *value.(*InFlightRequest)
+		item.Elapsed = report.StartTime.Sub(item.EnterTime)
+		report.InFlightRequests = append(report.InFlightRequests, item)
+		return true
+	})
+	sort.Slice(report.InFlightRequests, func(i, j int) bool {
+		return report.InFlightRequests[i].Elapsed > report.InFlightRequests[j].Elapsed
+	})
+	s.shutdownReport.Store(report)
+	if len(report.InFlightRequests) > 0 {
+		s.Logger().Infof(ctx, "%d in-flight requests at shutdown start", len(report.InFlightRequests))
+	}
+	if s.config.ShutdownHandler != nil {
+		s.config.ShutdownHandler(ctx, report)
+	}
+}
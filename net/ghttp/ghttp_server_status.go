@@ -7,10 +7,13 @@
 package ghttp
 
 import (
+	"context"
 	"fmt"
+	"strings"
 )
 
 // getStatusHandler retrieves and returns the handler for given status code.
+// The handler of exact status code has priority over the handler of status range like "4xx".
 func (s *Server) getStatusHandler(status int, r *Request) []HandlerFunc {
 	domains := []string{r.GetHost(), DefaultDomainName}
 	for _, domain := range domains {
@@ -18,6 +21,11 @@ func (s *Server) getStatusHandler(status int, r *Request) []HandlerFunc {
 			return f
 		}
 	}
+	for _, domain := range domains {
+		if f, ok := s.statusHandlerMap[s.statusRangeHandlerKey(status, domain)]; ok {
+			return f
+		}
+	}
 	return nil
 }
 
@@ -35,6 +43,27 @@ func (s *Server) statusHandlerKey(status int, domain string) string {
 	return fmt.Sprintf("%s#%d", domain, status)
 }
 
+// statusRangeHandlerKey creates and returns key of status range for given status and domain,
+// which is like: domain#4xx.
+func (s *Server) statusRangeHandlerKey(status int, domain string) string {
+	return s.statusPatternHandlerKey(fmt.Sprintf("%dxx", status/100), domain)
+}
+
+// statusPatternHandlerKey creates and returns key for given status pattern and domain.
+func (s *Server) statusPatternHandlerKey(pattern string, domain string) string {
+	return fmt.Sprintf("%s#%s", domain, pattern)
+}
+
+// checkStatusPattern checks and returns the status range `pattern` in lower case, which should be like
+// "4xx" or "5xx". It returns false if the pattern is invalid.
+func checkStatusPattern(pattern string) (string, bool) {
+	pattern = strings.ToLower(strings.TrimSpace(pattern))
+	if len(pattern) != 3 || pattern[0] < '1' || pattern[0] > '5' || pattern[1:] != "xx" {
+		return pattern, false
+	}
+	return pattern, true
+}
+
 // BindStatusHandler registers handler for given status code.
 func (s *Server) BindStatusHandler(status int, handler HandlerFunc) {
 	s.addStatusHandler(s.statusHandlerKey(status, DefaultDomainName), handler)
@@ -46,3 +75,20 @@ func (s *Server) BindStatusHandlerByMap(handlerMap map[int]HandlerFunc) {
 		s.BindStatusHandler(k, v)
 	}
 }
+
+// BindStatusHandlerByPattern registers fallback handler for the status range `pattern`, which is like
+// "4xx" for all client error statuses or "5xx" for all server error statuses. The handler is called
+// only if there's no handler registered for the exact status code, that is, the precedence is:
+// exact status code > status range > default.
+//
+// Note that the status handlers are not called for status 200.
+func (s *Server) BindStatusHandlerByPattern(pattern string, handler HandlerFunc) {
+	statusPattern, ok := checkStatusPattern(pattern)
+	if !ok {
+		s.Logger().Fatalf(
+			context.TODO(), `invalid status pattern "%s", it should be like "4xx" or "5xx"`, pattern,
+		)
+		return
+	}
+	s.addStatusHandler(s.statusPatternHandlerKey(statusPattern, DefaultDomainName), handler)
+}
@@ -0,0 +1,185 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/json"
+	"github.com/gogf/gf/v2/os/gctx"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+// TestServer is the in-memory server for integration testing, which serves the constructed requests
+// with the full workflow of the server, including middleware, hooks and status handlers, but does not
+// listen on any address.
+type TestServer struct {
+	server *Server
+}
+
+// TestClient is the client executing requests against TestServer, which records the responses.
+type TestClient struct {
+	server  *TestServer
+	header  http.Header
+	cookies []*http.Cookie
+}
+
+// TestResponse is the response recorded by TestClient.
+type TestResponse struct {
+	*http.Response
+	body []byte
+}
+
+// NewTestServer creates and returns an in-memory server for integration testing.
+// The functions `routes` are called with the underlying Server for route and middleware registering,
+// and the server is configured as the normal server except that it does not listen on any address.
+//
+// It panics if the server initialization fails, eg: there's no route registered.
+func NewTestServer(routes ...func(s *Server)) *TestServer {
+	var s = GetServer(guid.S())
+	s.SetDumpRouterMap(false)
+	for _, f := range routes {
+		f(s)
+	}
+	if err := s.prepareServe(gctx.GetInitCtx()); err != nil {
+		panic(gerror.Wrap(err, `test server initialization failed`))
+	}
+	s.initOpenApi()
+	return &TestServer{
+		server: s,
+	}
+}
+
+// Server returns the underlying Server of the test server.
+func (ts *TestServer) Server() *Server {
+	return ts.server
+}
+
+// Client creates and returns a new client executing requests against the test server.
+func (ts *TestServer) Client() *TestClient {
+	return &TestClient{
+		server: ts,
+		header: make(http.Header),
+	}
+}
+
+// ServeHTTP serves the constructed request `r` and returns the recorded response.
+func (ts *TestServer) ServeHTTP(r *http.Request) *TestResponse {
+	var recorder = httptest.NewRecorder()
+	ts.server.config.Handler(recorder, r)
+	var (
+		response = recorder.Result()
+		body, _  = io.ReadAll(response.Body)
+	)
+	_ = response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewReader(body))
+	return &TestResponse{
+		Response: response,
+		body:     body,
+	}
+}
+
+// SetHeader sets a custom HTTP header for all requests of the client.
+func (c *TestClient) SetHeader(key, value string) *TestClient {
+	c.header.Set(key, value)
+	return c
+}
+
+// SetCookie sets a cookie for all requests of the client.
+func (c *TestClient) SetCookie(key, value string) *TestClient {
+	c.cookies = append(c.cookies, &http.Cookie{Name: key, Value: value})
+	return c
+}
+
+// Get sends a GET request to the test server and returns the recorded response.
+func (c *TestClient) Get(url string, body ...any) *TestResponse {
+	return c.DoRequest(http.MethodGet, url, body...)
+}
+
+// Post sends a POST request to the test server and returns the recorded response.
+func (c *TestClient) Post(url string, body ...any) *TestResponse {
+	return c.DoRequest(http.MethodPost, url, body...)
+}
+
+// Put sends a PUT request to the test server and returns the recorded response.
+func (c *TestClient) Put(url string, body ...any) *TestResponse {
+	return c.DoRequest(http.MethodPut, url, body...)
+}
+
+// Delete sends a DELETE request to the test server and returns the recorded response.
+func (c *TestClient) Delete(url string, body ...any) *TestResponse {
+	return c.DoRequest(http.MethodDelete, url, body...)
+}
+
+// DoRequest sends request with given method, url and optional body to the test server,
+// and returns the recorded response.
+// The body of type string/[]byte/io.Reader is sent as it is, and the others are encoded as JSON.
+func (c *TestClient) DoRequest(method, url string, body ...any) *TestResponse {
+	var (
+		reader      io.Reader
+		contentType string
+	)
+	if len(body) > 0 && body[0] != nil {
+		switch v := body[0].(type) {
+		case string:
+			reader = strings.NewReader(v)
+		case []byte:
+			reader = bytes.NewReader(v)
+		case io.Reader:
+			reader = v
+		default:
+			b, err := json.Marshal(v)
+			if err != nil {
+				panic(gerror.Wrap(err, `json.Marshal failed for test request body`))
+			}
+			reader = bytes.NewReader(b)
+			contentType = contentTypeJson
+		}
+	}
+	var request = httptest.NewRequest(method, url, reader)
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+	return c.Do(request)
+}
+
+// Do sends the constructed request `r` to the test server with the headers and cookies
+// of the client, and returns the recorded response.
+func (c *TestClient) Do(r *http.Request) *TestResponse {
+	for key, values := range c.header {
+		r.Header[key] = values
+	}
+	for _, cookie := range c.cookies {
+		r.AddCookie(cookie)
+	}
+	return c.server.ServeHTTP(r)
+}
+
+// ReadAll returns the recorded response body.
+func (r *TestResponse) ReadAll() []byte {
+	return r.body
+}
+
+// ReadAllString returns the recorded response body as string.
+func (r *TestResponse) ReadAllString() string {
+	return string(r.body)
+}
+
+// GetCookie returns the value of cookie `key` set by the response.
+func (r *TestResponse) GetCookie(key string) string {
+	for _, cookie := range r.Cookies() {
+		if cookie.Name == key {
+			return cookie.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,35 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"context"
+)
+
+// ViewEngine is the interface for custom template engine rendering the template files for responses,
+// which is used in replace of the bundled gview for teams having their own template engine,
+// eg: html/template or templ.
+type ViewEngine interface {
+	// Render renders the template `name` with template variables `data` and returns the rendered content.
+	// The `data` contains the variables assigned to the request and the built-in variables like Form,
+	// Query, Request, Cookie, Session and Config.
+	Render(ctx context.Context, name string, data map[string]any) ([]byte, error)
+}
+
+// SetViewEngine sets the custom template engine for the server, which renders the template files for
+// Response.WriteTpl/WriteTplDefault/ParseTpl/ParseTplDefault instead of the gview object.
+// The template content parsing like Response.WriteTplContent is still done by gview.
+//
+// Note that the custom gview object set by Request.SetView takes priority over the engine for the request.
+func (s *Server) SetViewEngine(engine ViewEngine) {
+	s.config.ViewEngine = engine
+}
+
+// GetViewEngine returns the custom template engine of the server, which is nil if not set.
+func (s *Server) GetViewEngine() ViewEngine {
+	return s.config.ViewEngine
+}
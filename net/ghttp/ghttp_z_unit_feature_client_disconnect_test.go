@@ -0,0 +1,118 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_ClientDisconnect(t *testing.T) {
+	var (
+		logDir       = gfile.Temp(gtime.TimestampNanoStr())
+		disconnected = make(chan bool, 10)
+		waitCtxDone  = func(r *ghttp.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}
+	)
+	defer gfile.Remove(logDir)
+	s := g.Server(guid.S())
+	s.BindHandler("/slow", func(r *ghttp.Request) {
+		waitCtxDone(r)
+		disconnected <- r.IsClientDisconnected()
+		if err := r.Context().Err(); err != nil {
+			r.SetError(gerror.Wrap(err, "slow query failed"))
+			return
+		}
+		r.Response.Write("done")
+	})
+	s.BindHandler("/written", func(r *ghttp.Request) {
+		r.Response.WriteHeader(http.StatusNoContent)
+		waitCtxDone(r)
+		disconnected <- r.IsClientDisconnected()
+	})
+	s.Group("/abort", func(group *ghttp.RouterGroup) {
+		group.Middleware(func(r *ghttp.Request) {
+			waitCtxDone(r)
+			r.Middleware.Next()
+		})
+		group.GET("/", func(r *ghttp.Request) {
+			disconnected <- r.IsClientDisconnected()
+		})
+	})
+	s.SetClientDisconnectAbort(true)
+	s.SetLogPath(logDir)
+	s.SetAccessLogEnabled(true)
+	s.SetErrorLogEnabled(true)
+	s.SetLogStdout(false)
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	var (
+		client    = g.Client().Prefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		accessLog = func() string {
+			return gfile.GetContents(gfile.Join(logDir, "access-"+gtime.Now().Format("Ymd")+".log"))
+		}
+		getWithTimeout = func(uri string) error {
+			timeoutCtx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+			defer cancel()
+			resp, err := client.Get(timeoutCtx, uri)
+			if err == nil {
+				resp.Close()
+			}
+			return err
+		}
+	)
+	gtest.C(t, func(t *gtest.T) {
+		t.AssertNE(getWithTimeout("/slow"), nil)
+		t.Assert(<-disconnected, true)
+		time.Sleep(100 * time.Millisecond)
+
+		errorLog := gfile.GetContents(gfile.Join(logDir, "error-"+gtime.Now().Format("Ymd")+".log"))
+		t.Assert(gstr.Contains(accessLog(), `499 "GET http 127.0.0.1`), true)
+		t.Assert(gstr.Contains(accessLog(), `/slow HTTP/1.1"`), true)
+		t.Assert(gstr.Contains(errorLog, "slow query failed"), false)
+	})
+	// The remaining handlers are skipped.
+	gtest.C(t, func(t *gtest.T) {
+		t.AssertNE(getWithTimeout("/abort"), nil)
+		time.Sleep(100 * time.Millisecond)
+		t.Assert(gstr.Count(accessLog(), `499 "GET http 127.0.0.1`), 2)
+		t.Assert(len(disconnected), 0)
+	})
+	// The status written by handler before the client disconnects is kept.
+	gtest.C(t, func(t *gtest.T) {
+		t.AssertNE(getWithTimeout("/written"), nil)
+		t.Assert(<-disconnected, true)
+		time.Sleep(100 * time.Millisecond)
+		t.Assert(gstr.Count(accessLog(), `499 "GET http 127.0.0.1`), 2)
+		t.Assert(gstr.Contains(accessLog(), `204 "GET http 127.0.0.1`), true)
+		t.Assert(gstr.Contains(accessLog(), `/written HTTP/1.1"`), true)
+	})
+	// Connected client.
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(client.GetContent(ctx, "/abort"), "")
+		t.Assert(<-disconnected, false)
+	})
+}
@@ -8,10 +8,13 @@ package ghttp_test
 
 import (
 	"fmt"
+	"net"
+	"net/http"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/gogf/gf/v2/container/gtype"
 	"github.com/gogf/gf/v2/frame/g"
 	"github.com/gogf/gf/v2/net/ghttp"
 	"github.com/gogf/gf/v2/os/gfile"
@@ -105,6 +108,49 @@ func Test_ClientMaxBodySize(t *testing.T) {
 	})
 }
 
+func Test_MaxHeaderBytesAndCount(t *testing.T) {
+	s := g.Server(guid.S())
+	s.BindHandler("/", func(r *ghttp.Request) {
+		r.Response.Write("ok")
+	})
+	m := g.Map{
+		"MaxHeaderBytes": "1k",
+		"MaxHeaderCount": 20,
+	}
+	gtest.Assert(s.SetConfigWithMap(m), nil)
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		prefix := fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort())
+
+		res, err := g.Client().Header(g.MapStrStr{"X-Custom": "value"}).Get(ctx, prefix+"/")
+		t.AssertNil(err)
+		t.Assert(res.StatusCode, http.StatusOK)
+		t.Assert(res.ReadAllString(), "ok")
+		res.Close()
+
+		// Header size.
+		res, err = g.Client().Header(g.MapStrStr{"X-Custom": strings.Repeat("a", 1024)}).Get(ctx, prefix+"/")
+		t.AssertNil(err)
+		t.Assert(res.StatusCode, http.StatusRequestHeaderFieldsTooLarge)
+		res.Close()
+
+		// Header count.
+		headers := g.MapStrStr{}
+		for i := 0; i < 20; i++ {
+			headers[fmt.Sprintf("X-Custom-%d", i)] = "value"
+		}
+		res, err = g.Client().Header(headers).Get(ctx, prefix+"/")
+		t.AssertNil(err)
+		t.Assert(res.StatusCode, http.StatusRequestHeaderFieldsTooLarge)
+		res.Close()
+	})
+}
+
 func Test_ClientMaxBodySize_File(t *testing.T) {
 	s := g.Server(guid.S())
 	s.Group("/", func(group *ghttp.RouterGroup) {
@@ -202,3 +248,40 @@ func Test_Config_GracefulShutdownTimeout(t *testing.T) {
 		t.Assert(s.GetGracefulShutdownTimeout(), expect)
 	})
 }
+
+func Test_Config_ConnStateHandler(t *testing.T) {
+	var (
+		newCount    = gtype.NewInt()
+		activeCount = gtype.NewInt()
+		closedCount = gtype.NewInt()
+	)
+	s := g.Server(guid.S())
+	s.SetConnStateHandler(func(conn net.Conn, state http.ConnState) {
+		switch state {
+		case http.StateNew:
+			newCount.Add(1)
+		case http.StateActive:
+			activeCount.Add(1)
+		case http.StateClosed:
+			closedCount.Add(1)
+		}
+	})
+	s.BindHandler("/", func(r *ghttp.Request) {
+		r.Response.Write("ok")
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		t.Assert(client.GetContent(ctx, "/"), "ok")
+		t.Assert(client.GetContent(ctx, "/"), "ok")
+		time.Sleep(100 * time.Millisecond)
+		t.Assert(newCount.Val(), 2)
+		t.Assert(activeCount.Val(), 2)
+		t.Assert(closedCount.Val(), 2)
+	})
+}
@@ -166,3 +166,77 @@ func Test_CookieOptions(t *testing.T) {
 		t.Assert(parts[5], "SameSite=Lax")
 	})
 }
+
+func Test_CookieOptions_PartitionedPriority(t *testing.T) {
+	s := g.Server(guid.S())
+	s.SetConfigWithMap(g.Map{
+		"cookieSameSite":    "none",
+		"cookieSecure":      true,
+		"cookiePartitioned": true,
+		"cookiePriority":    "high",
+	})
+	s.BindHandler("/set", func(r *ghttp.Request) {
+		r.Cookie.Set("k", "v")
+	})
+	s.BindHandler("/session", func(r *ghttp.Request) {
+		r.Session.MustSet("k", "v")
+	})
+	s.BindHandler("/invalid", func(r *ghttp.Request) {
+		r.Cookie.SetCookie("k", "v", "", "/", time.Hour, ghttp.CookieOptions{Partitioned: true})
+		r.Cookie.SetCookie("p", "v", "", "/", time.Hour, ghttp.CookieOptions{Priority: "Urgent"})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+
+	time.Sleep(100 * time.Millisecond)
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		r1, err := client.Get(ctx, "/set")
+		t.AssertNil(err)
+		defer r1.Close()
+		parts := strings.Split(r1.Header.Get("Set-Cookie"), "; ")
+		t.Assert(parts[0], "k=v")
+		t.AssertIN("Secure", parts)
+		t.AssertIN("SameSite=None", parts)
+		t.AssertIN("Partitioned", parts)
+		t.Assert(parts[len(parts)-1], "Priority=High")
+
+		r2, err := client.Get(ctx, "/session")
+		t.AssertNil(err)
+		defer r2.Close()
+		parts = strings.Split(r2.Header.Get("Set-Cookie"), "; ")
+		t.Assert(strings.HasPrefix(parts[0], s.GetSessionIdName()+"="), true)
+		t.AssertIN("Partitioned", parts)
+		t.AssertIN("Priority=High", parts)
+
+		r3, err := client.Get(ctx, "/invalid")
+		t.AssertNil(err)
+		defer r3.Close()
+		t.Assert(r3.Header.Values("Set-Cookie"), nil)
+	})
+}
+
+func Test_CookieOptions_Validate(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.AssertNil(ghttp.CookieOptions{}.Validate())
+		t.AssertNil(ghttp.CookieOptions{
+			SameSite:    http.SameSiteNoneMode,
+			Secure:      true,
+			Partitioned: true,
+			Priority:    ghttp.CookiePriorityLow,
+		}.Validate())
+		t.AssertNE(ghttp.CookieOptions{Partitioned: true}.Validate(), nil)
+		t.AssertNE(ghttp.CookieOptions{Priority: "urgent"}.Validate(), nil)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		s := g.Server(guid.S())
+		s.SetConfigWithMap(g.Map{
+			"cookiePartitioned": true,
+		})
+		s.SetDumpRouterMap(false)
+		t.AssertNE(s.Start(), nil)
+	})
+}
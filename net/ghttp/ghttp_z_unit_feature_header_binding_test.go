@@ -0,0 +1,103 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/os/gctx"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Server_ContextHeaderBindings(t *testing.T) {
+	const (
+		ctxKeyTenant gctx.StrKey = "tenant"
+		ctxKeyLocale gctx.StrKey = "locale"
+		ctxKeyUserId gctx.StrKey = "userId"
+	)
+	s := g.Server(guid.S())
+	s.SetContextHeaderBindings([]ghttp.HeaderBinding{
+		{
+			Header: "X-Tenant",
+			CtxKey: ctxKeyTenant,
+			Parser: func(value string) (any, error) {
+				return strconv.Atoi(value)
+			},
+			RejectOnError: true,
+		},
+		{
+			Header: "Accept-Language",
+			CtxKey: ctxKeyLocale,
+			Parser: func(value string) (any, error) {
+				return strings.Split(value, ",")[0], nil
+			},
+		},
+		{
+			Header: "X-User-Id",
+			CtxKey: ctxKeyUserId,
+			Parser: func(value string) (any, error) {
+				return strconv.ParseInt(value, 10, 64)
+			},
+		},
+	})
+	s.BindHookHandler("/*", ghttp.HookBeforeServe, func(r *ghttp.Request) {
+		r.Response.Header().Set("X-Hook-Tenant", fmt.Sprint(r.Context().Value(ctxKeyTenant)))
+	})
+	s.BindHandler("/", func(r *ghttp.Request) {
+		var ctx = r.Context()
+		r.Response.Writef(
+			"%v|%v|%v",
+			ctx.Value(ctxKeyTenant), ctx.Value(ctxKeyLocale), ctx.Value(ctxKeyUserId),
+		)
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		resp, err := client.Header(g.MapStrStr{
+			"X-Tenant":        "100",
+			"Accept-Language": "zh-CN,en;q=0.8",
+			"X-User-Id":       "1",
+		}).Get(ctx, "/")
+		t.AssertNil(err)
+		t.Assert(resp.Header.Get("X-Hook-Tenant"), "100")
+		t.Assert(resp.ReadAllString(), "100|zh-CN|1")
+		resp.Close()
+
+		// Absent headers.
+		t.Assert(client.GetContent(ctx, "/"), "<nil>|<nil>|<nil>")
+
+		// Parsing error is skipped.
+		t.Assert(client.Header(g.MapStrStr{
+			"X-Tenant":  "100",
+			"X-User-Id": "invalid",
+		}).GetContent(ctx, "/"), "100|<nil>|<nil>")
+
+		// Parsing error is rejected.
+		resp, err = client.Header(g.MapStrStr{
+			"X-Tenant": "invalid",
+		}).Get(ctx, "/")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusBadRequest)
+		t.Assert(resp.Header.Get("X-Hook-Tenant"), "")
+		t.Assert(strings.Contains(resp.ReadAllString(), `invalid value of header "X-Tenant"`), true)
+		resp.Close()
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Server_AddListener(t *testing.T) {
+	s := g.Server(guid.S())
+	s.Use(func(r *ghttp.Request) {
+		r.Response.Header().Add("X-Middleware", "global")
+		r.Middleware.Next()
+	})
+	s.AddListener(ghttp.FreePortAddress, ghttp.ListenerOption{
+		Name: "admin",
+		Middleware: []ghttp.HandlerFunc{func(r *ghttp.Request) {
+			r.Response.Header().Add("X-Middleware", "admin")
+			r.Middleware.Next()
+		}},
+		Prefixes: []string{"/admin"},
+	})
+	s.BindHandler("/admin/info", func(r *ghttp.Request) {
+		r.Response.Write("listener:", r.GetListenerName())
+	})
+	s.BindHandler("/public", func(r *ghttp.Request) {
+		r.Response.Write("public")
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		t.AssertGT(s.GetListenerPort("admin"), 0)
+		t.AssertNE(s.GetListenerPort("admin"), s.GetListenedPort())
+		t.Assert(s.GetListenerPort("none"), -1)
+
+		var (
+			publicClient = g.Client()
+			adminClient  = g.Client()
+		)
+		publicClient.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		adminClient.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenerPort("admin")))
+
+		res, err := publicClient.Get(ctx, "/admin/info")
+		t.AssertNil(err)
+		t.Assert(res.ReadAllString(), "listener:")
+		t.Assert(res.Header.Values("X-Middleware"), []string{"global"})
+		res.Close()
+
+		res, err = publicClient.Get(ctx, "/public")
+		t.AssertNil(err)
+		t.Assert(res.ReadAllString(), "public")
+		res.Close()
+
+		res, err = adminClient.Get(ctx, "/admin/info")
+		t.AssertNil(err)
+		t.Assert(res.ReadAllString(), "listener:admin")
+		t.Assert(res.Header.Values("X-Middleware"), []string{"admin", "global"})
+		res.Close()
+
+		res, err = adminClient.Get(ctx, "/public")
+		t.AssertNil(err)
+		t.Assert(res.StatusCode, http.StatusNotFound)
+		res.Close()
+	})
+}
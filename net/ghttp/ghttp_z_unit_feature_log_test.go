@@ -9,6 +9,7 @@
 package ghttp_test
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/gogf/gf/v2/frame/g"
 	"github.com/gogf/gf/v2/net/ghttp"
 	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/os/glog"
 	"github.com/gogf/gf/v2/os/gtime"
 	"github.com/gogf/gf/v2/test/gtest"
 	"github.com/gogf/gf/v2/text/gstr"
@@ -62,3 +64,180 @@ func Test_Log(t *testing.T) {
 		t.Assert(gstr.Contains(gfile.GetContents(logPath3), "custom error"), true)
 	})
 }
+
+func Test_Log_AccessLogSampler(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		logDir := gfile.Temp(gtime.TimestampNanoStr())
+		s := g.Server(guid.S())
+		s.BindHandler("/health", func(r *ghttp.Request) {
+			r.Response.Write("ok")
+		})
+		s.BindHandler("/health/error", func(r *ghttp.Request) {
+			panic("health error")
+		})
+		s.BindHandler("/user", func(r *ghttp.Request) {
+			r.Response.Write("user")
+		})
+		s.SetLogPath(logDir)
+		s.SetAccessLogEnabled(true)
+		s.SetLogStdout(false)
+		s.SetAccessLogSampler(func(r *ghttp.Request) bool {
+			return !gstr.HasPrefix(r.URL.Path, "/health")
+		})
+		s.Start()
+		defer s.Shutdown()
+		defer gfile.Remove(logDir)
+		time.Sleep(100 * time.Millisecond)
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		t.Assert(client.GetContent(ctx, "/health"), "ok")
+		t.Assert(client.GetContent(ctx, "/health/error"), "exception recovered: health error")
+		t.Assert(client.GetContent(ctx, "/user"), "user")
+
+		var (
+			logPath = gfile.Join(logDir, "access-"+gtime.Now().Format("Ymd")+".log")
+			content = gfile.GetContents(logPath)
+		)
+		t.Assert(gstr.Contains(content, " /health "), false)
+		t.Assert(gstr.Contains(content, " /health/error "), true)
+		t.Assert(gstr.Contains(content, " /user "), true)
+	})
+}
+
+func Test_Log_SlowRequest(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		logDir := gfile.Temp(gtime.TimestampNanoStr())
+		s := g.Server(guid.S())
+		s.BindHandler("/fast", func(r *ghttp.Request) {
+			r.Response.Write("fast")
+		})
+		s.BindHandler("/slow/{id}", func(r *ghttp.Request) {
+			time.Sleep(200 * time.Millisecond)
+			r.Response.Write("slow")
+		})
+		s.SetLogPath(logDir)
+		s.SetLogStdout(false)
+		s.SetSlowRequestThreshold(100 * time.Millisecond)
+		s.Start()
+		defer s.Shutdown()
+		defer gfile.Remove(logDir)
+		time.Sleep(100 * time.Millisecond)
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		t.Assert(client.GetContent(ctx, "/fast"), "fast")
+		t.Assert(client.GetContent(ctx, "/slow/1"), "slow")
+
+		var (
+			logPath = gfile.Join(logDir, gtime.Now().Format("Y-m-d")+".log")
+			content = gfile.GetContents(logPath)
+		)
+		t.Assert(gstr.Contains(content, "[WARN]"), true)
+		t.Assert(gstr.Contains(content, `slow request: 200 "GET http`), true)
+		t.Assert(gstr.Contains(content, `route "/slow/{id}"`), true)
+		t.Assert(gstr.Contains(content, "/fast HTTP/1.1"), false)
+	})
+}
+
+func Test_Log_AccessLogWriter(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			logDir = gfile.Temp(gtime.TimestampNanoStr())
+			buf    = &SafeBuffer{buffer: bytes.NewBuffer(nil)}
+		)
+		s := g.Server(guid.S())
+		s.BindHandler("/hello", func(r *ghttp.Request) {
+			r.Response.Write("hello")
+		})
+		s.BindHandler("/error", func(r *ghttp.Request) {
+			panic("custom error")
+		})
+		s.SetLogPath(logDir)
+		s.SetAccessLogWriter(buf)
+		s.SetLogStdout(false)
+		s.Start()
+		defer s.Shutdown()
+		defer gfile.Remove(logDir)
+		time.Sleep(100 * time.Millisecond)
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		t.Assert(client.GetContent(ctx, "/hello"), "hello")
+		t.Assert(client.GetContent(ctx, "/error"), "exception recovered: custom error")
+
+		t.Assert(gstr.Contains(buf.String(), " /hello "), true)
+		t.Assert(gstr.Contains(buf.String(), " /error "), true)
+		t.Assert(gstr.Contains(buf.String(), "custom error"), false)
+		// The access logs are not written to files, but the error logs are.
+		t.Assert(gfile.Exists(gfile.Join(logDir, "access-"+gtime.Now().Format("Ymd")+".log")), false)
+		t.Assert(gstr.Contains(
+			gfile.GetContents(gfile.Join(logDir, "error-"+gtime.Now().Format("Ymd")+".log")), "custom error",
+		), true)
+	})
+}
+
+func Test_Log_AccessLogger(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			logDir       = gfile.Temp(gtime.TimestampNanoStr())
+			accessLogDir = gfile.Join(logDir, "access")
+			accessLogger = glog.New()
+		)
+		t.AssertNil(accessLogger.SetConfigWithMap(g.Map{
+			"path":        accessLogDir,
+			"file":        "http-{Ymd}.log",
+			"stdoutPrint": false,
+		}))
+		s := g.Server(guid.S())
+		s.BindHandler("/hello", func(r *ghttp.Request) {
+			r.Response.Write("hello")
+		})
+		s.SetLogPath(logDir)
+		s.SetAccessLogger(accessLogger)
+		s.SetLogStdout(false)
+		s.Start()
+		defer s.Shutdown()
+		defer gfile.Remove(logDir)
+		time.Sleep(100 * time.Millisecond)
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		t.Assert(client.GetContent(ctx, "/hello"), "hello")
+		t.Assert(gstr.Contains(
+			gfile.GetContents(gfile.Join(accessLogDir, "http-"+gtime.Now().Format("Ymd")+".log")), " /hello ",
+		), true)
+		t.Assert(gfile.Exists(gfile.Join(logDir, "access-"+gtime.Now().Format("Ymd")+".log")), false)
+	})
+}
+
+func Test_Log_AccessLogRotateSize(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		logDir := gfile.Temp(gtime.TimestampNanoStr())
+		s := g.Server(guid.S())
+		s.BindHandler("/hello", func(r *ghttp.Request) {
+			r.Response.Write("hello")
+		})
+		t.AssertNil(s.SetConfigWithMap(g.Map{
+			"logPath":                    logDir,
+			"accessLogEnabled":           true,
+			"accessLogRotateSize":        "1b",
+			"accessLogRotateBackupLimit": 10,
+			"logStdout":                  false,
+		}))
+		s.Start()
+		defer s.Shutdown()
+		defer gfile.Remove(logDir)
+		time.Sleep(100 * time.Millisecond)
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		for i := 0; i < 3; i++ {
+			t.Assert(client.GetContent(ctx, "/hello"), "hello")
+			time.Sleep(10 * time.Millisecond)
+		}
+		files, err := gfile.ScanDirFile(logDir, "access-*")
+		t.AssertNil(err)
+		t.AssertGE(len(files), 2)
+	})
+}
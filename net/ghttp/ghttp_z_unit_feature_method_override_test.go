@@ -0,0 +1,62 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Server_MethodOverride(t *testing.T) {
+	var handler = func(r *ghttp.Request) {
+		r.Response.Write(r.Method, ":", r.Get("name"))
+	}
+	s := g.Server(guid.S())
+	s.SetMethodOverride(true)
+	s.BindHandler("GET:/user", handler)
+	s.BindHandler("POST:/user", handler)
+	s.BindHandler("PUT:/user", handler)
+	s.BindHandler("DELETE:/user", handler)
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client().Prefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		// Form field.
+		t.Assert(client.PostContent(ctx, "/user", "_method=put&name=john"), "PUT:john")
+		t.Assert(client.PostContent(ctx, "/user", g.Map{"_method": "DELETE", "name": "john"}), "DELETE:john")
+		// Header has priority.
+		t.Assert(
+			client.Header(g.MapStrStr{ghttp.HeaderMethodOverride: "PUT"}).PostContent(ctx, "/user", "_method=DELETE"),
+			"PUT:",
+		)
+		// Invalid target method is ignored.
+		t.Assert(client.PostContent(ctx, "/user", "_method=GET&name=john"), "POST:john")
+		t.Assert(client.PostContent(ctx, "/user", "_method=CONNECT"), "POST:")
+		// Only POST can be overridden.
+		t.Assert(
+			client.Header(g.MapStrStr{ghttp.HeaderMethodOverride: "DELETE"}).GetContent(ctx, "/user?name=john"),
+			"GET:john",
+		)
+		// JSON body is not parsed for overriding.
+		t.Assert(client.ContentJson().PostContent(ctx, "/user", g.Map{"_method": "PUT", "name": "john"}), "POST:john")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		s.SetMethodOverride(false)
+		defer s.SetMethodOverride(true)
+		client := g.Client().Prefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		t.Assert(client.PostContent(ctx, "/user", "_method=PUT&name=john"), "POST:john")
+	})
+}
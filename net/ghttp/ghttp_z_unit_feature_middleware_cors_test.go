@@ -163,3 +163,76 @@ func Test_Middleware_CORS3(t *testing.T) {
 		resp.Close()
 	})
 }
+
+func Test_Middleware_CORSWithOptions_Preflight(t *testing.T) {
+	var handlerCalled bool
+	s := g.Server(guid.S())
+	s.Use(ghttp.MiddlewareCORSWithOptions(ghttp.CORSOptions{
+		AllowDomain: []string{"goframe.org"},
+		MaxAge:      600,
+	}))
+	s.Group("/api", func(group *ghttp.RouterGroup) {
+		group.GET("/user", func(r *ghttp.Request) {
+			handlerCalled = true
+			r.Response.Write("get")
+		})
+		group.PUT("/user", func(r *ghttp.Request) {
+			handlerCalled = true
+			r.Response.Write("put")
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		client.SetHeader("Origin", "https://goframe.org")
+		client.SetHeader("Access-Control-Request-Method", "PUT")
+		resp, err := client.Options(ctx, "/api/user")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, 204)
+		t.Assert(resp.Header.Get("Access-Control-Allow-Methods"), "GET,PUT")
+		t.Assert(resp.Header.Get("Access-Control-Allow-Origin"), "https://goframe.org")
+		t.Assert(resp.Header.Get("Access-Control-Max-Age"), "600")
+		t.Assert(resp.ReadAllString(), "")
+		t.Assert(handlerCalled, false)
+		resp.Close()
+	})
+	// Method not registered for the path, it still responds the allowed methods.
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		client.SetHeader("Access-Control-Request-Method", "DELETE")
+		resp, err := client.Options(ctx, "/api/user")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, 204)
+		t.Assert(resp.Header.Get("Access-Control-Allow-Methods"), "GET,PUT")
+		resp.Close()
+	})
+	// No route for the path.
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		client.SetHeader("Access-Control-Request-Method", "GET")
+		resp, err := client.Options(ctx, "/api/none")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, 404)
+		resp.Close()
+	})
+	// Actual request.
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		client.SetHeader("Origin", "https://goframe.org")
+		resp, err := client.Put(ctx, "/api/user")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, 200)
+		t.Assert(resp.Header.Get("Access-Control-Allow-Origin"), "https://goframe.org")
+		t.Assert(resp.ReadAllString(), "put")
+		t.Assert(handlerCalled, true)
+		resp.Close()
+	})
+}
@@ -0,0 +1,116 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/encoding/gjson"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gmode"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Server_NotFoundDetail(t *testing.T) {
+	var mode = gmode.Mode()
+	defer gmode.Set(mode)
+
+	s := g.Server(guid.S())
+	s.SetNotFoundDetail(true)
+	s.BindHandler("GET:/user/{id}", func(r *ghttp.Request) {
+		r.Response.Write("user")
+	})
+	s.BindHandler("POST:/user", func(r *ghttp.Request) {
+		r.Response.Write("create")
+	})
+	s.BindHandler("/order/list", func(r *ghttp.Request) {
+		r.Response.Write("orders")
+	})
+	s.Group("/api", func(group *ghttp.RouterGroup) {
+		group.Middleware(func(r *ghttp.Request) {
+			r.Middleware.Next()
+		})
+		group.GET("/goods", func(r *ghttp.Request) {
+			r.Response.Write("goods")
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	prefix := fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort())
+	// Develop mode.
+	gtest.C(t, func(t *gtest.T) {
+		gmode.SetDevelop()
+		client := g.Client().Prefix(prefix)
+		resp, err := client.Get(ctx, "/users")
+		t.AssertNil(err)
+		defer resp.Close()
+		t.Assert(resp.StatusCode, http.StatusNotFound)
+
+		j, err := gjson.LoadContent(resp.ReadAll())
+		t.AssertNil(err)
+		t.Assert(j.Get("method"), "GET")
+		t.Assert(j.Get("path"), "/users")
+		t.Assert(j.Get("suggestions.0.pattern"), "/user")
+		t.Assert(j.Get("suggestions.0.method"), "POST")
+		t.Assert(j.Get("suggestions.0.distance"), 1)
+		t.AssertLE(len(j.Get("suggestions").Array()), 5)
+
+		// The request matching the middleware only.
+		resp2, err := client.Get(ctx, "/api/good")
+		t.AssertNil(err)
+		defer resp2.Close()
+		t.Assert(resp2.StatusCode, http.StatusNotFound)
+		j, err = gjson.LoadContent(resp2.ReadAll())
+		t.AssertNil(err)
+		t.Assert(j.Get("suggestions.0.pattern"), "/api/goods")
+
+		// The matched routes are not affected.
+		t.Assert(client.GetContent(ctx, "/user/1"), "user")
+	})
+	// Product mode.
+	gtest.C(t, func(t *gtest.T) {
+		gmode.SetProduct()
+		client := g.Client().Prefix(prefix)
+		resp, err := client.Get(ctx, "/users")
+		t.AssertNil(err)
+		defer resp.Close()
+		t.Assert(resp.StatusCode, http.StatusNotFound)
+		t.Assert(resp.ReadAllString(), "Not Found")
+	})
+}
+
+func Test_Server_NotFoundDetail_Disabled(t *testing.T) {
+	var mode = gmode.Mode()
+	defer gmode.Set(mode)
+
+	s := g.Server(guid.S())
+	s.BindHandler("/user", func(r *ghttp.Request) {
+		r.Response.Write("user")
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		gmode.SetDevelop()
+		client := g.Client().Prefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		resp, err := client.Get(ctx, "/users")
+		t.AssertNil(err)
+		defer resp.Close()
+		t.Assert(resp.StatusCode, http.StatusNotFound)
+		t.Assert(resp.ReadAllString(), "Not Found")
+	})
+}
@@ -65,3 +65,44 @@ func TestServer_StartPProfServer(t *testing.T) {
 		}
 	})
 }
+
+func TestServer_EnablePProfWithGuard(t *testing.T) {
+	C(t, func(t *T) {
+		s := g.Server(guid.S())
+		s.Use(func(r *ghttp.Request) {
+			r.SetCtxVar("admin", r.Header.Get("Token") == "admin")
+			r.Middleware.Next()
+		})
+		s.EnablePProfWithGuard("/pprof", func(r *ghttp.Request) bool {
+			return r.GetCtxVar("admin").Bool()
+		})
+		s.BindHandler("/hello", func(r *ghttp.Request) {
+			r.Response.Write("hello")
+		})
+		s.SetDumpRouterMap(false)
+		s.Start()
+		defer s.Shutdown()
+		time.Sleep(100 * time.Millisecond)
+
+		urlPaths := []string{
+			"/pprof/index", "/pprof/cmdline", "/pprof/symbol", "/pprof/trace",
+		}
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		for _, urlPath := range urlPaths {
+			r, err := client.Get(ctx, urlPath)
+			t.AssertNil(err)
+			t.Assert(r.StatusCode, 403)
+			t.AssertNil(r.Close())
+		}
+		t.Assert(client.GetContent(ctx, "/hello"), "hello")
+
+		client.SetHeader("Token", "admin")
+		for _, urlPath := range urlPaths {
+			r, err := client.Get(ctx, urlPath)
+			t.AssertNil(err)
+			t.Assert(r.StatusCode, 200)
+			t.AssertNil(r.Close())
+		}
+	})
+}
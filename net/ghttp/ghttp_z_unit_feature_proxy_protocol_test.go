@@ -0,0 +1,179 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+// sendWithProxyProtocolHeader sends the `header` and a simple GET request through raw connection,
+// and returns the response content.
+func sendWithProxyProtocolHeader(port int, header []byte) (string, error) {
+	conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+	var request = bytes.NewBuffer(header)
+	request.WriteString("GET /ip HTTP/1.1\r\nHost: 127.0.0.1\r\nConnection: close\r\n\r\n")
+	if _, err = conn.Write(request.Bytes()); err != nil {
+		return "", err
+	}
+	content, err := io.ReadAll(conn)
+	return string(content), err
+}
+
+func Test_ProxyProtocol(t *testing.T) {
+	s := g.Server(guid.S())
+	s.SetProxyProtocol(true)
+	s.BindHandler("/ip", func(r *ghttp.Request) {
+		r.Response.Write(r.RemoteAddr)
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	// PROXY protocol v1.
+	gtest.C(t, func(t *gtest.T) {
+		content, err := sendWithProxyProtocolHeader(
+			s.GetListenedPort(), []byte("PROXY TCP4 1.2.3.4 5.6.7.8 1111 80\r\n"),
+		)
+		t.AssertNil(err)
+		t.Assert(strings.Contains(content, "1.2.3.4:1111"), true)
+
+		content, err = sendWithProxyProtocolHeader(
+			s.GetListenedPort(), []byte("PROXY TCP6 2001:db8::1 2001:db8::2 2222 80\r\n"),
+		)
+		t.AssertNil(err)
+		t.Assert(strings.Contains(content, "[2001:db8::1]:2222"), true)
+
+		// The original address is used for UNKNOWN protocol.
+		content, err = sendWithProxyProtocolHeader(s.GetListenedPort(), []byte("PROXY UNKNOWN\r\n"))
+		t.AssertNil(err)
+		t.Assert(strings.Contains(content, "127.0.0.1:"), true)
+	})
+	// PROXY protocol v2.
+	gtest.C(t, func(t *gtest.T) {
+		var header = bytes.NewBufferString("\r\n\r\n\x00\r\nQUIT\n")
+		header.Write([]byte{0x21, 0x11})
+		_ = binary.Write(header, binary.BigEndian, uint16(12))
+		header.Write(net.ParseIP("10.0.0.1").To4())
+		header.Write(net.ParseIP("10.0.0.2").To4())
+		_ = binary.Write(header, binary.BigEndian, uint16(3333))
+		_ = binary.Write(header, binary.BigEndian, uint16(80))
+
+		content, err := sendWithProxyProtocolHeader(s.GetListenedPort(), header.Bytes())
+		t.AssertNil(err)
+		t.Assert(strings.Contains(content, "10.0.0.1:3333"), true)
+	})
+	// Malformed headers are rejected.
+	gtest.C(t, func(t *gtest.T) {
+		content, err := sendWithProxyProtocolHeader(s.GetListenedPort(), nil)
+		t.AssertNil(err)
+		t.Assert(content, "")
+
+		content, err = sendWithProxyProtocolHeader(
+			s.GetListenedPort(), []byte("PROXY TCP4 1.2.3.4 5.6.7.8 1111\r\n"),
+		)
+		t.AssertNil(err)
+		t.Assert(content, "")
+
+		content, err = sendWithProxyProtocolHeader(
+			s.GetListenedPort(), []byte("PROXY TCP4 2001:db8::1 5.6.7.8 1111 80\r\n"),
+		)
+		t.AssertNil(err)
+		t.Assert(content, "")
+	})
+}
+
+func Test_ProxyProtocol_SlowHeader(t *testing.T) {
+	s := g.Server(guid.S())
+	s.SetProxyProtocol(true)
+	s.BindHandler("/ip", func(r *ghttp.Request) {
+		r.Response.Write(r.RemoteAddr)
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	// The remote address waits for the header sent later, and the idle connection
+	// without header does not block serving the other connections.
+	gtest.C(t, func(t *gtest.T) {
+		idleConn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", s.GetListenedPort()))
+		t.AssertNil(err)
+		defer idleConn.Close()
+
+		conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", s.GetListenedPort()))
+		t.AssertNil(err)
+		defer conn.Close()
+		_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+		time.Sleep(200 * time.Millisecond)
+		_, err = conn.Write([]byte(
+			"PROXY TCP4 1.2.3.4 5.6.7.8 1111 80\r\n" +
+				"GET /ip HTTP/1.1\r\nHost: 127.0.0.1\r\nConnection: close\r\n\r\n",
+		))
+		t.AssertNil(err)
+		content, err := io.ReadAll(conn)
+		t.AssertNil(err)
+		t.Assert(strings.Contains(string(content), "1.2.3.4:1111"), true)
+
+		content2, err := sendWithProxyProtocolHeader(
+			s.GetListenedPort(), []byte("PROXY TCP4 4.3.2.1 5.6.7.8 2222 80\r\n"),
+		)
+		t.AssertNil(err)
+		t.Assert(strings.Contains(content2, "4.3.2.1:2222"), true)
+	})
+}
+
+func Test_ProxyProtocol_TrustedUpstreams(t *testing.T) {
+	s1 := g.Server(guid.S())
+	s1.SetProxyProtocol(true)
+	s1.SetProxyProtocolTrustedUpstreams([]string{"127.0.0.0/8"})
+	s1.BindHandler("/ip", func(r *ghttp.Request) {
+		r.Response.Write(r.GetRemoteIp())
+	})
+	s1.SetDumpRouterMap(false)
+	s1.Start()
+	defer s1.Shutdown()
+
+	s2 := g.Server(guid.S())
+	s2.SetProxyProtocol(true)
+	s2.SetProxyProtocolTrustedUpstreams([]string{"10.0.0.1"})
+	s2.BindHandler("/ip", func(r *ghttp.Request) {
+		r.Response.Write(r.GetRemoteIp())
+	})
+	s2.SetDumpRouterMap(false)
+	s2.Start()
+	defer s2.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		var header = []byte("PROXY TCP4 1.2.3.4 5.6.7.8 1111 80\r\n")
+		content, err := sendWithProxyProtocolHeader(s1.GetListenedPort(), header)
+		t.AssertNil(err)
+		t.Assert(strings.Contains(content, "\r\n1.2.3.4\r\n"), true)
+
+		// The connection from untrusted upstream is closed.
+		content, _ = sendWithProxyProtocolHeader(s2.GetListenedPort(), header)
+		t.Assert(content, "")
+	})
+}
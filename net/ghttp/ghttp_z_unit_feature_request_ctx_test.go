@@ -10,13 +10,18 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	sdkTrace "go.opentelemetry.io/otel/sdk/trace"
+
 	"github.com/gogf/gf/v2/container/garray"
 	"github.com/gogf/gf/v2/encoding/gbase64"
 	"github.com/gogf/gf/v2/frame/g"
 	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/net/gtrace"
 	"github.com/gogf/gf/v2/test/gtest"
 	"github.com/gogf/gf/v2/util/guid"
 )
@@ -435,3 +440,54 @@ func Test_Request_NeverDoneCtx_NeverDone(t *testing.T) {
 		t.Assert(array.Len(), 1)
 	})
 }
+
+func Test_Request_Client(t *testing.T) {
+	provider := otel.GetTracerProvider()
+	defer otel.SetTracerProvider(provider)
+	otel.SetTracerProvider(sdkTrace.NewTracerProvider())
+
+	upstream := g.Server(guid.S())
+	upstream.BindHandler("/trace", func(r *ghttp.Request) {
+		r.Response.Write(r.Header.Get("Traceparent"))
+	})
+	upstream.BindHandler("/slow", func(r *ghttp.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(3 * time.Second):
+			r.Response.Write("slow")
+		}
+	})
+	upstream.SetDumpRouterMap(false)
+	upstream.Start()
+	defer upstream.Shutdown()
+
+	s := g.Server(guid.S())
+	upstreamPrefix := fmt.Sprintf("http://127.0.0.1:%d", upstream.GetListenedPort())
+	s.BindHandler("/trace", func(r *ghttp.Request) {
+		// The trace of current request is propagated even the context is not passed.
+		content := r.Client().GetContent(context.Background(), upstreamPrefix+"/trace")
+		traceId := gtrace.GetTraceID(r.Context())
+		r.Response.Write(traceId != "" && strings.Contains(content, traceId))
+	})
+	s.BindHandler("/slow", func(r *ghttp.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), 200*time.Millisecond)
+		defer cancel()
+		r.SetCtx(ctx)
+		var (
+			start   = time.Now()
+			content = r.Client().GetContent(context.Background(), upstreamPrefix+"/slow")
+		)
+		r.Response.Write(content == "" && time.Since(start) < 2*time.Second)
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		t.Assert(client.GetContent(ctx, "/trace"), "true")
+		t.Assert(client.GetContent(ctx, "/slow"), "true")
+	})
+}
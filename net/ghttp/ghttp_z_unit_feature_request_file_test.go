@@ -7,12 +7,17 @@
 package ghttp_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"mime/multipart"
+	"net/http"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/frame/g"
 	"github.com/gogf/gf/v2/internal/json"
 	"github.com/gogf/gf/v2/net/ghttp"
@@ -414,3 +419,84 @@ func Test_Params_Strict_Route_File_Batch_Up_Multiple(t *testing.T) {
 		t.Assert(gfile.GetContents(dstPath2), gfile.GetContents(srcPath2))
 	})
 }
+
+func Test_Params_File_Malformed_And_TooLarge(t *testing.T) {
+	var (
+		s         = g.Server(guid.S())
+		errCodeCh = make(chan int, 1)
+	)
+	s.SetClientMaxBodySize(1024)
+	s.SetErrorStatusMapping(true)
+	s.BindHandler("/upload", func(r *ghttp.Request) {
+		r.GetUploadFile("file")
+		r.Response.Write("ok")
+	})
+	s.BindHookHandler("/upload", ghttp.HookAfterServe, func(r *ghttp.Request) {
+		errCodeCh <- gerror.Code(r.GetError()).Code()
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	var (
+		url        = fmt.Sprintf("http://127.0.0.1:%d/upload", s.GetListenedPort())
+		newRequest = func(content []byte, truncated bool) (*http.Request, error) {
+			var (
+				buffer = bytes.NewBuffer(nil)
+				writer = multipart.NewWriter(buffer)
+			)
+			part, err := writer.CreateFormFile("file", "file.txt")
+			if err != nil {
+				return nil, err
+			}
+			if _, err = part.Write(content); err != nil {
+				return nil, err
+			}
+			if !truncated {
+				if err = writer.Close(); err != nil {
+					return nil, err
+				}
+			}
+			req, err := http.NewRequest(http.MethodPost, url, buffer)
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+			return req, nil
+		}
+	)
+	// Valid.
+	gtest.C(t, func(t *gtest.T) {
+		req, err := newRequest([]byte("content"), false)
+		t.AssertNil(err)
+		res, err := http.DefaultClient.Do(req)
+		t.AssertNil(err)
+		defer res.Body.Close()
+		t.Assert(res.StatusCode, http.StatusOK)
+		t.Assert(<-errCodeCh, gcode.CodeNil.Code())
+	})
+	// Malformed.
+	gtest.C(t, func(t *gtest.T) {
+		req, err := newRequest([]byte("content"), true)
+		t.AssertNil(err)
+		res, err := http.DefaultClient.Do(req)
+		t.AssertNil(err)
+		defer res.Body.Close()
+		t.Assert(res.StatusCode, http.StatusBadRequest)
+		t.Assert(<-errCodeCh, gcode.CodeInvalidRequest.Code())
+	})
+	// Too large.
+	gtest.C(t, func(t *gtest.T) {
+		req, err := newRequest(bytes.Repeat([]byte("a"), 2048), false)
+		t.AssertNil(err)
+		res, err := http.DefaultClient.Do(req)
+		t.AssertNil(err)
+		defer res.Body.Close()
+		body := make([]byte, 512)
+		n, _ := res.Body.Read(body)
+		t.Assert(res.StatusCode, http.StatusRequestEntityTooLarge)
+		t.Assert(gstr.Contains(string(body[:n]), "1024 bytes"), true)
+		t.Assert(<-errCodeCh, gcode.CodeRequestTooLarge.Code())
+	})
+}
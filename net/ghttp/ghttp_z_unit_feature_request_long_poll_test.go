@@ -0,0 +1,109 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/container/gtype"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Request_LongPoll(t *testing.T) {
+	var (
+		counter = gtype.NewInt()
+		errChan = make(chan error, 1)
+	)
+	s := g.Server(guid.S())
+	s.BindHandler("/ready", func(r *ghttp.Request) {
+		counter.Set(0)
+		_ = r.LongPoll(r.Context(), time.Second, func() (any, bool) {
+			if counter.Add(1) < 3 {
+				return nil, false
+			}
+			return g.Map{"count": counter.Val()}, true
+		})
+	})
+	s.BindHandler("/timeout", func(r *ghttp.Request) {
+		_ = r.LongPoll(r.Context(), 300*time.Millisecond, func() (any, bool) {
+			return nil, false
+		})
+	})
+	s.BindHandler("/disconnect", func(r *ghttp.Request) {
+		errChan <- r.LongPoll(r.Context(), 3*time.Second, func() (any, bool) {
+			return nil, false
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		t.Assert(client.GetContent(ctx, "/ready"), `{"count":3}`)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		start := time.Now()
+		resp, err := client.Get(ctx, "/timeout")
+		t.AssertNil(err)
+		defer resp.Close()
+		t.Assert(resp.StatusCode, http.StatusNoContent)
+		t.Assert(resp.ReadAllString(), "")
+		t.AssertGE(time.Since(start), 300*time.Millisecond)
+	})
+	// Client disconnection.
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		timeoutCtx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+		defer cancel()
+		_, err := client.Get(timeoutCtx, "/disconnect")
+		t.AssertNE(err, nil)
+		select {
+		case err = <-errChan:
+			t.Assert(err, context.Canceled)
+		case <-time.After(time.Second):
+			t.Error("long-polling is not canceled by client disconnection")
+		}
+	})
+}
+
+func Test_Request_LongPoll_WriteTimeout(t *testing.T) {
+	s := g.Server(guid.S())
+	s.SetWriteTimeout(time.Second)
+	s.BindHandler("/", func(r *ghttp.Request) {
+		_ = r.LongPoll(r.Context(), time.Minute, func() (any, bool) {
+			return nil, false
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		start := time.Now()
+		resp, err := client.Get(ctx, "/")
+		t.AssertNil(err)
+		defer resp.Close()
+		t.Assert(resp.StatusCode, http.StatusNoContent)
+		t.AssertLT(time.Since(start), time.Second)
+	})
+}
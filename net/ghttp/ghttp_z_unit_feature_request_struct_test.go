@@ -7,10 +7,14 @@
 package ghttp_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/frame/g"
 	"github.com/gogf/gf/v2/net/ghttp"
 	"github.com/gogf/gf/v2/test/gtest"
@@ -550,6 +554,257 @@ func Test_Params_Struct_Validation(t *testing.T) {
 	})
 }
 
+func Test_Params_ParseValidate(t *testing.T) {
+	type User struct {
+		Id   int    `v:"required"`
+		Name string `v:"required"`
+	}
+	s := g.Server(guid.S())
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.ALL("/", func(r *ghttp.Request) {
+			var user *User
+			if err := r.ParseValidate(&user); err != nil {
+				validationErr, ok := err.(gvalid.Error)
+				r.Response.WriteExit(ok, len(validationErr.Items()), ":", err)
+			}
+			r.Response.WriteExit(user.Id, user.Name)
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+
+	time.Sleep(100 * time.Millisecond)
+	gtest.C(t, func(t *gtest.T) {
+		c := g.Client()
+		c.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		t.Assert(c.GetContent(ctx, "/", ``), `true2:The Id field is required; The Name field is required`)
+		t.Assert(c.GetContent(ctx, "/", `id=1`), `true1:The Name field is required`)
+		t.Assert(c.GetContent(ctx, "/", `id=1&name=john`), `1john`)
+	})
+}
+
+type testParseValidationReq struct {
+	g.Meta `path:"/validation" method:"get"`
+	Id     int `v:"required"`
+}
+
+type testParseNoValidationReq struct {
+	g.Meta `path:"/no-validation" method:"get" nv:"true"`
+	Id     int `v:"required"`
+}
+
+type testParseNoValidationOtherReq struct {
+	g.Meta `path:"/no-validation-other" method:"get" nv:"true"`
+}
+
+type testParseValidationRes struct {
+	Id int
+}
+
+type testParseValidationController struct{}
+
+func (c *testParseValidationController) Validation(
+	ctx context.Context, req *testParseValidationReq,
+) (res *testParseValidationRes, err error) {
+	return &testParseValidationRes{Id: req.Id}, nil
+}
+
+func (c *testParseValidationController) NoValidation(
+	ctx context.Context, req *testParseNoValidationReq,
+) (res *testParseValidationRes, err error) {
+	return &testParseValidationRes{Id: req.Id}, nil
+}
+
+// NoValidationOther parses another struct without meta tag `nv`, which is still validated.
+func (c *testParseValidationController) NoValidationOther(
+	ctx context.Context, req *testParseNoValidationOtherReq,
+) (res *testParseValidationRes, err error) {
+	var other *testParseValidationReq
+	if err = g.RequestFromCtx(ctx).Parse(&other); err != nil {
+		return nil, err
+	}
+	return &testParseValidationRes{Id: other.Id}, nil
+}
+
+func Test_Params_Parse_ValidationStatus(t *testing.T) {
+	s := g.Server(guid.S())
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareHandlerResponse)
+		group.Bind(new(testParseValidationController))
+	})
+	s.Group("/raw", func(group *ghttp.RouterGroup) {
+		group.Bind(new(testParseValidationController))
+	})
+	s.BindHandler("/invalid-request", func(r *ghttp.Request) {
+		panic(gerror.NewCode(gcode.CodeInvalidRequest, "invalid request"))
+	})
+	s.SetErrorStatusMapping(true)
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+
+	time.Sleep(100 * time.Millisecond)
+	gtest.C(t, func(t *gtest.T) {
+		c := g.Client()
+		c.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		resp, err := c.Get(ctx, "/validation")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusBadRequest)
+		t.Assert(resp.ReadAllString(), `{"code":51,"message":"The Id field is required","data":null}`)
+		resp.Close()
+
+		resp, err = c.Get(ctx, "/raw/validation")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusBadRequest)
+		resp.Close()
+
+		resp, err = c.Get(ctx, "/validation?id=1")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusOK)
+		t.Assert(resp.ReadAllString(), `{"code":0,"message":"OK","data":{"Id":1}}`)
+		resp.Close()
+
+		resp, err = c.Get(ctx, "/no-validation")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusOK)
+		t.Assert(resp.ReadAllString(), `{"code":0,"message":"OK","data":{"Id":0}}`)
+		resp.Close()
+
+		resp, err = c.Get(ctx, "/invalid-request")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusBadRequest)
+		resp.Close()
+
+		resp, err = c.Get(ctx, "/no-validation-other")
+		t.AssertNil(err)
+		t.Assert(resp.ReadAllString(), `{"code":51,"message":"The Id field is required","data":null}`)
+		resp.Close()
+	})
+}
+
+func Test_Params_Parse_ValidationStatus_Default(t *testing.T) {
+	s := g.Server(guid.S())
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareHandlerResponse)
+		group.Bind(new(testParseValidationController))
+	})
+	s.Group("/raw", func(group *ghttp.RouterGroup) {
+		group.Bind(new(testParseValidationController))
+	})
+	s.BindHandler("/invalid-request", func(r *ghttp.Request) {
+		panic(gerror.NewCode(gcode.CodeInvalidRequest, "invalid request"))
+	})
+	var handler = func(r *ghttp.Request) {
+		var req *testParseValidationReq
+		if err := r.Parse(&req); err != nil {
+			r.Response.WriteStatus(http.StatusBadRequest, err.Error())
+			return
+		}
+		r.Response.Write(req.Id)
+	}
+	s.BindHandler("/handler/validation", handler)
+	s.BindHandler("/handler/no-validation", handler, ghttp.RouteNoValidation())
+	s.Group("/group", func(group *ghttp.RouterGroup) {
+		group.GET("/no-validation", handler, ghttp.RouteNoValidation())
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+
+	time.Sleep(100 * time.Millisecond)
+	// The error status mapping is disabled in default, but the validation error is still mapped to status 400.
+	gtest.C(t, func(t *gtest.T) {
+		c := g.Client()
+		c.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		resp, err := c.Get(ctx, "/validation")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusBadRequest)
+		t.Assert(resp.ReadAllString(), `{"code":51,"message":"The Id field is required","data":null}`)
+		resp.Close()
+
+		resp, err = c.Get(ctx, "/raw/validation")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusBadRequest)
+		resp.Close()
+
+		resp, err = c.Get(ctx, "/invalid-request")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusInternalServerError)
+		resp.Close()
+	})
+	// The validation is disabled for route registered with RouteNoValidation.
+	gtest.C(t, func(t *gtest.T) {
+		c := g.Client()
+		c.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		resp, err := c.Get(ctx, "/handler/validation")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusBadRequest)
+		t.Assert(resp.ReadAllString(), `The Id field is required`)
+		resp.Close()
+
+		resp, err = c.Get(ctx, "/handler/no-validation")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusOK)
+		t.Assert(resp.ReadAllString(), `0`)
+		resp.Close()
+
+		resp, err = c.Get(ctx, "/group/no-validation")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusOK)
+		t.Assert(resp.ReadAllString(), `0`)
+		resp.Close()
+	})
+}
+
+func Test_Params_Parse_QueryStyle(t *testing.T) {
+	type Req struct {
+		Name    string   `json:"name"`
+		Page    int      `json:"page"`
+		Ids     []int    `json:"ids" style:"form,explode"`
+		Codes   []int    `json:"codes" style:"form" explode:"false"`
+		Tags    []string `json:"tags" style:"spaceDelimited"`
+		Levels  []int    `json:"levels" style:"pipeDelimited"`
+		Default []string `json:"default"`
+	}
+	s := g.Server(guid.S())
+	s.BindHandler("/parse", func(r *ghttp.Request) {
+		var req *Req
+		if err := r.Parse(&req); err != nil {
+			r.Response.WriteExit(err)
+		}
+		r.Response.WriteExit(req)
+	})
+	s.BindHandler("/parse-query", func(r *ghttp.Request) {
+		var req *Req
+		if err := r.ParseQuery(&req); err != nil {
+			r.Response.WriteExit(err)
+		}
+		r.Response.WriteExit(req)
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+
+	time.Sleep(100 * time.Millisecond)
+	gtest.C(t, func(t *gtest.T) {
+		c := g.Client()
+		c.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		query := "name=john&page=2&ids=1&ids=2&ids=3&codes=4,5,6&tags=a%20b&levels=7|8&default=x&default=y"
+		expect := `{"name":"john","page":2,"ids":[1,2,3],"codes":[4,5,6],"tags":["a","b"],"levels":[7,8],"default":["y"]}`
+		t.Assert(c.GetContent(ctx, "/parse?"+query), expect)
+		t.Assert(c.GetContent(ctx, "/parse-query?"+query), expect)
+		// Exploded parameters are also accepted for delimited style.
+		t.Assert(
+			c.GetContent(ctx, "/parse?codes=1,2&codes=3"),
+			`{"name":"","page":0,"ids":null,"codes":[1,2,3],"tags":null,"levels":null,"default":null}`,
+		)
+	})
+}
+
 // https://github.com/gogf/gf/issues/1488
 func Test_Params_Parse_Issue1488(t *testing.T) {
 	s := g.Server(guid.S())
@@ -594,3 +849,43 @@ func Test_Params_Parse_Issue1488(t *testing.T) {
 		t.Assert(c.GetContent(ctx, "/", ``), `16161616161616161616`)
 	})
 }
+
+func Test_Params_Parse_IndexedKeys(t *testing.T) {
+	type Item struct {
+		Name string
+		Sku  string
+	}
+	type Order struct {
+		Items []Item
+	}
+	type Request struct {
+		Items  []Item `v:"required"`
+		Orders []Order
+	}
+	s := g.Server(guid.S())
+	s.BindHandler("/", func(r *ghttp.Request) {
+		var req *Request
+		if err := r.Parse(&req); err != nil {
+			r.Response.WriteExit(err)
+		}
+		r.Response.WriteJsonExit(req)
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+
+	time.Sleep(100 * time.Millisecond)
+	gtest.C(t, func(t *gtest.T) {
+		c := g.Client()
+		c.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		t.Assert(
+			c.PostContent(ctx, "/", `items[0].name=a&items[1].name=b&orders[0].items[1].sku=x`),
+			`{"Items":[{"Name":"a","Sku":""},{"Name":"b","Sku":""}],"Orders":[{"Items":[{"Name":"","Sku":""},{"Name":"","Sku":"x"}]}]}`,
+		)
+		t.Assert(
+			c.GetContent(ctx, "/", `items%5B0%5D.name=a`),
+			`{"Items":[{"Name":"a","Sku":""}],"Orders":null}`,
+		)
+		t.Assert(c.PostContent(ctx, "/", `orders[0].items[0].sku=x`), `The Items field is required`)
+	})
+}
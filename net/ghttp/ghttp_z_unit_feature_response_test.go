@@ -8,6 +8,7 @@ package ghttp_test
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strings"
@@ -321,3 +322,241 @@ func Test_Response_Write(t *testing.T) {
 		t.Assert(client.GetContent(ctx, "/WriteXmlWithStruct"), "<name>john</name>")
 	})
 }
+
+func Test_Response_SetTrailer(t *testing.T) {
+	s := g.Server(guid.S())
+	s.BindHandler("/stream", func(r *ghttp.Request) {
+		r.Response.SetTrailer("X-Checksum", "")
+		r.Response.Write("hello ")
+		r.Response.Flush()
+		r.Response.Write("world")
+		r.Response.SetTrailer("x-checksum", "abc")
+	})
+	s.BindHandler("/late", func(r *ghttp.Request) {
+		r.Response.Write("hello")
+		r.Response.Flush()
+		r.Response.SetTrailer("X-Status", "done")
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		prefix := fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort())
+		resp, err := http.Get(prefix + "/stream")
+		t.AssertNil(err)
+		defer resp.Body.Close()
+		// The announced trailer keys are available before body reading.
+		_, announced := resp.Trailer["X-Checksum"]
+		t.Assert(announced, true)
+		body, err := io.ReadAll(resp.Body)
+		t.AssertNil(err)
+		t.Assert(string(body), "hello world")
+		t.Assert(resp.Trailer.Get("X-Checksum"), "abc")
+	})
+	// Trailer is set after header is sent.
+	gtest.C(t, func(t *gtest.T) {
+		prefix := fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort())
+		resp, err := http.Get(prefix + "/late")
+		t.AssertNil(err)
+		defer resp.Body.Close()
+		_, announced := resp.Trailer["X-Status"]
+		t.Assert(announced, false)
+		body, err := io.ReadAll(resp.Body)
+		t.AssertNil(err)
+		t.Assert(string(body), "hello")
+		t.Assert(resp.Trailer.Get("X-Status"), "done")
+	})
+}
+
+func Test_Response_SetWriteDeadline(t *testing.T) {
+	s := g.Server(guid.S())
+	s.SetWriteTimeout(200 * time.Millisecond)
+	s.BindHandler("/extended", func(r *ghttp.Request) {
+		if err := r.Response.SetWriteDeadline(time.Now().Add(3 * time.Second)); err != nil {
+			r.Response.WriteStatus(http.StatusInternalServerError, err.Error())
+			return
+		}
+		time.Sleep(500 * time.Millisecond)
+		r.Response.Write("report")
+	})
+	s.BindHandler("/default", func(r *ghttp.Request) {
+		time.Sleep(500 * time.Millisecond)
+		r.Response.Write("report")
+	})
+	s.BindHandler("/shortened", func(r *ghttp.Request) {
+		if err := r.Response.SetWriteDeadline(time.Now().Add(10 * time.Millisecond)); err != nil {
+			r.Response.WriteStatus(http.StatusInternalServerError, err.Error())
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+		r.Response.Write("report")
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	get := func(uri string) (string, error) {
+		resp, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d%s", s.GetListenedPort(), uri))
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		return string(body), err
+	}
+	gtest.C(t, func(t *gtest.T) {
+		body, err := get("/extended")
+		t.AssertNil(err)
+		t.Assert(body, "report")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		_, err := get("/default")
+		t.AssertNE(err, nil)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		_, err := get("/shortened")
+		t.AssertNE(err, nil)
+	})
+}
+
+func Test_Response_SetPaginationLinks(t *testing.T) {
+	s := g.Server(guid.S())
+	s.BindHandler("/list", func(r *ghttp.Request) {
+		r.Response.SetPaginationLinks(r.Get("page").Int(), 10, r.Get("total").Int())
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			prefix = fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort())
+			client = g.Client().Prefix(prefix)
+			link   = func(query, rel string) string {
+				return fmt.Sprintf(`<%s/list?%s>; rel="%s"`, prefix, query, rel)
+			}
+		)
+		// Middle page, keeping the other query parameters.
+		resp, err := client.Get(ctx, "/list?page=3&total=95&kw=go")
+		t.AssertNil(err)
+		t.Assert(resp.Header.Get("Link"), strings.Join([]string{
+			link("kw=go&page=1&total=95", "first"),
+			link("kw=go&page=2&total=95", "prev"),
+			link("kw=go&page=4&total=95", "next"),
+			link("kw=go&page=10&total=95", "last"),
+		}, ", "))
+		resp.Close()
+
+		// First page, adding the page parameter.
+		resp, err = client.Get(ctx, "/list?total=20")
+		t.AssertNil(err)
+		t.Assert(resp.Header.Get("Link"), strings.Join([]string{
+			link("page=1&total=20", "first"),
+			link("page=2&total=20", "next"),
+			link("page=2&total=20", "last"),
+		}, ", "))
+		resp.Close()
+
+		// Last page.
+		resp, err = client.Get(ctx, "/list?page=2&total=20")
+		t.AssertNil(err)
+		t.Assert(resp.Header.Get("Link"), strings.Join([]string{
+			link("page=1&total=20", "first"),
+			link("page=1&total=20", "prev"),
+			link("page=2&total=20", "last"),
+		}, ", "))
+		resp.Close()
+
+		// Empty result.
+		resp, err = client.Get(ctx, "/list?page=1&total=0")
+		t.AssertNil(err)
+		t.Assert(resp.Header.Get("Link"), strings.Join([]string{
+			link("page=1&total=0", "first"),
+			link("page=1&total=0", "last"),
+		}, ", "))
+		resp.Close()
+	})
+}
+
+func Test_Response_ServeContent(t *testing.T) {
+	var (
+		s       = g.Server(guid.S())
+		modTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		content = "0123456789"
+	)
+	s.BindHandler("/media", func(r *ghttp.Request) {
+		r.Response.Write("ignored")
+		r.Cookie.Set("media", "1")
+		r.Response.ServeContent("media.txt", modTime, strings.NewReader(content))
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	prefix := fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort())
+	doRequest := func(t *gtest.T, header map[string]string) (*http.Response, string) {
+		req, err := http.NewRequest(http.MethodGet, prefix+"/media", nil)
+		t.AssertNil(err)
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		t.AssertNil(err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		t.AssertNil(err)
+		return resp, string(body)
+	}
+	// Full content.
+	gtest.C(t, func(t *gtest.T) {
+		resp, body := doRequest(t, nil)
+		t.Assert(resp.StatusCode, http.StatusOK)
+		t.Assert(body, content)
+		t.Assert(resp.Header.Get("Accept-Ranges"), "bytes")
+		t.Assert(resp.Header.Get("Last-Modified"), modTime.Format(http.TimeFormat))
+		t.Assert(strings.Contains(resp.Header.Get("Set-Cookie"), "media=1"), true)
+	})
+	// Range request.
+	gtest.C(t, func(t *gtest.T) {
+		resp, body := doRequest(t, map[string]string{"Range": "bytes=2-5"})
+		t.Assert(resp.StatusCode, http.StatusPartialContent)
+		t.Assert(body, "2345")
+		t.Assert(resp.Header.Get("Content-Range"), "bytes 2-5/10")
+	})
+	// If-Range matches the modification time.
+	gtest.C(t, func(t *gtest.T) {
+		resp, body := doRequest(t, map[string]string{
+			"Range":    "bytes=8-",
+			"If-Range": modTime.Format(http.TimeFormat),
+		})
+		t.Assert(resp.StatusCode, http.StatusPartialContent)
+		t.Assert(body, "89")
+	})
+	// If-Range does not match, the full content is returned.
+	gtest.C(t, func(t *gtest.T) {
+		resp, body := doRequest(t, map[string]string{
+			"Range":    "bytes=8-",
+			"If-Range": modTime.Add(-time.Hour).Format(http.TimeFormat),
+		})
+		t.Assert(resp.StatusCode, http.StatusOK)
+		t.Assert(body, content)
+	})
+	// Conditional request.
+	gtest.C(t, func(t *gtest.T) {
+		resp, body := doRequest(t, map[string]string{
+			"If-Modified-Since": modTime.Format(http.TimeFormat),
+		})
+		t.Assert(resp.StatusCode, http.StatusNotModified)
+		t.Assert(body, "")
+	})
+	// Unsatisfiable range.
+	gtest.C(t, func(t *gtest.T) {
+		resp, _ := doRequest(t, map[string]string{"Range": "bytes=20-"})
+		t.Assert(resp.StatusCode, http.StatusRequestedRangeNotSatisfiable)
+	})
+}
@@ -0,0 +1,100 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/os/glog"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+type ResponseValidationReq struct {
+	g.Meta `path:"/user" method:"get"`
+	Drift  bool
+}
+
+type ResponseValidationItem struct {
+	Name string `json:"name"`
+}
+
+type ResponseValidationRes struct {
+	Id       int                      `json:"id"`
+	Name     string                   `json:"name"`
+	Email    string                   `json:"email,omitempty"`
+	Items    []ResponseValidationItem `json:"items"`
+	Password string                   `json:"-"`
+}
+
+type responseValidationController struct{}
+
+func (responseValidationController) User(ctx context.Context, req *ResponseValidationReq) (res *ResponseValidationRes, err error) {
+	r := g.RequestFromCtx(ctx)
+	if !req.Drift {
+		return &ResponseValidationRes{Id: 1, Name: "john", Items: []ResponseValidationItem{{Name: "a"}}}, nil
+	}
+	r.Response.WriteJson(g.Map{
+		"code":    0,
+		"message": "OK",
+		"data": g.Map{
+			"id":    "1",
+			"items": g.Slice{g.Map{"name": 1}},
+			"age":   18,
+		},
+	})
+	return
+}
+
+func Test_Server_EnableResponseValidation(t *testing.T) {
+	var (
+		buf = &SafeBuffer{
+			buffer: bytes.NewBuffer(nil),
+			mu:     sync.Mutex{},
+		}
+		logger = glog.NewWithWriter(buf)
+	)
+	logger.SetStdoutPrint(false)
+	s := g.Server(guid.S())
+	s.SetLogger(logger)
+	s.EnableResponseValidation()
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareHandlerResponse)
+		group.Bind(responseValidationController{})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	client := g.Client().Prefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(
+			client.GetContent(ctx, "/user"),
+			`{"code":0,"message":"OK","data":{"id":1,"name":"john","items":[{"name":"a"}]}}`,
+		)
+		t.Assert(gstr.Contains(buf.String(), "response validation failed"), false)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		client.GetContent(ctx, "/user?drift=1")
+		content := buf.String()
+		t.Assert(gstr.Contains(content, "response validation failed: GET /user: "), true)
+		t.Assert(gstr.Contains(content, "data.id: expect integer but got string"), true)
+		t.Assert(gstr.Contains(content, "data.name: missing field"), true)
+		t.Assert(gstr.Contains(content, "data.items[0].name: expect string but got number"), true)
+		t.Assert(gstr.Contains(content, "data.age: extra field"), true)
+		t.Assert(gstr.Contains(content, "email"), false)
+	})
+}
@@ -298,3 +298,44 @@ func Test_Router_Priority(t *testing.T) {
 		t.Assert(client.GetContent(ctx, "/admin-goods-2"), "admin-goods-{page}")
 	})
 }
+
+func Test_Router_RegexConstraint(t *testing.T) {
+	s := g.Server(guid.S())
+	s.BindHandler("/user/{name}", func(r *ghttp.Request) {
+		r.Response.Write("name:", r.Get("name"))
+	})
+	s.BindHandler("/user/{id:\\d+}", func(r *ghttp.Request) {
+		r.Response.Write("id:", r.Get("id"))
+	})
+	s.BindHandler("/order/*any", func(r *ghttp.Request) {
+		r.Response.Write("any:", r.Get("any"))
+	})
+	s.BindHandler("/order/{no:\\d{4}}", func(r *ghttp.Request) {
+		r.Response.Write("no:", r.Get("no"))
+	})
+	s.BindHandler("/file/{name:[a-z]+}.{ext:(?:png|jpg)}", func(r *ghttp.Request) {
+		r.Response.Write(r.Get("name"), ".", r.Get("ext"))
+	})
+	s.BindHandler("/item/{id:[0-9]+}", func(r *ghttp.Request) {
+		r.Response.Write("item:", r.Get("id"))
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+
+	time.Sleep(100 * time.Millisecond)
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		t.Assert(client.GetContent(ctx, "/user/100"), "id:100")
+		t.Assert(client.GetContent(ctx, "/user/john"), "name:john")
+		t.Assert(client.GetContent(ctx, "/order/1234"), "no:1234")
+		t.Assert(client.GetContent(ctx, "/order/123"), "any:123")
+		t.Assert(client.GetContent(ctx, "/order/1234/5"), "any:1234/5")
+		t.Assert(client.GetContent(ctx, "/file/logo.png"), "logo.png")
+		t.Assert(client.GetContent(ctx, "/file/logo.gif"), "Not Found")
+		t.Assert(client.GetContent(ctx, "/item/1"), "item:1")
+		t.Assert(client.GetContent(ctx, "/item/abc"), "Not Found")
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gmeta"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Router_Deprecated(t *testing.T) {
+	type UserReq struct {
+		gmeta.Meta `method:"get"`
+		Id         int
+	}
+	type UserRes struct {
+		Id int
+	}
+	var (
+		s       = g.Server(guid.S())
+		sunset  = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+		handler = func(ctx context.Context, req *UserReq) (res *UserRes, err error) {
+			return &UserRes{Id: req.Id}, nil
+		}
+	)
+	s.SetOpenApiPath("/api.json")
+	s.Use(ghttp.MiddlewareHandlerResponse)
+	s.BindHandler("/v1/user", handler, ghttp.RouteDeprecated(sunset))
+	s.BindHandler("/v2/user", handler)
+	s.Group("/group", func(group *ghttp.RouterGroup) {
+		group.GET("/deprecated", func(r *ghttp.Request) {
+			r.Response.Write("deprecated")
+		}, ghttp.RouteDeprecated(time.Time{}))
+		group.GET("/exit", func(r *ghttp.Request) {
+			r.Response.WriteExit("exit")
+		}, ghttp.RouteDeprecated(sunset))
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		c := g.Client()
+		c.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		res, err := c.Get(ctx, "/v1/user?id=1")
+		t.AssertNil(err)
+		defer res.Close()
+		t.Assert(res.ReadAllString(), `{"code":0,"message":"OK","data":{"Id":1}}`)
+		t.Assert(res.Header.Get("Deprecation"), "true")
+		t.Assert(res.Header.Get("Sunset"), sunset.Format(http.TimeFormat))
+
+		res, err = c.Get(ctx, "/v2/user?id=1")
+		t.AssertNil(err)
+		defer res.Close()
+		t.Assert(res.Header.Get("Deprecation"), "")
+		t.Assert(res.Header.Get("Sunset"), "")
+
+		res, err = c.Get(ctx, "/group/deprecated")
+		t.AssertNil(err)
+		defer res.Close()
+		t.Assert(res.ReadAllString(), "deprecated")
+		t.Assert(res.Header.Get("Deprecation"), "true")
+		t.Assert(res.Header.Get("Sunset"), "")
+
+		res, err = c.Get(ctx, "/group/exit")
+		t.AssertNil(err)
+		defer res.Close()
+		t.Assert(res.ReadAllString(), "exit")
+		t.Assert(res.Header.Get("Deprecation"), "true")
+		t.Assert(res.Header.Get("Sunset"), sunset.Format(http.TimeFormat))
+	})
+
+	gtest.C(t, func(t *gtest.T) {
+		var paths = s.GetOpenApi().Paths
+		t.Assert(paths["/v1/user"].Get.Deprecated, true)
+		t.Assert(paths["/v2/user"].Get.Deprecated, false)
+	})
+}
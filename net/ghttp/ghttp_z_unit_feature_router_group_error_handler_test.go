@@ -0,0 +1,113 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+type groupErrorHandlerObject struct{}
+
+func (o *groupErrorHandlerObject) Show(r *ghttp.Request) {
+	panic("object error")
+}
+
+func Test_RouterGroup_SetErrorHandler(t *testing.T) {
+	var (
+		s         = g.Server(guid.S())
+		errorChan = make(chan error, 10)
+	)
+	s.BindHookHandler("/*", ghttp.HookAfterServe, func(r *ghttp.Request) {
+		if err := r.GetError(); err != nil {
+			errorChan <- err
+		}
+	})
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.GET("/default", func(r *ghttp.Request) {
+			panic("default error")
+		})
+	})
+	s.Group("/public", func(group *ghttp.RouterGroup) {
+		group.SetErrorHandler(func(r *ghttp.Request, err error) {
+			r.Response.WriteStatus(http.StatusServiceUnavailable, "public: internal error")
+		})
+		group.GET("/panic", func(r *ghttp.Request) {
+			panic("public error")
+		})
+		group.ALL("/object", new(groupErrorHandlerObject))
+		group.Group("/admin", func(group *ghttp.RouterGroup) {
+			group.SetErrorHandler(func(r *ghttp.Request, err error) {
+				r.Response.Writef("admin: %s", err.Error())
+			})
+			group.GET("/panic", func(r *ghttp.Request) {
+				panic("admin error")
+			})
+		})
+		group.Group("/inherit", func(group *ghttp.RouterGroup) {
+			group.GET("/panic", func(r *ghttp.Request) {
+				panic("inherit error")
+			})
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		c := g.Client()
+		c.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		// Default error handling.
+		res, err := c.Get(ctx, "/default")
+		t.AssertNil(err)
+		t.Assert(res.StatusCode, http.StatusInternalServerError)
+		t.Assert(res.ReadAllString(), "exception recovered: default error")
+		res.Close()
+		t.Assert((<-errorChan).Error(), "exception recovered: default error")
+
+		// Group error handler.
+		res, err = c.Get(ctx, "/public/panic")
+		t.AssertNil(err)
+		t.Assert(res.StatusCode, http.StatusServiceUnavailable)
+		t.Assert(res.ReadAllString(), "public: internal error")
+		res.Close()
+		t.Assert((<-errorChan).Error(), "exception recovered: public error")
+
+		// Object handler.
+		res, err = c.Get(ctx, "/public/object/show")
+		t.AssertNil(err)
+		t.Assert(res.StatusCode, http.StatusServiceUnavailable)
+		t.Assert(res.ReadAllString(), "public: internal error")
+		res.Close()
+		t.Assert((<-errorChan).Error(), "exception recovered: object error")
+
+		// The most specific group handler wins.
+		res, err = c.Get(ctx, "/public/admin/panic")
+		t.AssertNil(err)
+		t.Assert(res.StatusCode, http.StatusInternalServerError)
+		t.Assert(res.ReadAllString(), "admin: exception recovered: admin error")
+		res.Close()
+		t.Assert((<-errorChan).Error(), "exception recovered: admin error")
+
+		// Inherited from parent group.
+		res, err = c.Get(ctx, "/public/inherit/panic")
+		t.AssertNil(err)
+		t.Assert(res.StatusCode, http.StatusServiceUnavailable)
+		t.Assert(res.ReadAllString(), "public: internal error")
+		res.Close()
+		t.Assert((<-errorChan).Error(), "exception recovered: inherit error")
+	})
+}
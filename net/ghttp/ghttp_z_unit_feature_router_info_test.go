@@ -0,0 +1,50 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Router_Routes(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		s := g.Server(guid.S())
+		s.Group("/api", func(group *ghttp.RouterGroup) {
+			group.Middleware(ghttp.MiddlewareHandlerResponse)
+			group.POST("/user", func(r *ghttp.Request) {})
+			group.GET("/user/{id}", func(r *ghttp.Request) {})
+		})
+		s.BindHandler("/", func(r *ghttp.Request) {})
+
+		// It is called without starting the server.
+		routes := s.Routes()
+		t.Assert(len(routes), 3)
+
+		t.Assert(routes[0].Pattern, "/")
+		t.Assert(routes[0].Method, "ALL")
+		t.Assert(routes[0].MiddlewareCount, 0)
+
+		t.Assert(routes[1].Pattern, "/api/user")
+		t.Assert(routes[1].Method, "POST")
+		t.Assert(routes[1].Type, ghttp.HandlerTypeHandler)
+		t.Assert(routes[1].MiddlewareCount, 1)
+		t.Assert(gstr.Contains(routes[1].Source, ":"), true)
+		t.AssertNE(routes[1].HandlerName, "")
+
+		t.Assert(routes[2].Pattern, "/api/user/{id}")
+		t.Assert(routes[2].Method, "GET")
+
+		// Stable result for repeated calls.
+		t.Assert(s.Routes(), routes)
+	})
+}
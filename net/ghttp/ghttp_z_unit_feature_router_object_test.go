@@ -8,6 +8,7 @@ package ghttp_test
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
@@ -116,3 +117,70 @@ func Test_Router_ObjectMethod(t *testing.T) {
 		t.Assert(client.GetContent(ctx, "/none-exist"), "Not Found")
 	})
 }
+
+type ObjectRouteMeta struct{}
+
+func (o *ObjectRouteMeta) RouteMeta() map[string]ghttp.RouteMeta {
+	return map[string]ghttp.RouteMeta{
+		"Delete": {Middleware: []ghttp.HandlerFunc{
+			func(r *ghttp.Request) {
+				if r.GetHeader("Token") == "" {
+					r.Response.WriteStatus(http.StatusForbidden, "Forbidden")
+					return
+				}
+				r.Middleware.Next()
+			},
+		}},
+		"Show": {Middleware: []ghttp.HandlerFunc{
+			func(r *ghttp.Request) {
+				r.Response.Write("meta-")
+				r.Middleware.Next()
+			},
+		}},
+	}
+}
+
+func (o *ObjectRouteMeta) Show(r *ghttp.Request) {
+	r.Response.Write("show")
+}
+
+func (o *ObjectRouteMeta) Delete(r *ghttp.Request) {
+	r.Response.Write("delete")
+}
+
+func (o *ObjectRouteMeta) Info(r *ghttp.Request) {
+	r.Response.Write("info")
+}
+
+func Test_Router_Object_RouteMeta(t *testing.T) {
+	s := g.Server(guid.S())
+	s.Group("/group", func(group *ghttp.RouterGroup) {
+		group.Middleware(func(r *ghttp.Request) {
+			r.Response.Write("group-")
+			r.Middleware.Next()
+		})
+		group.ALL("/object", new(ObjectRouteMeta))
+	})
+	s.BindObject("/object", new(ObjectRouteMeta))
+	s.BindObjectMethod("/method/show", new(ObjectRouteMeta), "Show")
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+
+	time.Sleep(100 * time.Millisecond)
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		t.Assert(client.GetContent(ctx, "/object/show"), "meta-show")
+		t.Assert(client.GetContent(ctx, "/object/info"), "info")
+		t.Assert(client.GetContent(ctx, "/object/delete"), "Forbidden")
+		t.Assert(client.Header(g.MapStrStr{"Token": "1"}).GetContent(ctx, "/object/delete"), "delete")
+		t.Assert(client.GetContent(ctx, "/object/route-meta"), "Not Found")
+
+		t.Assert(client.GetContent(ctx, "/group/object/show"), "group-meta-show")
+		t.Assert(client.GetContent(ctx, "/group/object/info"), "group-info")
+
+		t.Assert(client.GetContent(ctx, "/method/show"), "meta-show")
+	})
+}
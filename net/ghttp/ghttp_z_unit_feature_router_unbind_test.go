@@ -0,0 +1,116 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/container/gtype"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Router_UnbindHandler(t *testing.T) {
+	var (
+		s             = g.Server(guid.S())
+		pluginHandler = func(r *ghttp.Request) {
+			r.Response.Write("plugin")
+		}
+	)
+	s.BindHandler("/user/*any", func(r *ghttp.Request) {
+		r.Response.Write("any")
+	})
+	s.BindHandler("/user/plugin", pluginHandler)
+	s.BindHandler("POST:/order", func(r *ghttp.Request) {
+		r.Response.Write("order")
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+
+	time.Sleep(100 * time.Millisecond)
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		t.Assert(client.GetContent(ctx, "/user/plugin"), "plugin")
+
+		t.Assert(s.UnbindHandler("", "/user/plugin"), true)
+		t.Assert(client.GetContent(ctx, "/user/plugin"), "any")
+		t.Assert(s.UnbindHandler("", "/user/plugin"), false)
+
+		s.BindHandler("/user/plugin", pluginHandler)
+		t.Assert(client.GetContent(ctx, "/user/plugin"), "plugin")
+
+		// Method specified.
+		t.Assert(s.UnbindHandler(http.MethodGet, "/order"), false)
+		t.Assert(client.PostContent(ctx, "/order"), "order")
+		t.Assert(s.UnbindHandler(http.MethodPost, "/order"), true)
+		t.Assert(client.PostContent(ctx, "/order"), "Not Found")
+	})
+}
+
+func Test_Router_UnbindHandler_ConcurrentTraffic(t *testing.T) {
+	var (
+		s             = g.Server(guid.S())
+		pluginHandler = func(r *ghttp.Request) {
+			r.Response.Write("plugin")
+		}
+	)
+	s.BindHandler("/*any", func(r *ghttp.Request) {
+		r.Response.Write("any")
+	})
+	s.BindHandler("/plugin/:name", pluginHandler)
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+
+	time.Sleep(100 * time.Millisecond)
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			wg           sync.WaitGroup
+			closed       = gtype.NewBool()
+			invalidCount = gtype.NewInt()
+			prefix       = fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort())
+		)
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				client := g.Client()
+				client.SetPrefix(prefix)
+				for !closed.Val() {
+					switch client.GetContent(ctx, "/plugin/john") {
+					case "plugin", "any":
+					default:
+						invalidCount.Add(1)
+					}
+				}
+			}()
+		}
+		for i := 0; i < 20; i++ {
+			t.Assert(s.UnbindHandler("", "/plugin/:name"), true)
+			time.Sleep(5 * time.Millisecond)
+			s.BindHandler("/plugin/:name", pluginHandler)
+			time.Sleep(5 * time.Millisecond)
+		}
+		closed.Set(true)
+		wg.Wait()
+		t.Assert(invalidCount.Val(), 0)
+
+		client := g.Client()
+		client.SetPrefix(prefix)
+		t.Assert(client.GetContent(ctx, "/plugin/john"), "plugin")
+		t.Assert(s.UnbindHandler("", "/plugin/:name"), true)
+		t.Assert(client.GetContent(ctx, "/plugin/john"), "any")
+	})
+}
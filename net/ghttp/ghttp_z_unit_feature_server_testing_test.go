@@ -0,0 +1,86 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_TestServer(t *testing.T) {
+	ts := ghttp.NewTestServer(func(s *ghttp.Server) {
+		s.BindStatusHandler(http.StatusNotFound, func(r *ghttp.Request) {
+			r.Response.ClearBuffer()
+			r.Response.Write("custom not found")
+		})
+		s.Group("/api", func(group *ghttp.RouterGroup) {
+			group.Middleware(func(r *ghttp.Request) {
+				if r.GetHeader("Token") != "123" {
+					r.Response.WriteStatus(http.StatusForbidden)
+					return
+				}
+				r.Response.Header().Set("X-Middleware", "api")
+				r.Middleware.Next()
+			})
+			group.Hook("/*", ghttp.HookBeforeServe, func(r *ghttp.Request) {
+				r.SetCtxVar("hook", "before")
+			})
+			group.POST("/user", func(r *ghttp.Request) {
+				r.Cookie.Set("session", "abc")
+				r.Response.WriteJson(g.Map{
+					"name": r.Get("name"),
+					"lang": r.Cookie.Get("lang"),
+					"hook": r.GetCtxVar("hook"),
+				})
+			})
+		})
+	})
+
+	gtest.C(t, func(t *gtest.T) {
+		client := ts.Client().SetHeader("Token", "123").SetCookie("lang", "en")
+		res := client.Post("/api/user", g.Map{"name": "john"})
+		t.Assert(res.StatusCode, http.StatusOK)
+		t.Assert(res.Header.Get("X-Middleware"), "api")
+		t.Assert(res.Header.Get("Content-Type"), "application/json")
+		t.Assert(res.GetCookie("session"), "abc")
+		t.Assert(res.ReadAllString(), `{"hook":"before","lang":"en","name":"john"}`)
+	})
+	// Middleware rejects.
+	gtest.C(t, func(t *gtest.T) {
+		res := ts.Client().Post("/api/user", "name=john")
+		t.Assert(res.StatusCode, http.StatusForbidden)
+		t.Assert(res.Header.Get("X-Middleware"), "")
+	})
+	// Status handler.
+	gtest.C(t, func(t *gtest.T) {
+		res := ts.Client().Get("/none")
+		t.Assert(res.StatusCode, http.StatusNotFound)
+		t.Assert(res.ReadAllString(), "custom not found")
+	})
+	// Constructed request.
+	gtest.C(t, func(t *gtest.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/user?name=smith", nil)
+		req.Header.Set("Token", "123")
+		res := ts.ServeHTTP(req)
+		t.Assert(res.StatusCode, http.StatusOK)
+		t.Assert(res.ReadAllString(), `{"hook":"before","lang":null,"name":"smith"}`)
+	})
+}
+
+func Test_TestServer_NoRoute(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		defer func() {
+			t.AssertNE(recover(), nil)
+		}()
+		ghttp.NewTestServer()
+	})
+}
@@ -0,0 +1,112 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Server_ShutdownReport(t *testing.T) {
+	var (
+		s           = g.Server(guid.S())
+		entered     = make(chan struct{})
+		release     = make(chan struct{})
+		reportChan  = make(chan *ghttp.ShutdownReport, 1)
+		responseErr = make(chan error, 1)
+	)
+	s.BindHandler("/user/{id}", func(r *ghttp.Request) {
+		close(entered)
+		<-release
+		r.Response.Write("done")
+	})
+	s.BindHandler("/ping", func(r *ghttp.Request) {
+		r.Response.Write("pong")
+	})
+	s.SetShutdownHandler(func(ctx context.Context, report *ghttp.ShutdownReport) {
+		reportChan <- report
+		close(release)
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(s.GetShutdownReport(), nil)
+
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		t.Assert(client.GetContent(ctx, "/ping"), "pong")
+		go func() {
+			_, err := client.Post(ctx, "/user/1")
+			responseErr <- err
+		}()
+		<-entered
+		time.Sleep(50 * time.Millisecond)
+
+		t.AssertNil(s.Shutdown())
+		t.AssertNil(<-responseErr)
+
+		report := <-reportChan
+		t.Assert(report, s.GetShutdownReport())
+		t.Assert(len(report.InFlightRequests), 1)
+		item := report.InFlightRequests[0]
+		t.Assert(item.Method, "POST")
+		t.Assert(item.Route, "/user/{id}")
+		t.Assert(item.Path, "/user/1")
+		t.Assert(item.ClientIp, "127.0.0.1")
+		t.AssertGE(item.Elapsed, 50*time.Millisecond)
+	})
+}
+
+func Test_Server_ShutdownReport_Disabled(t *testing.T) {
+	s := g.Server(guid.S())
+	s.BindHandler("/ping", func(r *ghttp.Request) {
+		r.Response.Write("pong")
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		t.Assert(client.GetContent(ctx, "/ping"), "pong")
+
+		t.AssertNil(s.Shutdown())
+		t.Assert(s.GetShutdownReport(), nil)
+	})
+}
+
+func Test_Server_ShutdownReport_Enabled(t *testing.T) {
+	s := g.Server(guid.S())
+	s.BindHandler("/ping", func(r *ghttp.Request) {
+		r.Response.Write("pong")
+	})
+	s.SetShutdownReport(true)
+	s.SetDumpRouterMap(false)
+	s.Start()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		t.Assert(client.GetContent(ctx, "/ping"), "pong")
+
+		t.AssertNil(s.Shutdown())
+		report := s.GetShutdownReport()
+		t.AssertNE(report, nil)
+		t.Assert(len(report.InFlightRequests), 0)
+	})
+}
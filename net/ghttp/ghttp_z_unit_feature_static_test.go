@@ -10,10 +10,14 @@ package ghttp_test
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/internal/json"
+	"github.com/gogf/gf/v2/net/ghttp"
 	"github.com/gogf/gf/v2/os/gfile"
 	"github.com/gogf/gf/v2/test/gtest"
 	"github.com/gogf/gf/v2/text/gstr"
@@ -115,6 +119,92 @@ func Test_Static_IndexFolder(t *testing.T) {
 	})
 }
 
+func Test_Static_DirectoryListing(t *testing.T) {
+	// JSON listing by Accept header.
+	gtest.C(t, func(t *gtest.T) {
+		s := g.Server(guid.S())
+		path := fmt.Sprintf(`%s/ghttp/static/test/%d`, gfile.Temp(), s.GetListenedPort())
+		defer gfile.Remove(path)
+		gfile.PutContents(path+"/test.html", "test")
+		gfile.Mkdir(path + "/sub")
+		s.SetIndexFolder(true)
+		s.SetServerRoot(path)
+		s.Start()
+		defer s.Shutdown()
+		time.Sleep(100 * time.Millisecond)
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		content := client.Header(g.MapStrStr{"Accept": "application/json"}).GetContent(ctx, "/")
+		var files []ghttp.FileInfo
+		t.AssertNil(json.Unmarshal([]byte(content), &files))
+		t.Assert(len(files), 2)
+		t.Assert(files[0].Name, "sub")
+		t.Assert(files[0].Path, "/sub/")
+		t.Assert(files[0].IsDir, true)
+		t.Assert(files[1].Name, "test.html")
+		t.Assert(files[1].Path, "/test.html")
+		t.Assert(files[1].Size, 4)
+
+		content = client.Header(g.MapStrStr{"Accept": "text/html,application/json;q=0.9"}).GetContent(ctx, "/")
+		t.AssertNE(gstr.Pos(content, `<a href="/test.html"`), -1)
+	})
+	// Custom renderer.
+	gtest.C(t, func(t *gtest.T) {
+		s := g.Server(guid.S())
+		path := fmt.Sprintf(`%s/ghttp/static/test/%d`, gfile.Temp(), s.GetListenedPort())
+		defer gfile.Remove(path)
+		gfile.PutContents(path+"/test.html", "test")
+		gfile.PutContents(path+"/error/test.html", "test")
+		s.SetIndexFolder(true)
+		s.SetServerRoot(path)
+		s.SetDirectoryListingRenderer(func(r *ghttp.Request, files []ghttp.FileInfo) error {
+			if gstr.HasPrefix(r.URL.Path, "/error") {
+				return gerror.New("render failed")
+			}
+			for _, file := range files {
+				r.Response.Writef("%s;", file.Path)
+			}
+			return nil
+		})
+		s.SetDumpRouterMap(false)
+		s.Start()
+		defer s.Shutdown()
+		time.Sleep(100 * time.Millisecond)
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		t.Assert(client.GetContent(ctx, "/"), "/error/;/test.html;")
+		resp, err := client.Get(ctx, "/error/")
+		t.AssertNil(err)
+		defer resp.Close()
+		t.Assert(resp.StatusCode, http.StatusInternalServerError)
+	})
+	// Disabled listing.
+	gtest.C(t, func(t *gtest.T) {
+		s := g.Server(guid.S())
+		path := fmt.Sprintf(`%s/ghttp/static/test/%d`, gfile.Temp(), s.GetListenedPort())
+		defer gfile.Remove(path)
+		gfile.PutContents(path+"/test.html", "test")
+		s.SetIndexFolder(true)
+		s.SetDirectoryListingDisabled(true)
+		s.SetServerRoot(path)
+		s.BindHandler("/serve", func(r *ghttp.Request) {
+			r.Response.ServeFile(path, true)
+		})
+		s.SetDumpRouterMap(false)
+		s.Start()
+		defer s.Shutdown()
+		time.Sleep(100 * time.Millisecond)
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		t.Assert(client.GetContent(ctx, "/"), "Forbidden")
+		t.Assert(client.GetContent(ctx, "/serve"), "Forbidden")
+		t.Assert(client.GetContent(ctx, "/test.html"), "test")
+	})
+}
+
 func Test_Static_IndexFiles1(t *testing.T) {
 	gtest.C(t, func(t *gtest.T) {
 		s := g.Server(guid.S())
@@ -272,3 +362,84 @@ func Test_Static_Rewrite(t *testing.T) {
 		t.Assert(client.GetContent(ctx, "/my-test2"), "test2")
 	})
 }
+
+func Test_Static_CacheControl(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		s := g.Server(guid.S())
+		path := fmt.Sprintf(`%s/ghttp/static/test/%s`, gfile.Temp(), guid.S())
+		defer gfile.Remove(path)
+		gfile.PutContents(path+"/index.html", "index")
+		gfile.PutContents(path+"/assets/app.3f2a1b.js", "js")
+		gfile.PutContents(path+"/assets/readme.txt", "txt")
+		s.SetServerRoot(path)
+		s.SetStaticCacheControl(map[string]string{
+			"*.*.js":      "public, max-age=31536000, immutable",
+			"/index.html": "no-cache",
+		})
+		s.SetDumpRouterMap(false)
+		s.Start()
+		defer s.Shutdown()
+		time.Sleep(100 * time.Millisecond)
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		res, err := client.Get(ctx, "/assets/app.3f2a1b.js")
+		t.AssertNil(err)
+		t.Assert(res.ReadAllString(), "js")
+		t.Assert(res.Header.Get("Cache-Control"), "public, max-age=31536000, immutable")
+		t.AssertNE(res.Header.Get("Expires"), "")
+		res.Close()
+
+		res, err = client.Get(ctx, "/index.html")
+		t.AssertNil(err)
+		t.Assert(res.Header.Get("Cache-Control"), "no-cache")
+		t.Assert(res.Header.Get("Expires"), "Thu, 01 Jan 1970 00:00:00 GMT")
+		res.Close()
+
+		res, err = client.Get(ctx, "/assets/readme.txt")
+		t.AssertNil(err)
+		t.Assert(res.Header.Get("Cache-Control"), "")
+		res.Close()
+	})
+}
+
+func Test_Static_Fallback(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		s := g.Server(guid.S())
+		path := fmt.Sprintf(`%s/ghttp/static/fallback/%s`, gfile.Temp(), guid.S())
+		defer gfile.Remove(path)
+		gfile.PutContents(path+"/index.html", "root")
+		gfile.PutContents(path+"/app/index.html", "app")
+		gfile.PutContents(path+"/app/main.js", "js")
+		s.SetServerRoot(path)
+		s.SetStaticFallback("/", "/index.html")
+		s.SetStaticFallback("/app/", "/app/index.html")
+		s.BindHandler("/api/user", func(r *ghttp.Request) {
+			r.Response.Write("user")
+		})
+		s.SetDumpRouterMap(false)
+		s.Start()
+		defer s.Shutdown()
+		time.Sleep(100 * time.Millisecond)
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		// Existing files and handlers.
+		t.Assert(client.GetContent(ctx, "/app/main.js"), "js")
+		t.Assert(client.GetContent(ctx, "/api/user"), "user")
+		// Missing paths under roots, the longest root is used.
+		t.Assert(client.GetContent(ctx, "/about"), "root")
+		t.Assert(client.GetContent(ctx, "/app/users/1"), "app")
+		t.Assert(client.GetContent(ctx, "/application"), "root")
+		// Missing assets are not fallback.
+		resp, err := client.Get(ctx, "/app/missing.js")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusNotFound)
+		resp.Close()
+		// Only GET/HEAD requests are fallback.
+		resp, err = client.Post(ctx, "/app/users/1")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusNotFound)
+		resp.Close()
+	})
+}
@@ -63,3 +63,44 @@ func Test_StatusHandler_Multi(t *testing.T) {
 		t.Assert(client.GetContent(ctx, "/502"), "12")
 	})
 }
+
+func Test_StatusHandler_ByPattern(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		s := g.Server(guid.S())
+		s.BindStatusHandlerByPattern("4xx", func(r *ghttp.Request) {
+			r.Response.WriteOver("4xx")
+		})
+		s.BindStatusHandlerByPattern("5XX", func(r *ghttp.Request) {
+			r.Response.WriteOver("5xx")
+		})
+		s.BindStatusHandler(404, func(r *ghttp.Request) {
+			r.Response.WriteOver("404")
+		})
+		s.BindHandler("/403", func(r *ghttp.Request) {
+			r.Response.WriteStatusExit(403)
+		})
+		s.BindHandler("/502", func(r *ghttp.Request) {
+			r.Response.WriteStatusExit(502)
+		})
+		s.BindHandler("/503", func(r *ghttp.Request) {
+			r.Response.WriteStatusExit(503)
+		})
+		s.BindHandler("/302", func(r *ghttp.Request) {
+			r.Response.WriteStatusExit(302, "302")
+		})
+		s.SetDumpRouterMap(false)
+		s.Start()
+		defer s.Shutdown()
+		time.Sleep(100 * time.Millisecond)
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		// The exact status code has priority over the status range.
+		t.Assert(client.GetContent(ctx, "/404"), "404")
+		t.Assert(client.GetContent(ctx, "/403"), "4xx")
+		t.Assert(client.GetContent(ctx, "/502"), "5xx")
+		t.Assert(client.GetContent(ctx, "/503"), "5xx")
+		// The default handling.
+		t.Assert(client.SetRedirectLimit(0).GetContent(ctx, "/302"), "302")
+	})
+}
@@ -9,7 +9,10 @@
 package ghttp_test
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"html/template"
 	"testing"
 	"time"
 
@@ -173,3 +176,68 @@ func Test_Template_XSS(t *testing.T) {
 		t.Assert(client.GetContent(ctx, "/"), ghtml.Entities(c))
 	})
 }
+
+// htmlTemplateEngine is the custom template engine using html/template for testing.
+type htmlTemplateEngine struct {
+	tpl *template.Template
+}
+
+func (e *htmlTemplateEngine) Render(ctx context.Context, name string, data map[string]any) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := e.tpl.ExecuteTemplate(&buffer, name, data); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func Test_Template_ViewEngine(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			engine = &htmlTemplateEngine{
+				tpl: template.Must(template.New("").Parse(
+					`{{define "index.html"}}Index:{{.name}}{{end}}` +
+						`{{define "user.html"}}User:{{.name}},{{.Query.id}}{{end}}`,
+				)),
+			}
+			s = g.Server(guid.S())
+		)
+		s.SetViewEngine(engine)
+		t.Assert(s.GetViewEngine(), engine)
+		s.BindHandler("/user", func(r *ghttp.Request) {
+			r.Response.WriteTpl("user.html", g.Map{
+				"name": "<john>",
+			})
+		})
+		s.BindHandler("/default", func(r *ghttp.Request) {
+			r.Response.WriteTplDefault(g.Map{
+				"name": "smith",
+			})
+		})
+		s.BindHandler("/content", func(r *ghttp.Request) {
+			r.Response.WriteTplContent(`Content:{{.name}}`, g.Map{
+				"name": "john",
+			})
+		})
+		s.BindHandler("/none", func(r *ghttp.Request) {
+			err := r.Response.WriteTpl("none.html")
+			t.AssertNE(err, nil)
+		})
+		s.BindHandler("/gview", func(r *ghttp.Request) {
+			r.SetView(gview.New(gtest.DataPath("template", "basic")))
+			r.Response.WriteTpl("index.html", g.Map{
+				"name": "john",
+			})
+		})
+		s.SetDumpRouterMap(false)
+		s.Start()
+		defer s.Shutdown()
+		time.Sleep(100 * time.Millisecond)
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		t.Assert(client.GetContent(ctx, "/user?id=1"), "User:&lt;john&gt;,1")
+		t.Assert(client.GetContent(ctx, "/default"), "Index:smith")
+		t.Assert(client.GetContent(ctx, "/content"), "Content:john")
+		t.Assert(client.GetContent(ctx, "/gview"), "Name:john")
+	})
+}
@@ -0,0 +1,113 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Middleware_BasicAuth(t *testing.T) {
+	s := g.Server(guid.S())
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareBasicAuth(ghttp.BasicAuthAccounts(map[string]string{
+			"john": "123456",
+		}), "Restricted"))
+		group.GET("/", func(r *ghttp.Request) {
+			r.Response.Write("user:", ghttp.AuthUserFromCtx(r.Context()))
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		prefix := fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort())
+
+		resp, err := g.Client().Get(ctx, prefix+"/")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusUnauthorized)
+		t.Assert(resp.Header.Get("WWW-Authenticate"), `Basic realm="Restricted"`)
+		resp.Close()
+
+		resp, err = g.Client().SetBasicAuth("john", "654321").Get(ctx, prefix+"/")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusUnauthorized)
+		resp.Close()
+
+		resp, err = g.Client().SetBasicAuth("smith", "123456").Get(ctx, prefix+"/")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusUnauthorized)
+		resp.Close()
+
+		resp, err = g.Client().SetBasicAuth("john", "123456").Get(ctx, prefix+"/")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusOK)
+		t.Assert(resp.ReadAllString(), "user:john")
+		resp.Close()
+	})
+}
+
+func Test_Middleware_BearerAuth(t *testing.T) {
+	s := g.Server(guid.S())
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareBearerAuth(func(token string) (any, bool) {
+			if token == "valid-token" {
+				return g.Map{"uid": 1}, true
+			}
+			return nil, false
+		}))
+		group.GET("/", func(r *ghttp.Request) {
+			r.Response.Write(ghttp.AuthClaimsFromCtx(r.Context()))
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		prefix := fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort())
+
+		resp, err := g.Client().Get(ctx, prefix+"/")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusUnauthorized)
+		t.Assert(resp.Header.Get("WWW-Authenticate"), `Bearer`)
+		resp.Close()
+
+		resp, err = g.Client().SetHeader("Authorization", "Bearer invalid-token").Get(ctx, prefix+"/")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusUnauthorized)
+		t.Assert(resp.Header.Get("WWW-Authenticate"), `Bearer error="invalid_token"`)
+		resp.Close()
+
+		resp, err = g.Client().SetHeader("Authorization", "Bearer valid-token").Get(ctx, prefix+"/")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusOK)
+		t.Assert(resp.ReadAllString(), `{"uid":1}`)
+		resp.Close()
+	})
+}
+
+func Test_BasicAuthAccounts(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		verify := ghttp.BasicAuthAccounts(map[string]string{"john": "123456", "empty": ""})
+		t.Assert(verify("john", "123456"), true)
+		t.Assert(verify("john", "12345"), false)
+		t.Assert(verify("smith", "123456"), false)
+		t.Assert(verify("empty", ""), true)
+		t.Assert(verify("unknown", ""), false)
+	})
+}
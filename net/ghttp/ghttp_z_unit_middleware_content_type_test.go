@@ -0,0 +1,84 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Middleware_RequireContentType(t *testing.T) {
+	s := g.Server(guid.S())
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareRequireContentType("application/json", "multipart/*"))
+		group.ALL("/", func(r *ghttp.Request) {
+			r.Response.Write("ok")
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		prefix := fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort())
+
+		resp, err := g.Client().ContentJson().Post(ctx, prefix+"/", `{"id":1}`)
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusOK)
+		t.Assert(resp.ReadAllString(), "ok")
+		resp.Close()
+
+		resp, err = g.Client().Header(g.MapStrStr{
+			"Content-Type": "Application/JSON; charset=utf-8",
+		}).Post(ctx, prefix+"/", `{"id":1}`)
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusOK)
+		resp.Close()
+
+		resp, err = g.Client().Header(g.MapStrStr{
+			"Content-Type": "multipart/form-data; boundary=xxx",
+		}).Post(ctx, prefix+"/", "--xxx--")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusOK)
+		resp.Close()
+
+		resp, err = g.Client().Header(g.MapStrStr{
+			"Content-Type": "text/plain",
+		}).Post(ctx, prefix+"/", `{"id":1}`)
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusUnsupportedMediaType)
+		t.Assert(resp.Header.Get("Accept-Post"), "application/json, multipart/*")
+		resp.Close()
+	})
+	// Empty body of GET/DELETE.
+	gtest.C(t, func(t *gtest.T) {
+		prefix := fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort())
+
+		resp, err := g.Client().Get(ctx, prefix+"/")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusOK)
+		resp.Close()
+
+		resp, err = g.Client().Delete(ctx, prefix+"/")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusOK)
+		resp.Close()
+
+		resp, err = g.Client().Post(ctx, prefix+"/")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusUnsupportedMediaType)
+		resp.Close()
+	})
+}
@@ -0,0 +1,176 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Middleware_Decompress(t *testing.T) {
+	s := g.Server(guid.S())
+	s.SetClientMaxBodySize(1024)
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareDecompress)
+		group.POST("/", func(r *ghttp.Request) {
+			var req struct {
+				Name string
+				Age  int
+			}
+			if err := r.Parse(&req); err != nil {
+				r.Response.Write(err.Error())
+				return
+			}
+			r.Response.Writef("%s:%d", req.Name, req.Age)
+		})
+		group.POST("/size", func(r *ghttp.Request) {
+			r.Response.Write(len(r.GetBody()))
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	var (
+		content     = []byte(`{"name":"john","age":18}`)
+		gzipContent = func(data []byte) []byte {
+			var buffer bytes.Buffer
+			writer := gzip.NewWriter(&buffer)
+			_, _ = writer.Write(data)
+			_ = writer.Close()
+			return buffer.Bytes()
+		}
+	)
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		client.SetHeader("Content-Type", "application/json")
+
+		// Uncompressed.
+		t.Assert(client.PostContent(ctx, "/", content), "john:18")
+
+		// Gzip.
+		t.Assert(client.Header(g.MapStrStr{
+			"Content-Encoding": "gzip",
+		}).PostContent(ctx, "/", gzipContent(content)), "john:18")
+
+		// Deflate in zlib format.
+		var buffer bytes.Buffer
+		writer := zlib.NewWriter(&buffer)
+		_, _ = writer.Write(content)
+		_ = writer.Close()
+		t.Assert(client.Header(g.MapStrStr{
+			"Content-Encoding": "deflate",
+		}).PostContent(ctx, "/", buffer.Bytes()), "john:18")
+
+		// Invalid compressed content.
+		resp, err := client.Header(g.MapStrStr{
+			"Content-Encoding": "gzip",
+		}).Post(ctx, "/", content)
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusBadRequest)
+		resp.Close()
+
+		// Unsupported encoding, brotli is deliberately unsupported.
+		resp, err = client.Header(g.MapStrStr{
+			"Content-Encoding": "br",
+		}).Post(ctx, "/", content)
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusUnsupportedMediaType)
+		t.Assert(resp.Header.Get("Accept-Encoding"), "gzip, deflate")
+		t.Assert(resp.ReadAllString(), "unsupported content encoding: br")
+		resp.Close()
+	})
+	// Decompressed size limit.
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		var (
+			small = gzipContent([]byte(strings.Repeat("a", 1000)))
+			large = gzipContent([]byte(strings.Repeat("a", 64*1024)))
+		)
+		t.Assert(client.Header(g.MapStrStr{
+			"Content-Encoding": "gzip",
+		}).PostContent(ctx, "/size", small), "1000")
+
+		// The compressed content is small enough, but the decompressed content is too large.
+		t.Assert(len(large) < 1024, true)
+		resp, err := client.Header(g.MapStrStr{
+			"Content-Encoding": "gzip",
+		}).Post(ctx, "/size", large)
+		t.AssertNil(err)
+		t.AssertNE(resp.StatusCode, http.StatusOK)
+		resp.Close()
+	})
+}
+
+func Test_Middleware_Decompress_MaxDecompressedBodySize(t *testing.T) {
+	s := g.Server(guid.S())
+	s.SetClientMaxBodySize(1024)
+	s.SetMaxDecompressedBodySize(100 * 1024)
+	s.SetErrorStatusMapping(true)
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareDecompress)
+		group.POST("/size", func(r *ghttp.Request) {
+			r.Response.Write(len(r.GetBody()))
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	var gzipContent = func(data []byte) []byte {
+		var buffer bytes.Buffer
+		writer := gzip.NewWriter(&buffer)
+		_, _ = writer.Write(data)
+		_ = writer.Close()
+		return buffer.Bytes()
+	}
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		client.SetHeader("Content-Encoding", "gzip")
+
+		// The decompressed content is larger than ClientMaxBodySize, but within MaxDecompressedBodySize.
+		large := gzipContent([]byte(strings.Repeat("a", 64*1024)))
+		t.Assert(len(large) < 1024, true)
+		t.Assert(client.PostContent(ctx, "/size", large), "65536")
+
+		// The decompressed content exceeds MaxDecompressedBodySize.
+		huge := gzipContent([]byte(strings.Repeat("a", 200*1024)))
+		t.Assert(len(huge) < 1024, true)
+		resp, err := client.Post(ctx, "/size", huge)
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusRequestEntityTooLarge)
+		t.Assert(strings.Contains(resp.ReadAllString(), "limit MaxDecompressedBodySize of 102400 bytes"), true)
+		resp.Close()
+
+		// The compressed content exceeds ClientMaxBodySize.
+		random := make([]byte, 4096)
+		rand.New(rand.NewSource(1)).Read(random)
+		resp, err = client.Post(ctx, "/size", gzipContent(random))
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusRequestEntityTooLarge)
+		t.Assert(strings.Contains(resp.ReadAllString(), "limit ClientMaxBodySize of 1024 bytes"), true)
+		resp.Close()
+	})
+}
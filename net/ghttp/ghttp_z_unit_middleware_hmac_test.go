@@ -0,0 +1,130 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/gclient"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Middleware_HMACVerify(t *testing.T) {
+	var (
+		secrets    = map[string][]byte{"partner1": []byte("secret1")}
+		secretFunc = func(r *ghttp.Request) ([]byte, error) {
+			if secret, ok := secrets[r.Header.Get("X-Key-Id")]; ok {
+				return secret, nil
+			}
+			return nil, errors.New("unknown partner")
+		}
+		s = g.Server(guid.S())
+	)
+	s.SetClientMaxBodySize(64)
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareHMACVerify(secretFunc, "X-Signature", ghttp.HMACAlgorithmSHA256))
+		group.POST("/", func(r *ghttp.Request) {
+			var req struct {
+				Name string
+			}
+			if err := r.Parse(&req); err != nil {
+				r.Response.Write(err.Error())
+				return
+			}
+			// The original body is still readable.
+			body, _ := io.ReadAll(r.Body)
+			r.Response.Writef("%s:%s", req.Name, body)
+		})
+	})
+	s.Group("/sha1", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareHMACVerify(secretFunc, "X-Signature", ghttp.HMACAlgorithmSHA1))
+		group.POST("/", func(r *ghttp.Request) {
+			r.Response.Write(r.GetBodyString())
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	var (
+		content = `{"name":"john"}`
+		sign    = func(data []byte) []byte {
+			mac := hmac.New(sha256.New, []byte("secret1"))
+			mac.Write(data)
+			return mac.Sum(nil)
+		}
+		statusOf = func(client *gclient.Client, url string, header map[string]string, body string) int {
+			resp, err := client.Header(header).Post(ctx, url, body)
+			if err != nil {
+				return 0
+			}
+			defer resp.Close()
+			return resp.StatusCode
+		}
+	)
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		client.SetHeader("Content-Type", "application/json")
+		client.SetHeader("X-Key-Id", "partner1")
+
+		// Hex signature.
+		t.Assert(client.Header(g.MapStrStr{
+			"X-Signature": hex.EncodeToString(sign([]byte(content))),
+		}).PostContent(ctx, "/", content), `john:`+content)
+
+		// Prefixed base64 signature.
+		t.Assert(client.Header(g.MapStrStr{
+			"X-Signature": "sha256=" + base64.StdEncoding.EncodeToString(sign([]byte(content))),
+		}).PostContent(ctx, "/", content), `john:`+content)
+
+		// Mismatched signature.
+		t.Assert(statusOf(client, "/", g.MapStrStr{
+			"X-Signature": hex.EncodeToString(sign([]byte(`{"name":"smith"}`))),
+		}, content), http.StatusUnauthorized)
+
+		// Missing signature.
+		t.Assert(statusOf(client, "/", nil, content), http.StatusUnauthorized)
+
+		// Unknown partner.
+		t.Assert(statusOf(client, "/", g.MapStrStr{
+			"X-Key-Id":    "partner2",
+			"X-Signature": hex.EncodeToString(sign([]byte(content))),
+		}, content), http.StatusUnauthorized)
+
+		// Body exceeds the size limit.
+		var large = strings.Repeat("a", 128)
+		t.Assert(statusOf(client, "/", g.MapStrStr{
+			"X-Signature": hex.EncodeToString(sign([]byte(large))),
+		}, large), http.StatusRequestEntityTooLarge)
+
+		// SHA1.
+		mac := hmac.New(sha1.New, []byte("secret1"))
+		mac.Write([]byte(content))
+		t.Assert(client.Header(g.MapStrStr{
+			"X-Signature": hex.EncodeToString(mac.Sum(nil)),
+		}).PostContent(ctx, "/sha1", content), content)
+		t.Assert(statusOf(client, "/sha1", g.MapStrStr{
+			"X-Signature": hex.EncodeToString(sign([]byte(content))),
+		}, content), http.StatusUnauthorized)
+	})
+}
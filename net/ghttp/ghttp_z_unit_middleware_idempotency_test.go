@@ -0,0 +1,110 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/container/gtype"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Middleware_Idempotency(t *testing.T) {
+	var counter = gtype.NewInt()
+	s := g.Server(guid.S())
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareIdempotency(nil, time.Minute))
+		group.POST("/pay", func(r *ghttp.Request) {
+			r.Response.Header().Set("X-Order", r.Get("order").String())
+			r.Response.WriteStatus(http.StatusCreated, fmt.Sprintf("paid %d", counter.Add(1)))
+		})
+		group.POST("/slow", func(r *ghttp.Request) {
+			time.Sleep(500 * time.Millisecond)
+			r.Response.Write(fmt.Sprintf("slow %d", counter.Add(1)))
+		})
+		group.POST("/fail", func(r *ghttp.Request) {
+			if counter.Add(1)%2 == 1 {
+				panic("failed")
+			}
+			r.Response.Write("ok")
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	prefix := fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort())
+	gtest.C(t, func(t *gtest.T) {
+		counter.Set(0)
+		client := g.Client().Prefix(prefix).Header(g.MapStrStr{ghttp.HeaderIdempotencyKey: guid.S()})
+		for i := 0; i < 3; i++ {
+			resp, err := client.Post(ctx, "/pay", "order=1")
+			t.AssertNil(err)
+			t.Assert(resp.StatusCode, http.StatusCreated)
+			t.Assert(resp.ReadAllString(), "paid 1")
+			t.Assert(resp.Header.Get("X-Order"), "1")
+			if i == 0 {
+				t.Assert(resp.Header.Get(ghttp.HeaderIdempotentReplayed), "")
+			} else {
+				t.Assert(resp.Header.Get(ghttp.HeaderIdempotentReplayed), "true")
+			}
+			resp.Close()
+		}
+		// Same key with different body.
+		resp, err := client.Post(ctx, "/pay", "order=2")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusUnprocessableEntity)
+		resp.Close()
+
+		// Requests without key are not deduplicated.
+		t.Assert(g.Client().Prefix(prefix).PostContent(ctx, "/pay", "order=1"), "paid 2")
+		t.Assert(g.Client().Prefix(prefix).PostContent(ctx, "/pay", "order=1"), "paid 3")
+	})
+	// Concurrent in-flight requests.
+	gtest.C(t, func(t *gtest.T) {
+		counter.Set(0)
+		var (
+			wg       sync.WaitGroup
+			statuses = make([]int, 2)
+			client   = g.Client().Prefix(prefix).Header(g.MapStrStr{ghttp.HeaderIdempotencyKey: guid.S()})
+		)
+		for i := 0; i < 2; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				time.Sleep(time.Duration(i) * 100 * time.Millisecond)
+				resp, err := client.Post(ctx, "/slow")
+				t.AssertNil(err)
+				defer resp.Close()
+				statuses[i] = resp.StatusCode
+			}(i)
+		}
+		wg.Wait()
+		t.Assert(statuses, []int{http.StatusOK, http.StatusConflict})
+		t.Assert(client.PostContent(ctx, "/slow"), "slow 1")
+	})
+	// Failed request is not stored.
+	gtest.C(t, func(t *gtest.T) {
+		counter.Set(0)
+		client := g.Client().Prefix(prefix).Header(g.MapStrStr{ghttp.HeaderIdempotencyKey: guid.S()})
+		resp, err := client.Post(ctx, "/fail")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusInternalServerError)
+		resp.Close()
+		t.Assert(client.PostContent(ctx, "/fail"), "ok")
+		t.Assert(client.PostContent(ctx, "/fail"), "ok")
+		t.Assert(counter.Val(), 2)
+	})
+}
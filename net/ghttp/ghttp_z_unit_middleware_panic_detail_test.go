@@ -0,0 +1,81 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/internal/json"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gmode"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Middleware_PanicDetail(t *testing.T) {
+	var mode = gmode.Mode()
+	defer gmode.Set(mode)
+
+	s := g.Server(guid.S())
+	s.Use(ghttp.MiddlewarePanicDetail, ghttp.MiddlewareHandlerResponse)
+	s.BindHandler("/panic/{id}", func(r *ghttp.Request) {
+		panic("something wrong")
+	})
+	s.BindHandler("/ok", func(r *ghttp.Request) {
+		r.Response.Write("ok")
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	prefix := fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort())
+	// Develop mode.
+	gtest.C(t, func(t *gtest.T) {
+		gmode.SetDevelop()
+		client := g.Client()
+		client.SetPrefix(prefix)
+		client.SetHeader("X-Test", "panic")
+
+		res, err := client.Get(ctx, "/panic/1?name=john")
+		t.AssertNil(err)
+		defer res.Close()
+		t.Assert(res.StatusCode, http.StatusInternalServerError)
+		t.Assert(strings.HasPrefix(res.Header.Get("Content-Type"), "application/json"), true)
+
+		var detail ghttp.PanicDetail
+		t.AssertNil(json.Unmarshal(res.ReadAll(), &detail))
+		t.Assert(detail.Message, "exception recovered: something wrong")
+		t.Assert(detail.Request.Method, http.MethodGet)
+		t.Assert(detail.Request.Url, "/panic/1?name=john")
+		t.Assert(detail.Request.Route, "/panic/{id}")
+		t.Assert(detail.Request.Header.Get("X-Test"), "panic")
+		t.AssertGT(len(detail.Stack), 0)
+		t.Assert(strings.Contains(strings.Join(detail.Stack, "\n"), "ghttp_z_unit_middleware_panic_detail_test.go"), true)
+
+		t.Assert(client.GetContent(ctx, "/ok"), "ok")
+	})
+	// Product mode.
+	gtest.C(t, func(t *gtest.T) {
+		gmode.SetProduct()
+		client := g.Client()
+		client.SetPrefix(prefix)
+
+		res, err := client.Get(ctx, "/panic/1")
+		t.AssertNil(err)
+		defer res.Close()
+		t.Assert(res.StatusCode, http.StatusInternalServerError)
+		content := res.ReadAllString()
+		t.Assert(strings.Contains(content, "stack"), false)
+		t.Assert(strings.Contains(content, ".go"), false)
+	})
+}
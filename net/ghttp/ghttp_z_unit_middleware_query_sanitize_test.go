@@ -0,0 +1,73 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Middleware_QuerySanitize(t *testing.T) {
+	s := g.Server(guid.S())
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareQuerySanitize([]string{"page", "size", "filter_*"}, 4))
+		group.ALL("/", func(r *ghttp.Request) {
+			r.Response.Writef("%s|%s|%s", r.URL.RawQuery, r.Get("page"), r.Get("token"))
+		})
+	})
+	s.Group("/all", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareQuerySanitize(nil, 0))
+		group.ALL("/", func(r *ghttp.Request) {
+			r.Response.Write(r.URL.RawQuery)
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		t.Assert(client.GetContent(ctx, "/"), "||")
+		t.Assert(
+			client.GetContent(ctx, "/?size=10&token=secret&page=2&filter_name=a%20b&filter_age=18"),
+			"filter_age=18&filter_name=a+b&page=2&size=10|2|",
+		)
+		// Values of the same name keep the order.
+		t.Assert(
+			client.GetContent(ctx, "/?size=10&filter_id=3&filter_id=1&utm_source=x"),
+			"filter_id=3&filter_id=1&size=10||",
+		)
+		// Too many parameters.
+		resp, err := client.Get(ctx, "/?page=1&size=10&filter_a=1&filter_b=2&filter_c=3")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusBadRequest)
+		resp.Close()
+
+		// The removed parameters are not counted.
+		t.Assert(
+			client.GetContent(ctx, "/?page=1&size=10&filter_a=1&filter_b=2&a=1&b=2"),
+			"filter_a=1&filter_b=2&page=1&size=10|1|",
+		)
+		// Invalid query string.
+		resp, err = client.Get(ctx, "/?page=%zz")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusBadRequest)
+		resp.Close()
+
+		t.Assert(client.GetContent(ctx, "/all?b=2&a=1&c=3"), "a=1&b=2&c=3")
+	})
+}
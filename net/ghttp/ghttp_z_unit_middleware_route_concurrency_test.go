@@ -0,0 +1,84 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Middleware_RouteConcurrency(t *testing.T) {
+	var (
+		entered = make(chan struct{}, 10)
+		release = make(chan struct{})
+	)
+	s := g.Server(guid.S())
+	s.Group("/report", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareRouteConcurrency(1))
+		group.GET("/export", func(r *ghttp.Request) {
+			entered <- struct{}{}
+			<-release
+			r.Response.Write("exported")
+		})
+		group.GET("/summary", func(r *ghttp.Request) {
+			r.Response.Write("summary")
+		})
+		group.GET("/panic", func(r *ghttp.Request) {
+			panic("export failed")
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			client = g.Client().Prefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+			result = make(chan string, 1)
+		)
+		go func() {
+			result <- client.GetContent(ctx, "/report/export")
+		}()
+		<-entered
+		t.Assert(s.GetRouteInFlight("GET:/report/export"), 1)
+
+		// The route in-flight is limited.
+		resp, err := client.Get(ctx, "/report/export")
+		t.AssertNil(err)
+		t.Assert(resp.StatusCode, http.StatusServiceUnavailable)
+		t.AssertNil(resp.Close())
+		t.Assert(s.GetRouteInFlight("GET:/report/export"), 1)
+
+		// The other routes of the group are limited separately.
+		t.Assert(client.GetContent(ctx, "/report/summary"), "summary")
+
+		close(release)
+		t.Assert(<-result, "exported")
+		t.Assert(s.GetRouteInFlight("GET:/report/export"), 0)
+		t.Assert(client.GetContent(ctx, "/report/export"), "exported")
+	})
+	// The slot is released if handler panics.
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client().Prefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		for i := 0; i < 2; i++ {
+			resp, err := client.Get(ctx, "/report/panic")
+			t.AssertNil(err)
+			t.Assert(resp.StatusCode, http.StatusInternalServerError)
+			t.AssertNil(resp.Close())
+		}
+		t.Assert(s.GetRouteInFlight("GET:/report/panic"), 0)
+		t.Assert(s.GetRouteInFlight("GET:/report/unknown"), 0)
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Middleware_TransformBody(t *testing.T) {
+	var contentTypes = make(chan string, 10)
+	s := g.Server(guid.S())
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareTransformBody(func(contentType string, body []byte) ([]byte, error) {
+			contentTypes <- contentType
+			switch {
+			case strings.HasPrefix(contentType, "text/html"):
+				return bytes.ReplaceAll(body, []byte("{nonce}"), []byte("abc123")), nil
+			case strings.HasPrefix(contentType, "application/json"):
+				return bytes.ReplaceAll(body, []byte(" "), nil), nil
+			case strings.HasPrefix(contentType, "text/plain"):
+				return nil, errors.New("transform failed")
+			}
+			return body, nil
+		}))
+		group.GET("/html", func(r *ghttp.Request) {
+			r.Response.Write(`<html><script nonce="{nonce}"></script></html>`)
+		})
+		group.GET("/json", func(r *ghttp.Request) {
+			r.Response.Header().Set("Content-Type", "application/json")
+			r.Response.Header().Set("Content-Length", "16")
+			r.Response.Write(`{"a": 1, "b": 2}`)
+		})
+		group.GET("/text", func(r *ghttp.Request) {
+			r.Response.Header().Set("Content-Type", "text/plain")
+			r.Response.Write("plain {nonce}")
+		})
+		group.GET("/stream", func(r *ghttp.Request) {
+			r.Response.Header().Set("Content-Type", "text/html")
+			r.Response.Write("<p>{nonce}</p>")
+			r.Response.Flush()
+			r.Response.Write("<p>{nonce}</p>")
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client().Prefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		// Content type sniffing.
+		t.Assert(client.GetContent(ctx, "/html"), `<html><script nonce="abc123"></script></html>`)
+		t.Assert(<-contentTypes, "text/html; charset=utf-8")
+
+		// Content length updating.
+		resp, err := client.Get(ctx, "/json")
+		t.AssertNil(err)
+		t.Assert(resp.ReadAllString(), `{"a":1,"b":2}`)
+		t.Assert(resp.ContentLength, 13)
+		t.AssertNil(resp.Close())
+		t.Assert(<-contentTypes, "application/json")
+
+		// The original body is kept if transforming fails.
+		t.Assert(client.GetContent(ctx, "/text"), "plain {nonce}")
+		t.Assert(<-contentTypes, "text/plain")
+
+		// The streamed response is not transformed.
+		t.Assert(client.GetContent(ctx, "/stream"), "<p>{nonce}</p><p>{nonce}</p>")
+		t.Assert(len(contentTypes), 0)
+	})
+}
@@ -72,6 +72,9 @@ type ServerConfig struct {
 	// Handler the handler for HTTP request.
 	Handler func(w http.ResponseWriter, r *http.Request) `json:"-"`
 
+	// ConnState specifies an optional callback function that is called when a client connection changes state.
+	ConnState func(conn net.Conn, state http.ConnState) `json:"-"`
+
 	// ReadTimeout is the maximum duration for reading the entire
 	// request, including the body.
 	//
@@ -110,6 +113,14 @@ type ServerConfig struct {
 
 	// Logger specifies the logger for server.
 	Logger *glog.Logger `json:"logger"`
+
+	// ProxyProtocol enables accepting PROXY protocol v1/v2 header for each connection,
+	// which uses the source address in header as the remote address of connection.
+	ProxyProtocol bool `json:"proxyProtocol"`
+
+	// ProxyProtocolTrustedUpstreams specifies the IP or CIDR of upstreams that are allowed to connect
+	// when ProxyProtocol is enabled. All upstreams are allowed if it is empty.
+	ProxyProtocolTrustedUpstreams []string `json:"proxyProtocolTrustedUpstreams"`
 }
 
 // New creates and returns a graceful http server with a given address.
@@ -162,6 +173,7 @@ func newHttpServer(
 		IdleTimeout:    config.IdleTimeout,
 		MaxHeaderBytes: config.MaxHeaderBytes,
 		ErrorLog:       log.New(loggerWriter, "", 0),
+		ConnState:      config.ConnState,
 	}
 	server.SetKeepAlivesEnabled(config.KeepAlive)
 	return server
@@ -185,11 +197,21 @@ func (s *Server) CreateListener() error {
 	if err != nil {
 		return err
 	}
-	s.listener = ln
+	if s.listener, err = s.wrapListener(ln); err != nil {
+		return err
+	}
 	s.setRawListener(ln)
 	return nil
 }
 
+// wrapListener wraps the raw listener `ln` with features like PROXY protocol if necessary.
+func (s *Server) wrapListener(ln net.Listener) (net.Listener, error) {
+	if !s.config.ProxyProtocol {
+		return ln, nil
+	}
+	return newProxyProtocolListener(ln, s.config.ProxyProtocolTrustedUpstreams)
+}
+
 // IsHttps returns whether the server is running in HTTPS mode.
 func (s *Server) IsHttps() bool {
 	return s.isHttps
@@ -240,8 +262,11 @@ func (s *Server) CreateListenerTLS(certFile, keyFile string, tlsConfig ...*tls.C
 	if err != nil {
 		return err
 	}
-
-	s.listener = tls.NewListener(ln, config)
+	wrappedLn, err := s.wrapListener(ln)
+	if err != nil {
+		return err
+	}
+	s.listener = tls.NewListener(wrappedLn, config)
 	s.setRawListener(ln)
 	return nil
 }
@@ -0,0 +1,293 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package graceful
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+const (
+	// proxyProtocolHeaderTimeout is the max duration for reading the PROXY protocol header.
+	proxyProtocolHeaderTimeout = 10 * time.Second
+	// proxyProtocolV1MaxLength is the max length of PROXY protocol v1 header, including "\r\n".
+	proxyProtocolV1MaxLength = 107
+	// proxyProtocolV2MaxLength is the max length of address and TLV part of PROXY protocol v2 header.
+	proxyProtocolV2MaxLength = 4096
+)
+
+var (
+	// proxyProtocolV1Prefix is the prefix of PROXY protocol v1 header.
+	proxyProtocolV1Prefix = []byte("PROXY ")
+	// proxyProtocolV2Signature is the signature of PROXY protocol v2 header.
+	proxyProtocolV2Signature = []byte("\r\n\r\n\x00\r\nQUIT\n")
+)
+
+// proxyProtocolListener wraps net.Listener, which parses the PROXY protocol header
+// of each accepted connection.
+type proxyProtocolListener struct {
+	net.Listener
+	trustedUpstreams []*net.IPNet // Trusted upstream networks, all upstreams are trusted if empty.
+}
+
+// proxyProtocolConn wraps net.Conn, which uses the source address in PROXY protocol header
+// as its remote address.
+type proxyProtocolConn struct {
+	net.Conn
+	reader     *bufio.Reader
+	once       sync.Once
+	remoteAddr net.Addr // Source address from PROXY protocol header, nil for original address.
+	err        error    // Error for malformed PROXY protocol header.
+}
+
+// newProxyProtocolListener creates and returns a listener accepting PROXY protocol v1/v2.
+// The parameter `trustedUpstreams` specifies the IP or CIDR of upstreams allowed to connect.
+func newProxyProtocolListener(ln net.Listener, trustedUpstreams []string) (net.Listener, error) {
+	var proxyLn = &proxyProtocolListener{
+		Listener: ln,
+	}
+	for _, upstream := range trustedUpstreams {
+		upstream = strings.TrimSpace(upstream)
+		if upstream == "" {
+			continue
+		}
+		if !strings.Contains(upstream, "/") {
+			ip := net.ParseIP(upstream)
+			if ip == nil {
+				return nil, gerror.NewCodef(gcode.CodeInvalidParameter, `invalid trusted upstream "%s"`, upstream)
+			}
+			if ip.To4() != nil {
+				upstream += "/32"
+			} else {
+				upstream += "/128"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(upstream)
+		if err != nil {
+			return nil, gerror.WrapCodef(gcode.CodeInvalidParameter, err, `invalid trusted upstream "%s"`, upstream)
+		}
+		proxyLn.trustedUpstreams = append(proxyLn.trustedUpstreams, ipNet)
+	}
+	return proxyLn, nil
+}
+
+// Accept waits for and returns the next connection from trusted upstream.
+// The connection from untrusted upstream is closed directly.
+func (ln *proxyProtocolListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := ln.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+		if !ln.isTrustedUpstream(conn.RemoteAddr()) {
+			_ = conn.Close()
+			continue
+		}
+		return &proxyProtocolConn{
+			Conn:   conn,
+			reader: bufio.NewReader(conn),
+		}, nil
+	}
+}
+
+// isTrustedUpstream checks whether `addr` is trusted upstream address.
+func (ln *proxyProtocolListener) isTrustedUpstream(addr net.Addr) bool {
+	if len(ln.trustedUpstreams) == 0 {
+		return true
+	}
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return false
+	}
+	for _, ipNet := range ln.trustedUpstreams {
+		if ipNet.Contains(tcpAddr.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+// Read reads data from the connection after the PROXY protocol header.
+func (c *proxyProtocolConn) Read(b []byte) (int, error) {
+	c.once.Do(c.readHeader)
+	if c.err != nil {
+		return 0, c.err
+	}
+	return c.reader.Read(b)
+}
+
+// RemoteAddr returns the source address in PROXY protocol header,
+// or else the original remote address.
+//
+// Note that it reads the header on its first call if the header is not read by Read yet, which blocks
+// until the header is received or proxyProtocolHeaderTimeout is reached. It cannot return the original
+// address before the header is read, as net/http takes the remote address of the connection before
+// reading the request. The blocking happens in the serving goroutine of the connection, which does
+// not block accepting the other connections.
+func (c *proxyProtocolConn) RemoteAddr() net.Addr {
+	c.once.Do(c.readHeader)
+	if c.remoteAddr != nil {
+		return c.remoteAddr
+	}
+	return c.Conn.RemoteAddr()
+}
+
+// readHeader reads and parses the PROXY protocol header.
+// The connection is closed if the header is malformed.
+func (c *proxyProtocolConn) readHeader() {
+	_ = c.Conn.SetReadDeadline(time.Now().Add(proxyProtocolHeaderTimeout))
+	defer func() {
+		_ = c.Conn.SetReadDeadline(time.Time{})
+	}()
+	if c.remoteAddr, c.err = parseProxyProtocolHeader(c.reader); c.err != nil {
+		_ = c.Conn.Close()
+	}
+}
+
+// parseProxyProtocolHeader parses PROXY protocol v1/v2 header from `reader`,
+// and returns the source address. The returned address is nil if the header
+// does not carry source address, like v1 "UNKNOWN" or v2 "LOCAL" command.
+func parseProxyProtocolHeader(reader *bufio.Reader) (net.Addr, error) {
+	prefix, err := reader.Peek(len(proxyProtocolV1Prefix))
+	if err != nil {
+		return nil, gerror.WrapCode(gcode.CodeInvalidRequest, err, `read PROXY protocol header failed`)
+	}
+	if bytes.Equal(prefix, proxyProtocolV1Prefix) {
+		return parseProxyProtocolV1(reader)
+	}
+	signature, err := reader.Peek(len(proxyProtocolV2Signature))
+	if err != nil || !bytes.Equal(signature, proxyProtocolV2Signature) {
+		return nil, gerror.NewCode(gcode.CodeInvalidRequest, `missing PROXY protocol header`)
+	}
+	return parseProxyProtocolV2(reader)
+}
+
+// parseProxyProtocolV1 parses the human-readable PROXY protocol v1 header, eg:
+// "PROXY TCP4 192.168.0.1 192.168.0.11 56324 443\r\n".
+func parseProxyProtocolV1(reader *bufio.Reader) (net.Addr, error) {
+	var line = make([]byte, 0, proxyProtocolV1MaxLength)
+	for {
+		b, err := reader.ReadByte()
+		if err != nil {
+			return nil, gerror.WrapCode(gcode.CodeInvalidRequest, err, `read PROXY protocol v1 header failed`)
+		}
+		line = append(line, b)
+		if b == '\n' {
+			break
+		}
+		if len(line) >= proxyProtocolV1MaxLength {
+			return nil, gerror.NewCode(gcode.CodeInvalidRequest, `PROXY protocol v1 header too long`)
+		}
+	}
+	if !bytes.HasSuffix(line, []byte("\r\n")) {
+		return nil, gerror.NewCode(gcode.CodeInvalidRequest, `invalid PROXY protocol v1 header ending`)
+	}
+	fields := strings.Split(string(line[:len(line)-2]), " ")
+	if len(fields) >= 2 && fields[1] == "UNKNOWN" {
+		return nil, nil
+	}
+	if len(fields) != 6 {
+		return nil, gerror.NewCodef(gcode.CodeInvalidRequest, `invalid PROXY protocol v1 header "%s"`, line)
+	}
+	var (
+		srcIP   = net.ParseIP(fields[2])
+		dstIP   = net.ParseIP(fields[3])
+		srcPort = parseProxyProtocolPort(fields[4])
+		dstPort = parseProxyProtocolPort(fields[5])
+	)
+	if srcIP == nil || dstIP == nil || srcPort < 0 || dstPort < 0 {
+		return nil, gerror.NewCodef(gcode.CodeInvalidRequest, `invalid PROXY protocol v1 header "%s"`, line)
+	}
+	switch fields[1] {
+	case "TCP4":
+		if srcIP.To4() == nil || dstIP.To4() == nil {
+			return nil, gerror.NewCodef(gcode.CodeInvalidRequest, `invalid PROXY protocol v1 header "%s"`, line)
+		}
+	case "TCP6":
+		if srcIP.To4() != nil || dstIP.To4() != nil {
+			return nil, gerror.NewCodef(gcode.CodeInvalidRequest, `invalid PROXY protocol v1 header "%s"`, line)
+		}
+	default:
+		return nil, gerror.NewCodef(gcode.CodeInvalidRequest, `unsupported PROXY protocol v1 protocol "%s"`, fields[1])
+	}
+	return &net.TCPAddr{IP: srcIP, Port: srcPort}, nil
+}
+
+// parseProxyProtocolV2 parses the binary PROXY protocol v2 header.
+func parseProxyProtocolV2(reader *bufio.Reader) (net.Addr, error) {
+	var header = make([]byte, 16)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, gerror.WrapCode(gcode.CodeInvalidRequest, err, `read PROXY protocol v2 header failed`)
+	}
+	var (
+		version = header[12] >> 4
+		command = header[12] & 0x0f
+		family  = header[13]
+		length  = int(binary.BigEndian.Uint16(header[14:16]))
+	)
+	if version != 2 {
+		return nil, gerror.NewCodef(gcode.CodeInvalidRequest, `invalid PROXY protocol v2 version "%d"`, version)
+	}
+	if length > proxyProtocolV2MaxLength {
+		return nil, gerror.NewCodef(gcode.CodeInvalidRequest, `PROXY protocol v2 header too long: %d`, length)
+	}
+	var payload = make([]byte, length)
+	if _, err := io.ReadFull(reader, payload); err != nil {
+		return nil, gerror.WrapCode(gcode.CodeInvalidRequest, err, `read PROXY protocol v2 addresses failed`)
+	}
+	switch command {
+	case 0x0:
+		// LOCAL command, the connection is established by the proxy itself.
+		return nil, nil
+	case 0x1:
+		// PROXY command.
+	default:
+		return nil, gerror.NewCodef(gcode.CodeInvalidRequest, `invalid PROXY protocol v2 command "%d"`, command)
+	}
+	switch family >> 4 {
+	case 0x1:
+		// AF_INET: 4 bytes source address, 4 bytes destination address, 2 bytes source port, 2 bytes destination port.
+		if length < 12 {
+			return nil, gerror.NewCode(gcode.CodeInvalidRequest, `invalid PROXY protocol v2 IPv4 addresses`)
+		}
+		return &net.TCPAddr{
+			IP:   net.IP(payload[0:4]),
+			Port: int(binary.BigEndian.Uint16(payload[8:10])),
+		}, nil
+	case 0x2:
+		// AF_INET6: 16 bytes source address, 16 bytes destination address, 2 bytes source port, 2 bytes destination port.
+		if length < 36 {
+			return nil, gerror.NewCode(gcode.CodeInvalidRequest, `invalid PROXY protocol v2 IPv6 addresses`)
+		}
+		return &net.TCPAddr{
+			IP:   net.IP(payload[0:16]),
+			Port: int(binary.BigEndian.Uint16(payload[32:34])),
+		}, nil
+	default:
+		// AF_UNSPEC or AF_UNIX, the source address is not used.
+		return nil, nil
+	}
+}
+
+// parseProxyProtocolPort parses and returns the port, or -1 if it is invalid.
+func parseProxyProtocolPort(s string) int {
+	port, err := strconv.Atoi(s)
+	if err != nil || port < 0 || port > 65535 || (len(s) > 1 && s[0] == '0') {
+		return -1
+	}
+	return port
+}
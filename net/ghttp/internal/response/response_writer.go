@@ -57,6 +57,11 @@ func (w *Writer) Hijack() (conn net.Conn, writer *bufio.ReadWriter, err error) {
 	return
 }
 
+// Unwrap returns the underlying http.ResponseWriter, which is used by http.ResponseController.
+func (w *Writer) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 // IsHeaderWrote returns if the header status is written.
 func (w *Writer) IsHeaderWrote() bool {
 	return w.wroteHeader
@@ -7,9 +7,12 @@
 package goai
 
 import (
+	"strings"
+
 	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/internal/json"
 	"github.com/gogf/gf/v2/util/gconv"
+	"github.com/gogf/gf/v2/util/gtag"
 )
 
 // Parameter is specified by OpenAPI/Swagger 3.0 standard.
@@ -36,6 +39,16 @@ func (oai *OpenApiV3) tagMapToParameter(tagMap map[string]string, parameter *Par
 	if err := gconv.Struct(mergedTagMap, parameter); err != nil {
 		return gerror.Wrap(err, `mapping struct tags to Parameter failed`)
 	}
+	// The explode can also be specified in style tag, eg: `style:"form,explode"`.
+	if array := strings.Split(parameter.Style, ","); len(array) > 1 {
+		parameter.Style = strings.TrimSpace(array[0])
+		for _, item := range array[1:] {
+			if item = strings.TrimSpace(item); item == gtag.Explode || strings.HasPrefix(item, gtag.Explode+"=") {
+				explode := item == gtag.Explode || gconv.Bool(strings.TrimPrefix(item, gtag.Explode+"="))
+				parameter.Explode = &explode
+			}
+		}
+	}
 	oai.tagMapToXExtensions(mergedTagMap, parameter.XExtensions)
 	return nil
 }
@@ -1377,3 +1377,38 @@ func Test_ValidationRules(t *testing.T) {
 		t.Assert(schema.Properties.Get("Address").Value.MaxLength, 64)
 	})
 }
+
+func Test_ParameterStyle(t *testing.T) {
+	type Req struct {
+		gmeta.Meta `method:"get"`
+		Ids        []int `json:"ids" in:"query" style:"form,explode"`
+		Codes      []int `json:"codes" in:"query" style:"form" explode:"false"`
+		Tags       []int `json:"tags" in:"query" style:"pipeDelimited"`
+	}
+	type Res struct{}
+
+	f := func(ctx context.Context, req *Req) (res *Res, err error) {
+		return
+	}
+
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			err  error
+			oai  = goai.New()
+			path = `/test`
+		)
+		err = oai.Add(goai.AddInput{
+			Path:   path,
+			Method: http.MethodGet,
+			Object: f,
+		})
+		t.AssertNil(err)
+		t.Assert(len(oai.Paths[path].Get.Parameters), 3)
+		t.Assert(oai.Paths[path].Get.Parameters[0].Value.Style, `form`)
+		t.Assert(*oai.Paths[path].Get.Parameters[0].Value.Explode, true)
+		t.Assert(oai.Paths[path].Get.Parameters[1].Value.Style, `form`)
+		t.Assert(*oai.Paths[path].Get.Parameters[1].Value.Explode, false)
+		t.Assert(oai.Paths[path].Get.Parameters[2].Value.Style, `pipeDelimited`)
+		t.Assert(oai.Paths[path].Get.Parameters[2].Value.Explode, nil)
+	})
+}
@@ -13,8 +13,9 @@ import (
 
 // Manager for sessions.
 type Manager struct {
-	ttl     time.Duration // TTL for sessions.
-	storage Storage       // Storage interface for session storage.
+	ttl        time.Duration // TTL for sessions.
+	storage    Storage       // Storage interface for session storage.
+	serializer Serializer    // Serializer for session data in storage.
 }
 
 // New creates and returns a new session manager.
@@ -49,6 +50,9 @@ func (m *Manager) New(ctx context.Context, sessionId ...string) *Session {
 // SetStorage sets the session storage for manager.
 func (m *Manager) SetStorage(storage Storage) {
 	m.storage = storage
+	if m.serializer != nil {
+		m.SetSerializer(m.serializer)
+	}
 }
 
 // GetStorage returns the session storage of current manager.
@@ -56,6 +60,27 @@ func (m *Manager) GetStorage() Storage {
 	return m.storage
 }
 
+// SetSerializer sets the serializer for marshaling and unmarshaling session data in storage,
+// which takes effect only if the storage supports custom serializer, like StorageFile and StorageRedis.
+//
+// The session data stored by other serializer keeps readable after the serializer changes,
+// as the format is detected from the stored data.
+func (m *Manager) SetSerializer(serializer Serializer) {
+	m.serializer = serializer
+	if setter, ok := m.storage.(serializerSetter); ok {
+		setter.SetSerializer(serializer)
+	}
+}
+
+// GetSerializer returns the serializer of current manager.
+// It returns SerializerJSON if no serializer is set.
+func (m *Manager) GetSerializer() Serializer {
+	if m.serializer == nil {
+		return SerializerJSON
+	}
+	return m.serializer
+}
+
 // SetTTL the TTL for the session manager.
 func (m *Manager) SetTTL(ttl time.Duration) {
 	m.ttl = ttl
@@ -0,0 +1,155 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gsession
+
+import (
+	"bytes"
+	"encoding/gob"
+	stdjson "encoding/json"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/json"
+)
+
+// Serializer is the interface for marshaling and unmarshaling session data in storage.
+type Serializer interface {
+	// Name returns the unique name of the serializer, which is stored with the session data
+	// for detecting the format of stored data.
+	Name() string
+
+	// Marshal marshals the session data to bytes.
+	Marshal(data map[string]any) ([]byte, error)
+
+	// Unmarshal unmarshals the bytes to session data.
+	Unmarshal(content []byte) (map[string]any, error)
+}
+
+// serializerSetter is the interface for storages supporting custom serializer.
+type serializerSetter interface {
+	SetSerializer(serializer Serializer)
+}
+
+var (
+	// SerializerJSON is the serializer using JSON format, which is the default serializer.
+	SerializerJSON Serializer = serializerJSON{}
+
+	// SerializerGob is the serializer using gob format, which keeps the Go types of values.
+	// Note that the custom types in session data should be registered using gob.Register.
+	SerializerGob Serializer = serializerGob{}
+
+	// builtinSerializers are the built-in serializers by their names,
+	// which are used for detecting the format of stored data.
+	builtinSerializers = map[string]Serializer{
+		SerializerJSON.Name(): SerializerJSON,
+		SerializerGob.Name():  SerializerGob,
+	}
+)
+
+// serializedHeaderMark is the first byte of the serialized data having format header, which is
+// in format: mark(1 byte) + name length(1 byte) + name. The data without header is in JSON format,
+// which keeps compatible with the data stored by former versions.
+const serializedHeaderMark byte = 0
+
+func init() {
+	// The nested maps and slices are common in session data.
+	gob.Register(map[string]any{})
+	gob.Register([]any{})
+	// The numbers of session data read from JSON storage are type of json.Number.
+	gob.Register(stdjson.Number(""))
+}
+
+type serializerJSON struct{}
+
+// Name returns the name of JSON serializer.
+func (serializerJSON) Name() string {
+	return "json"
+}
+
+// Marshal marshals the session data to JSON bytes.
+func (serializerJSON) Marshal(data map[string]any) ([]byte, error) {
+	return json.Marshal(data)
+}
+
+// Unmarshal unmarshals the JSON bytes to session data.
+func (serializerJSON) Unmarshal(content []byte) (map[string]any, error) {
+	var m map[string]any
+	if err := json.UnmarshalUseNumber(content, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+type serializerGob struct{}
+
+// Name returns the name of gob serializer.
+func (serializerGob) Name() string {
+	return "gob"
+}
+
+// Marshal marshals the session data to gob bytes.
+func (serializerGob) Marshal(data map[string]any) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(data); err != nil {
+		return nil, gerror.Wrap(err, `gob encode session data failed`)
+	}
+	return buffer.Bytes(), nil
+}
+
+// Unmarshal unmarshals the gob bytes to session data.
+func (serializerGob) Unmarshal(content []byte) (map[string]any, error) {
+	var m map[string]any
+	if err := gob.NewDecoder(bytes.NewReader(content)).Decode(&m); err != nil {
+		return nil, gerror.Wrap(err, `gob decode session data failed`)
+	}
+	return m, nil
+}
+
+// serializeSessionData marshals `data` using `serializer`, and prefixes the result with format header
+// if it's not the JSON serializer. It uses SerializerJSON if `serializer` is nil.
+func serializeSessionData(serializer Serializer, data map[string]any) ([]byte, error) {
+	if serializer == nil {
+		serializer = SerializerJSON
+	}
+	content, err := serializer.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	if serializer.Name() == SerializerJSON.Name() {
+		return content, nil
+	}
+	var name = serializer.Name()
+	if name == "" || len(name) > 255 {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, `invalid serializer name "%s"`, name)
+	}
+	var buffer = bytes.NewBuffer(make([]byte, 0, len(content)+len(name)+2))
+	buffer.WriteByte(serializedHeaderMark)
+	buffer.WriteByte(byte(len(name)))
+	buffer.WriteString(name)
+	buffer.Write(content)
+	return buffer.Bytes(), nil
+}
+
+// unserializeSessionData unmarshals `content` to session data. It detects the format of `content`
+// by its header, so that the stored data keeps readable after the serializer changes.
+func unserializeSessionData(serializer Serializer, content []byte) (map[string]any, error) {
+	if len(content) == 0 || content[0] != serializedHeaderMark {
+		return SerializerJSON.Unmarshal(content)
+	}
+	if len(content) < 2 || len(content) < int(content[1])+2 {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, `invalid serialized session data`)
+	}
+	var name = string(content[2 : content[1]+2])
+	content = content[content[1]+2:]
+	if serializer != nil && serializer.Name() == name {
+		return serializer.Unmarshal(content)
+	}
+	if v, ok := builtinSerializers[name]; ok {
+		return v.Unmarshal(content)
+	}
+	return nil, gerror.NewCodef(gcode.CodeNotSupported, `unsupported serializer "%s" for session data`, name)
+}
@@ -19,7 +19,6 @@ import (
 	"github.com/gogf/gf/v2/errors/gcode"
 	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/internal/intlog"
-	"github.com/gogf/gf/v2/internal/json"
 	"github.com/gogf/gf/v2/os/gfile"
 	"github.com/gogf/gf/v2/os/gtime"
 	"github.com/gogf/gf/v2/os/gtimer"
@@ -33,6 +32,7 @@ type StorageFile struct {
 	cryptoKey     []byte        // Used when enable crypto feature.
 	cryptoEnabled bool          // Used when enable crypto feature.
 	updatingIdSet *gset.StrSet  // To be batched updated session id set.
+	serializer    Serializer    // Serializer for session data, which is SerializerJSON in default.
 }
 
 const (
@@ -121,6 +121,11 @@ func (s *StorageFile) SetCryptoEnabled(enabled bool) {
 	s.cryptoEnabled = enabled
 }
 
+// SetSerializer sets the serializer for session data of the storage.
+func (s *StorageFile) SetSerializer(serializer Serializer) {
+	s.serializer = serializer
+}
+
 // sessionFilePath returns the storage file path for given session id.
 func (s *StorageFile) sessionFilePath(sessionId string) string {
 	return gfile.Join(s.path, sessionId) + ".session"
@@ -158,7 +163,7 @@ func (s *StorageFile) GetSession(ctx context.Context, sessionId string, ttl time
 			}
 		}
 		var m map[string]any
-		if err = json.UnmarshalUseNumber(content, &m); err != nil {
+		if m, err = unserializeSessionData(s.serializer, content); err != nil {
 			return nil, err
 		}
 		if m == nil {
@@ -175,7 +180,7 @@ func (s *StorageFile) GetSession(ctx context.Context, sessionId string, ttl time
 func (s *StorageFile) SetSession(ctx context.Context, sessionId string, sessionData *gmap.StrAnyMap, ttl time.Duration) error {
 	intlog.Printf(ctx, "StorageFile.SetSession: %s, %v, %v", sessionId, sessionData, ttl)
 	path := s.sessionFilePath(sessionId)
-	content, err := json.Marshal(sessionData)
+	content, err := serializeSessionData(s.serializer, sessionData.Map())
 	if err != nil {
 		return err
 	}
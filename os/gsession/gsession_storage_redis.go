@@ -13,7 +13,6 @@ import (
 	"github.com/gogf/gf/v2/container/gmap"
 	"github.com/gogf/gf/v2/database/gredis"
 	"github.com/gogf/gf/v2/internal/intlog"
-	"github.com/gogf/gf/v2/internal/json"
 	"github.com/gogf/gf/v2/os/gtimer"
 )
 
@@ -23,6 +22,7 @@ type StorageRedis struct {
 	redis         *gredis.Redis   // Redis client for session storage.
 	prefix        string          // Redis key prefix for session id.
 	updatingIdMap *gmap.StrIntMap // Updating TTL set for session id.
+	serializer    Serializer      // Serializer for session data, which is SerializerJSON in default.
 }
 
 const (
@@ -66,6 +66,11 @@ func NewStorageRedis(redis *gredis.Redis, prefix ...string) *StorageRedis {
 	return s
 }
 
+// SetSerializer sets the serializer for session data of the storage.
+func (s *StorageRedis) SetSerializer(serializer Serializer) {
+	s.serializer = serializer
+}
+
 // RemoveAll deletes all key-value pairs from storage.
 func (s *StorageRedis) RemoveAll(ctx context.Context, sessionId string) error {
 	_, err := s.redis.Del(ctx, s.sessionIdToRedisKey(sessionId))
@@ -89,8 +94,8 @@ func (s *StorageRedis) GetSession(ctx context.Context, sessionId string, ttl tim
 	if len(content) == 0 {
 		return nil, nil
 	}
-	var m map[string]any
-	if err = json.UnmarshalUseNumber(content, &m); err != nil {
+	m, err := unserializeSessionData(s.serializer, content)
+	if err != nil {
 		return nil, err
 	}
 	if m == nil {
@@ -104,7 +109,7 @@ func (s *StorageRedis) GetSession(ctx context.Context, sessionId string, ttl tim
 // This copy all session data map from memory to storage.
 func (s *StorageRedis) SetSession(ctx context.Context, sessionId string, sessionData *gmap.StrAnyMap, ttl time.Duration) error {
 	intlog.Printf(ctx, "StorageRedis.SetSession: %s, %v, %v", sessionId, sessionData, ttl)
-	content, err := json.Marshal(sessionData)
+	content, err := serializeSessionData(s.serializer, sessionData.Map())
 	if err != nil {
 		return err
 	}
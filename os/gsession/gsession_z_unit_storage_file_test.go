@@ -80,3 +80,48 @@ func Test_StorageFile(t *testing.T) {
 		t.Assert(s.MustGet("k6"), nil)
 	})
 }
+
+func Test_StorageFile_Serializer(t *testing.T) {
+	storage := gsession.NewStorageFile("", time.Minute)
+	manager := gsession.New(time.Minute, storage)
+	sessionId := ""
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(manager.GetSerializer().Name(), gsession.SerializerJSON.Name())
+		s := manager.New(context.TODO())
+		s.MustSet("k1", "v1")
+		s.MustSet("k2", 100)
+		sessionId = s.MustId()
+		t.AssertNil(s.Close())
+	})
+	defer storage.RemoveAll(context.TODO(), sessionId)
+
+	// The data stored in JSON is still readable after the serializer changes.
+	manager.SetSerializer(gsession.SerializerGob)
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(manager.GetSerializer().Name(), gsession.SerializerGob.Name())
+		s := manager.New(context.TODO(), sessionId)
+		t.Assert(s.MustGet("k1"), "v1")
+		t.Assert(s.MustGet("k2"), 100)
+		s.MustSet("k3", g.Map{"n": 1})
+		t.AssertNil(s.Close())
+	})
+	gtest.C(t, func(t *gtest.T) {
+		s := manager.New(context.TODO(), sessionId)
+		defer s.Close()
+		t.Assert(s.MustGet("k1"), "v1")
+		t.Assert(s.MustGet("k3").Map(), g.Map{"n": 1})
+		// The gob serializer keeps the Go types of values.
+		_, ok := s.MustGet("k3").Val().(map[string]any)["n"].(int)
+		t.Assert(ok, true)
+	})
+
+	// The data stored in gob is still readable after switching back to JSON.
+	manager.SetSerializer(gsession.SerializerJSON)
+	gtest.C(t, func(t *gtest.T) {
+		s := manager.New(context.TODO(), sessionId)
+		defer s.Close()
+		t.Assert(s.MustGet("k1"), "v1")
+		t.Assert(s.MustGet("k2"), 100)
+		t.Assert(s.MustGet("k3").Map(), g.Map{"n": 1})
+	})
+}
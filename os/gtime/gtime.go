@@ -207,8 +207,37 @@ func StrToTime(str string, format ...string) (*Time, error) {
 	if len(format) > 0 {
 		return StrToTimeFormat(str, format[0])
 	}
+	return doStrToTime(str, nil)
+}
+
+// StrToTimeInLocation converts string to *Time object like StrToTime, but it interprets the
+// datetime string without zone information in the given location `loc`, instead of the local
+// time zone. The zone information in `str` has higher priority than `loc`, eg:
+// "2024-03-10 01:30:00" is in `loc`, but "2024-03-10T01:30:00+08:00" is in zone "+08:00".
+// The timestamp string is always absolute, which is converted to location `loc`.
+//
+// If `format` is given, it parses `str` using `format` in location `loc`.
+func StrToTimeInLocation(str string, loc *time.Location, format ...string) (*Time, error) {
+	if loc == nil {
+		return StrToTime(str, format...)
+	}
+	if str == "" {
+		return &Time{wrapper{time.Time{}}}, nil
+	}
+	if len(format) > 0 {
+		return StrToTimeLayoutInLocation(str, formatToStdLayout(format[0]), loc)
+	}
+	return doStrToTime(str, loc)
+}
+
+// doStrToTime converts "standard" datetime string `str` to *Time object in location `loc`.
+// It uses the local time zone if `loc` is nil.
+func doStrToTime(str string, loc *time.Location) (*Time, error) {
 	if isTimestampStr(str) {
 		timestamp, _ := strconv.ParseInt(str, 10, 64)
+		if loc != nil {
+			return NewFromTimeStamp(timestamp).ToLocation(loc), nil
+		}
 		return NewFromTimeStamp(timestamp), nil
 	}
 	var (
@@ -217,6 +246,9 @@ func StrToTime(str string, format ...string) (*Time, error) {
 		match                []string
 		local                = time.Local
 	)
+	if loc != nil {
+		local = loc
+	}
 	if match = timeRegex1.FindStringSubmatch(str); len(match) > 0 && match[1] != "" {
 		year, month, day = parseDateStr(match[1])
 	} else if match = timeRegex2.FindStringSubmatch(str); len(match) > 0 && match[1] != "" {
@@ -288,7 +320,8 @@ func StrToTime(str string, format ...string) (*Time, error) {
 				zoneOffset = -zoneOffset
 			}
 			// Comparing in seconds.
-			if localOffset != zoneOffset {
+			// It always uses the given zone if the location is specified.
+			if loc != nil || localOffset != zoneOffset {
 				local = time.FixedZone("", zoneOffset)
 			}
 		}
@@ -343,6 +376,21 @@ func StrToTimeLayout(str string, layout string) (*Time, error) {
 	}
 }
 
+// StrToTimeLayoutInLocation parses string `str` to *Time object with given format `layout` in
+// location `loc`. The parameter `layout` is in stdlib format like "2006-01-02 15:04:05".
+// Note that the zone information in `str` has higher priority than `loc` if `layout` contains zone.
+func StrToTimeLayoutInLocation(str string, layout string, loc *time.Location) (*Time, error) {
+	if t, err := time.ParseInLocation(layout, str, loc); err == nil {
+		return NewFromTime(t), nil
+	} else {
+		return nil, gerror.WrapCodef(
+			gcode.CodeInvalidParameter, err,
+			`time.ParseInLocation failed for layout "%s" and value "%s"`,
+			layout, str,
+		)
+	}
+}
+
 // ParseTimeFromContent retrieves time information for content string, it then parses and returns it
 // as *Time object.
 // It returns the first time information if there are more than one time string in the content.
@@ -231,6 +231,37 @@ func Test_StrToTime(t *testing.T) {
 	})
 }
 
+func Test_StrToTimeInLocation(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		t.AssertNil(err)
+
+		// Daylight saving time begins at 2024-03-10 02:00:00.
+		t1, err := gtime.StrToTimeInLocation("2024-03-10 01:30:00", loc)
+		t.AssertNil(err)
+		t.Assert(t1.UTC().String(), "2024-03-10 06:30:00")
+		t2, err := gtime.StrToTimeInLocation("2024-03-10 03:30:00", loc)
+		t.AssertNil(err)
+		t.Assert(t2.UTC().String(), "2024-03-10 07:30:00")
+
+		// The zone in string has higher priority.
+		t3, err := gtime.StrToTimeInLocation("2024-03-10T01:30:00+08:00", loc)
+		t.AssertNil(err)
+		t.Assert(t3.UTC().String(), "2024-03-09 17:30:00")
+
+		// Timestamp is absolute.
+		t4, err := gtime.StrToTimeInLocation("1710052200", loc)
+		t.AssertNil(err)
+		t.Assert(t4.Timestamp(), 1710052200)
+		t.Assert(t4.Location().String(), "America/New_York")
+
+		// Format.
+		t5, err := gtime.StrToTimeInLocation("10/03/2024 01:30", loc, "d/m/Y H:i")
+		t.AssertNil(err)
+		t.Assert(t5.UTC().String(), "2024-03-10 06:30:00")
+	})
+}
+
 func Test_ConvertZone(t *testing.T) {
 	gtest.C(t, func(t *gtest.T) {
 		// 现行时间
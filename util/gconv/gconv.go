@@ -7,6 +7,17 @@
 // Package gconv implements powerful and convenient converting functionality for any types of variables.
 //
 // This package should keep much fewer dependencies with other packages.
+//
+// # Tag Options
+//
+// The converting options of struct attributes are specified in tags gconv/c after the attribute name,
+// which are separated by char ',', eg: `gconv:"age,default:18"`. The options having value are in format
+// `key:value`, which are default, index, nested, converter, pattern and maxlen. The flag options without
+// value are required, wrapper, remaining and flags.
+//
+// Note that the first item of the tag value is always the attribute name, so the flag option without
+// name needs a leading char ',', eg: `gconv:",required"`. The tag `gconv:"required"` names the attribute
+// "required" instead of marking it as required.
 package gconv
 
 import (
@@ -36,6 +47,9 @@ type Converter interface {
 // ConverterForBasic is the basic converting interface.
 type ConverterForBasic interface {
 	Scan(srcValue, dstPointer any, option ...ScanOption) (err error)
+	ScanJson(data []byte, dstPointer any, option ...ScanOption) (err error)
+	ScanJsonPresence(data []byte, dstPointer any, option ...ScanOption) (present map[string]bool, err error)
+	ScanQuery(query string, dstPointer any, option ...ScanOption) (err error)
 	String(anyInput any) (string, error)
 	Bool(anyInput any) (bool, error)
 	Rune(anyInput any) (rune, error)
@@ -68,14 +82,22 @@ type ConverterForUint interface {
 
 // ConverterForFloat is the converting interface for float.
 type ConverterForFloat interface {
-	Float32(v any) (float32, error)
-	Float64(v any) (float64, error)
+	Float32(v any, option ...FloatOption) (float32, error)
+	Float64(v any, option ...FloatOption) (float64, error)
 }
 
 // ConverterForMap is the converting interface for map.
 type ConverterForMap interface {
 	Map(v any, option ...MapOption) (map[string]any, error)
 	MapStrStr(v any, option ...MapOption) (map[string]string, error)
+	MapWithKeyStyle(v any, style MapKeyStyle, option ...MapOption) (map[string]any, error)
+	MapWithMethods(v any, methods []string, option ...MapOption) (map[string]any, error)
+	Pairs(v any, sorted bool, option ...PairsOption) ([]Pair, error)
+	SortedPairs(v any) ([]Pair, error)
+	PairsToMap(pairs []Pair) map[string]any
+	FlatMap(v any, option ...MapOption) (map[string]any, error)
+	QueryString(v any, option ...QueryOption) (string, error)
+	JsonBytes(v any, option ...MapOption) ([]byte, error)
 }
 
 // ConverterForSlice is the converting interface for slice.
@@ -93,12 +115,19 @@ type ConverterForSlice interface {
 	SliceUint64(v any, option ...SliceOption) ([]uint64, error)
 	SliceStr(v any, option ...SliceOption) ([]string, error)
 	SliceMap(v any, option ...SliceMapOption) ([]map[string]any, error)
+	SliceUnique(srcValue any, dstPointer any) error
+	ToSet(srcValue any, dstPointer any) error
 }
 
 // ConverterForStruct is the converting interface for struct.
 type ConverterForStruct interface {
 	Struct(params, pointer any, option ...StructOption) (err error)
 	Structs(params, pointer any, option ...StructsOption) (err error)
+	StructsParallel(params, pointer any, workers int, option ...StructsOption) (err error)
+	Fields(structType any) ([]FieldInfo, error)
+	CSVHeader(structType any) ([]string, error)
+	ToCSVRow(value any, fields []string, option ...CSVOption) ([]string, error)
+	FromCSVRow(row []string, dstPointer any, fields []string, option ...CSVOption) error
 }
 
 // ConverterForConvert is the converting interface for custom converting.
@@ -111,6 +140,16 @@ type ConverterForConvert interface {
 type ConverterForRegister interface {
 	RegisterTypeConverterFunc(f any) error
 	RegisterAnyConverterFunc(f AnyConvertFunc, types ...reflect.Type)
+	UnregisterConverter(fromType, toType reflect.Type) bool
+	ListConverters() []ConverterInfo
+	RegisterDefaultProvider(fn any) error
+	UnregisterDefaultProvider(t reflect.Type) bool
+	RegisterFlagsMapping(t reflect.Type, mapping map[string]uint64) error
+	UnregisterFlagsMapping(t reflect.Type) bool
+	RegisterNamedConverter(name string, fn any) error
+	UnregisterNamedConverter(name string) bool
+	RegisterProtoTimestamp(t reflect.Type) error
+	UnregisterProtoTimestamp(t reflect.Type) bool
 }
 
 type (
@@ -120,15 +159,62 @@ type (
 	// MapOption specifies the option for map converting.
 	MapOption = converter.MapOption
 
+	// MapKeyStyle is the case style for map keys that are derived from struct attribute names.
+	MapKeyStyle = converter.MapKeyStyle
+
+	// Pair is a key-value pair converted from struct attribute.
+	Pair = converter.Pair
+
+	// PairsOption specifies the option for Pairs converting.
+	PairsOption = converter.PairsOption
+
+	// FieldInfo is the descriptor of struct attribute, which is retrieved by Fields.
+	FieldInfo = converter.FieldInfo
+
+	// CSVOption is the option for converting between struct and CSV row.
+	CSVOption = converter.CSVOption
+
 	// SliceOption is the option for Slice type converting.
 	SliceOption = converter.SliceOption
 
 	// SliceMapOption is the option for SliceMap function.
 	SliceMapOption = converter.SliceMapOption
 
+	// FieldConvertError is the error for converting value to struct attribute, which carries
+	// the full attribute path and the source value type.
+	FieldConvertError = converter.FieldConvertError
+
+	// ElementConvertError is the error for converting elements of slice, which carries
+	// the errors of all the failed elements with their indexes.
+	ElementConvertError = converter.ElementConvertError
+
+	// ConverterInfo is the information of registered custom converter.
+	ConverterInfo = converter.ConverterInfo
+
 	// ScanOption is the option for the Scan function.
 	ScanOption = converter.ScanOption
 
+	// ScanEnvOption is the option for the ScanEnv function.
+	ScanEnvOption = converter.ScanEnvOption
+
+	// ToEnvOption is the option for the ToEnv function.
+	ToEnvOption = converter.ToEnvOption
+
+	// EnvSliceStyle is the style for converting slice attribute to environment variables.
+	EnvSliceStyle = converter.EnvSliceStyle
+
+	// QueryOption is the option for the QueryString function.
+	QueryOption = converter.QueryOption
+
+	// QuerySliceStyle is the style for converting slice of scalar values to query string.
+	QuerySliceStyle = converter.QuerySliceStyle
+
+	// ScanMergeOption is the option for the ScanMergeWithOptions function.
+	ScanMergeOption = converter.ScanMergeOption
+
+	// ScanWarning is the non-fatal warning of lossy type coercion reported by ScanWithWarnings.
+	ScanWarning = converter.ScanWarning
+
 	// StructOption is the option for Struct converting.
 	StructOption = converter.StructOption
 
@@ -137,15 +223,64 @@ type (
 
 	// ConvertOption is the option for converting.
 	ConvertOption = converter.ConvertOption
+
+	// FloatOption is the option for float converting.
+	FloatOption = converter.FloatOption
+
+	// FlagsOption is the option for NamesToFlags function.
+	FlagsOption = converter.FlagsOption
+
+	// DurationStyle is the format style of human-readable duration string.
+	DurationStyle = converter.DurationStyle
+)
+
+const (
+	MapKeyStyleDefault = converter.MapKeyStyleDefault // Uses the attribute name as it is.
+	MapKeyStyleSnake   = converter.MapKeyStyleSnake   // Eg: UserName -> user_name.
+	MapKeyStyleCamel   = converter.MapKeyStyleCamel   // Eg: UserName -> userName.
+	MapKeyStyleKebab   = converter.MapKeyStyleKebab   // Eg: UserName -> user-name.
+	MapKeyStylePascal  = converter.MapKeyStylePascal  // Eg: userName -> UserName.
+)
+
+const (
+	DurationStyleDefault = converter.DurationStyleDefault // Go format, eg: 26h30m0s.
+	DurationStyleShort   = converter.DurationStyleShort   // Eg: 1d 2h 30m.
+	DurationStyleLong    = converter.DurationStyleLong    // Eg: 1 day 2 hours 30 minutes.
+)
+
+const (
+	EnvSliceStyleJoin  = converter.EnvSliceStyleJoin  // Joins the elements to one variable, eg: APP_HOSTS=a,b.
+	EnvSliceStyleIndex = converter.EnvSliceStyleIndex // One variable for each element, eg: APP_HOSTS_0=a.
+)
+
+const (
+	QuerySliceStyleRepeat  = converter.QuerySliceStyleRepeat  // Repeats the key for each element, eg: tags=a&tags=b.
+	QuerySliceStyleBracket = converter.QuerySliceStyleBracket // Repeats the key with brackets, eg: tags[]=a&tags[]=b.
+	QuerySliceStyleIndex   = converter.QuerySliceStyleIndex   // Suffixes the key with index, eg: tags[0]=a&tags[1]=b.
+	QuerySliceStyleComma   = converter.QuerySliceStyleComma   // Joins the elements to one value, eg: tags=a,b.
 )
 
 // IUnmarshalValue is the interface for custom defined types customizing value assignment.
 // Note that only pointer can implement interface IUnmarshalValue.
 type IUnmarshalValue = localinterface.IUnmarshalValue
 
+// ISetField is the interface for struct customizing attribute assignment in converting.
+// The SetField is called with each key of the source before the reflection assignment, and the
+// key is not assigned by reflection if it is handled by SetField.
+// Note that only pointer can implement interface ISetField.
+type ISetField = localinterface.ISetField
+
 var (
 	// defaultConverter is the default management object converting.
 	defaultConverter = converter.NewConverter()
+
+	// ErrSpecialFloat is the error that indicates the special float string like "Inf" and "NaN"
+	// is rejected in strict float converting, eg: FloatOption.Strict and ScanOption.StrictFloat.
+	ErrSpecialFloat = converter.ErrSpecialFloat
+
+	// ErrMaxLenExceeded is the error that indicates the value of string/[]byte attribute exceeds the
+	// maximum length specified by tag option, eg: `gconv:"maxlen:256"`, in ScanOption.MaxLenStrict mode.
+	ErrMaxLenExceeded = converter.ErrMaxLenExceeded
 )
 
 // NewConverter creates and returns management object for type converting.
@@ -161,11 +296,116 @@ func RegisterConverter(fn any) (err error) {
 }
 
 // RegisterTypeConverterFunc registers custom converter.
+//
+// The converter function should be defined as `func(T1) (*T2, error)`. The converter registered
+// for source type string is also used for integer, float and named string (eg: json.Number) sources,
+// which are converted to string before calling. The converter whose output type is `*string`
+// is used for presenting values in map converting. For example, for decimal type:
+//
+//	gconv.RegisterTypeConverterFunc(func(s string) (*decimal.Decimal, error) {
+//		d, err := decimal.NewFromString(s)
+//		return &d, err
+//	})
+//	gconv.RegisterTypeConverterFunc(func(d decimal.Decimal) (*string, error) {
+//		s := d.String()
+//		return &s, nil
+//	})
 func RegisterTypeConverterFunc(fn any) (err error) {
 	return defaultConverter.RegisterTypeConverterFunc(fn)
 }
 
+// UnregisterConverter removes the custom converter registered for converting `fromType` to `toType`,
+// which is usually used for cleaning up the converters registered in tests.
+// The `toType` can be either the pointer type as registered or its element type.
+// It returns true if the converter is found and removed.
+func UnregisterConverter(fromType, toType reflect.Type) bool {
+	return defaultConverter.UnregisterConverter(fromType, toType)
+}
+
+// ListConverters returns all the registered custom converters.
+func ListConverters() []ConverterInfo {
+	return defaultConverter.ListConverters()
+}
+
 // RegisterAnyConverterFunc registers custom type converting function for specified type.
 func RegisterAnyConverterFunc(f AnyConvertFunc, types ...reflect.Type) {
 	defaultConverter.RegisterAnyConverterFunc(f, types...)
 }
+
+// RegisterDefaultProvider registers lazy default value provider for struct attributes, which is more
+// flexible than the static default value of struct tag, like the computed or expensive default values.
+//
+// The parameter `fn` must be defined as pattern `func(context.Context) (T, error)`, which is keyed by
+// the type `T`. It is called during converting only when the attribute of type `T` or `*T` is missing
+// in the source or converted to zero value. The context is passed by option Context of Scan/Struct.
+//
+// Example:
+//
+//	gconv.RegisterDefaultProvider(func(ctx context.Context) (Region, error) {
+//	    return lookupRegion(ctx)
+//	})
+func RegisterDefaultProvider(fn any) (err error) {
+	return defaultConverter.RegisterDefaultProvider(fn)
+}
+
+// UnregisterDefaultProvider removes the default provider registered for type `t`,
+// which is usually used for cleaning up the providers registered in tests.
+// It returns true if the provider is found and removed.
+func UnregisterDefaultProvider(t reflect.Type) bool {
+	return defaultConverter.UnregisterDefaultProvider(t)
+}
+
+// RegisterNamedConverter registers converter function `fn` with name `name`, which is applied only to
+// the struct attributes specified by tag option `converter`, unlike RegisterTypeConverterFunc applying
+// to all the attributes of the matched types. So the same type can be converted differently in
+// different structs.
+//
+// The parameter `fn` must be defined as pattern `func(T1) (T2, error)`. The source value is converted
+// to `T1` before calling, and the result of `T2` is converted to the attribute type after calling.
+//
+// Example:
+//
+//	gconv.RegisterNamedConverter("cents", func(yuan float64) (int64, error) {
+//	    return int64(math.Round(yuan * 100)), nil
+//	})
+//
+//	type Order struct {
+//	    Amount int64 `json:"amount" gconv:"converter:cents"`
+//	}
+//	// {"amount": "12.34"} -> Order{Amount: 1234}
+func RegisterNamedConverter(name string, fn any) (err error) {
+	return defaultConverter.RegisterNamedConverter(name, fn)
+}
+
+// UnregisterNamedConverter removes the converter function registered with name `name`,
+// which is usually used for cleaning up the converters registered in tests.
+// It returns true if the converter is found and removed.
+func UnregisterNamedConverter(name string) bool {
+	return defaultConverter.UnregisterNamedConverter(name)
+}
+
+// RegisterProtoTimestamp registers the converting between time and the struct type `t` in protobuf
+// Timestamp shape, which is the struct having exactly the exported attributes `Seconds int64` and
+// `Nanos int32`, like `timestamppb.Timestamp`. The shape is checked structurally, so no protobuf
+// package is imported.
+//
+// Example:
+//
+//	gconv.RegisterProtoTimestamp(reflect.TypeOf(timestamppb.Timestamp{}))
+//
+//	t := gconv.Time(&timestamppb.Timestamp{Seconds: 1700000000})
+//	// 2023-11-14 22:13:20 +0000 UTC
+//
+//	var ts *timestamppb.Timestamp
+//	err := gconv.Scan("2023-11-14T22:13:20Z", &ts)
+//	// ts.Seconds == 1700000000
+func RegisterProtoTimestamp(t reflect.Type) (err error) {
+	return defaultConverter.RegisterProtoTimestamp(t)
+}
+
+// UnregisterProtoTimestamp removes the converting between time and the struct type `t` registered by
+// RegisterProtoTimestamp, which is usually used for cleaning up in tests.
+// It returns true if it is registered before.
+func UnregisterProtoTimestamp(t reflect.Type) bool {
+	return defaultConverter.UnregisterProtoTimestamp(t)
+}
@@ -0,0 +1,37 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv
+
+// CSVHeader retrieves and returns the CSV column names of struct type `structType` in order of
+// attribute declaration, which are the converting keys from tags like gconv/c/json, or the attribute
+// names if no tag. The `structType` can be a reflect.Type, or a struct/*struct value.
+// It returns nil if `structType` is not a struct type.
+func CSVHeader(structType any) []string {
+	header, _ := defaultConverter.CSVHeader(structType)
+	return header
+}
+
+// ToCSVRow converts struct `value` to CSV row, the cells of which are the attribute values in order
+// of column names `fields`, which is usually used for CSV exporting along with CSVHeader.
+// It uses the columns of CSVHeader if `fields` is empty, and it returns nil if `value` is not a struct.
+//
+// The time attributes are formatted using CSVOption.TimeLayout, which is "2006-01-02 15:04:05" in
+// default, and the float numbers are never formatted in exponent.
+func ToCSVRow(value any, fields []string, option ...CSVOption) []string {
+	row, _ := defaultConverter.ToCSVRow(value, fields, option...)
+	return row
+}
+
+// FromCSVRow converts CSV row `row` to struct `dstPointer`, the cells of which are bound to the
+// attributes in order of column names `fields`, which is usually used for CSV importing.
+// It uses the columns of CSVHeader if `fields` is empty.
+//
+// The empty cells are ignored, so the attributes keep their default values. The time cells are parsed
+// using CSVOption.TimeLayout, or in the common time formats if it fails.
+func FromCSVRow(row []string, dstPointer any, fields []string, option ...CSVOption) error {
+	return defaultConverter.FromCSVRow(row, dstPointer, fields, option...)
+}
@@ -0,0 +1,40 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv
+
+import (
+	"time"
+
+	"github.com/gogf/gf/v2/util/gconv/internal/converter"
+)
+
+// DurationString formats duration `d` as human-readable string in `style`, which is usually used
+// for displaying in UI, and it can be parsed back by ParseDuration.
+//
+// Example:
+//
+//	DurationString(90*time.Minute, DurationStyleShort) // "1h 30m"
+//	DurationString(90*time.Minute, DurationStyleLong)  // "1 hour 30 minutes"
+//	DurationString(50*time.Hour, DurationStyleShort)   // "2d 2h"
+func DurationString(d time.Duration, style DurationStyle) string {
+	return converter.DurationString(d, style)
+}
+
+// ParseDuration parses duration string `s` in Go format or human-readable forms, which supports
+// the days and weeks that time.ParseDuration rejects. It is used by Duration and Scan for the
+// time.Duration attributes only if gtime.ParseDuration fails, so the strings accepted by
+// gtime.ParseDuration keep their results.
+//
+// Example:
+//
+//	ParseDuration("1.5h")                // 1h30m0s
+//	ParseDuration("2 days")              // 48h0m0s
+//	ParseDuration("1w 2d")               // 216h0m0s
+//	ParseDuration("1 hour and 30 mins")  // 1h30m0s
+func ParseDuration(s string) (time.Duration, error) {
+	return converter.ParseDuration(s)
+}
@@ -0,0 +1,21 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv
+
+// Fields retrieves and returns the descriptors of attributes of struct type `structType` recursively,
+// which is usually used for building dynamic form schemas from structs. The `structType` can be a
+// reflect.Type, or a struct/*struct value. It returns nil if `structType` is not a struct type.
+//
+// The descriptor contains the attribute name, the converting key, the type kind and the tag metadata
+// like default value, required and label, which uses the same tag parsing as Scan. The attributes of
+// nested struct, or element struct of slice/array/map attribute are in FieldInfo.Fields.
+//
+// The result is cached for each struct type, which should not be modified.
+func Fields(structType any) []FieldInfo {
+	fields, _ := defaultConverter.Fields(structType)
+	return fields
+}
@@ -0,0 +1,64 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv
+
+import (
+	"reflect"
+
+	"github.com/gogf/gf/v2/util/gconv/internal/converter"
+)
+
+// FlagsToNames converts bitflags `flags` to the flag names according to `mapping`.
+// The name whose flag value has multiple bits is contained only if all its bits are set in `flags`.
+// The returned names are sorted by flag value and then name.
+//
+// Example:
+//
+//	FlagsToNames(5, map[string]uint64{"read": 1, "write": 2, "delete": 4})
+//	// ["read", "delete"]
+func FlagsToNames(flags uint64, mapping map[string]uint64) []string {
+	return converter.FlagsToNames(flags, mapping)
+}
+
+// NamesToFlags converts flag names `names` to bitflags according to `mapping`.
+// It returns error for the names that are not in `mapping`, unless the option IgnoreUnknown is set.
+//
+// Example:
+//
+//	NamesToFlags([]string{"read", "delete"}, map[string]uint64{"read": 1, "write": 2, "delete": 4})
+//	// 5
+func NamesToFlags(names []string, mapping map[string]uint64, option ...FlagsOption) (uint64, error) {
+	return converter.NamesToFlags(names, mapping, option...)
+}
+
+// RegisterFlagsMapping registers the flag names mapping for the bitflags struct attributes of integer
+// type `t`, which are specified by tag option `flags`. The attribute is then bound from the flag names,
+// which can be a slice of names or a string of names separated by char ','. The numeric source values
+// are bound as it is. The unknown names are ignored if the option IgnoreUnknownFlags is set.
+//
+// Example:
+//
+//	type Permission uint64
+//
+//	gconv.RegisterFlagsMapping(reflect.TypeOf(Permission(0)), map[string]uint64{
+//	    "read": 1, "write": 2, "delete": 4,
+//	})
+//
+//	type Role struct {
+//	    Permission Permission `json:"permission" gconv:",flags"`
+//	}
+//	// {"permission": ["read", "write"]} -> Role{Permission: 3}
+func RegisterFlagsMapping(t reflect.Type, mapping map[string]uint64) (err error) {
+	return defaultConverter.RegisterFlagsMapping(t, mapping)
+}
+
+// UnregisterFlagsMapping removes the flag names mapping registered for type `t`,
+// which is usually used for cleaning up the mappings registered in tests.
+// It returns true if the mapping is found and removed.
+func UnregisterFlagsMapping(t reflect.Type) bool {
+	return defaultConverter.UnregisterFlagsMapping(t)
+}
@@ -13,6 +13,9 @@ func Float32(anyInput any) float32 {
 }
 
 // Float64 converts `any` to float64.
+//
+// The special float strings in standard Go representations like "Inf", "+Infinity" and "NaN" are
+// accepted. Use Converter.Float64 with FloatOption.Strict for rejecting them with ErrSpecialFloat.
 func Float64(anyInput any) float64 {
 	v, _ := defaultConverter.Float64(anyInput)
 	return v
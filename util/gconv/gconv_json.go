@@ -0,0 +1,68 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv
+
+// JsonBytes converts `value` to JSON bytes through the converting layer rather than encoding/json
+// reflection, so that the keys of struct attributes are named in the same tag priority as Map function:
+// gconv, json, field name, and the registered converters to string are honored for attribute values.
+//
+// The optional `option` specifies the map converting option like Tags, OmitEmpty and KeyStyle,
+// and `value` is always converted recursively.
+//
+// Example:
+//
+//	type User struct {
+//	    Id   int    `c:"uid" json:"id"`
+//	    Name string `json:"name"`
+//	}
+//
+//	b, err := JsonBytes(User{Id: 1, Name: "john"})
+//	// b: {"name":"john","uid":1}
+func JsonBytes(value any, option ...MapOption) ([]byte, error) {
+	return defaultConverter.JsonBytes(value, option...)
+}
+
+// ScanJson decodes JSON `data` and converts the result to `dstPointer` using the same binding
+// semantics as Scan function, which honors tag priority, fuzzy key matching and registered converters.
+// It is the counterpart of JsonBytes.
+//
+// For PATCH like updating, the JSON null and absent key are handled differently:
+// the JSON null resets the matched attribute to its zero value, eg: nil for pointer attribute,
+// while the attribute of absent key is left unchanged. Use ScanJsonPresence to know exactly
+// which attributes are present in `data`.
+//
+// The attribute of type json.RawMessage or []byte captures the raw bytes of the matched object or array
+// in `data` without decoding, which enables deferred or polymorphic handling of sub-documents.
+func ScanJson(data []byte, dstPointer any, option ...ScanOption) (err error) {
+	return defaultConverter.ScanJson(data, dstPointer, getUsedScanJsonOption(option...)...)
+}
+
+// ScanJsonPresence does the same as ScanJson, but it also returns the paths of struct attributes having
+// matched keys in `data`, in which the attributes of JSON null are reported present, and the attributes of
+// absent keys are not. The path of nested struct attribute is joined with char '.', like: "Address.City".
+//
+// Example:
+//
+//	type UserPatch struct {
+//	    Name     *string
+//	    Nickname *string
+//	}
+//
+//	var patch UserPatch
+//	present, err := ScanJsonPresence([]byte(`{"nickname":null}`), &patch)
+//	// patch.Nickname == nil && present["Nickname"]: set Nickname to null.
+//	// patch.Name == nil && !present["Name"]: leave Name unchanged.
+func ScanJsonPresence(data []byte, dstPointer any, option ...ScanOption) (present map[string]bool, err error) {
+	return defaultConverter.ScanJsonPresence(data, dstPointer, getUsedScanJsonOption(option...)...)
+}
+
+func getUsedScanJsonOption(option ...ScanOption) []ScanOption {
+	if len(option) == 0 {
+		return []ScanOption{{ContinueOnError: true}}
+	}
+	return option
+}
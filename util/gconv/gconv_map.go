@@ -12,6 +12,14 @@ package gconv
 // If `value` is a struct/*struct object, the second parameter `priorityTagAndFieldName` specifies the most priority
 // priorityTagAndFieldName that will be detected, otherwise it detects the priorityTagAndFieldName in order of:
 // gconv, json, field name.
+//
+// If `value` is an error, it is converted to map of its code and message, and also its stack if
+// option ErrorStack is set, eg: {"code": 51, "message": "invalid parameter"}. The error attributes
+// of struct are converted the same way if option Deep is set.
+//
+// In deep converting, the pointer to struct or the map referenced more than once in `value` is converted
+// to the same map, which means the shared references are preserved instead of duplicated, and the
+// reference cycles like doubly-linked nodes result in cyclic maps instead of endless recursion.
 func Map(value any, option ...MapOption) map[string]any {
 	result, _ := defaultConverter.Map(value, getUsedMapOption(option...))
 	return result
@@ -32,6 +40,57 @@ func MapDeep(value any, tags ...string) map[string]any {
 	return result
 }
 
+// MapWithKeyStyle converts `value` to map[string]any recursively, the keys of which are
+// formatted with `style` if they are derived from attribute names of struct or keys of map.
+// The keys specified by struct tags are kept as they are, eg:
+//
+//	type User struct {
+//		UserName string
+//		Nickname string `json:"nick"`
+//	}
+//	gconv.MapWithKeyStyle(User{}, gconv.MapKeyStyleSnake) // {"user_name": "", "nick": ""}
+func MapWithKeyStyle(value any, style MapKeyStyle, option ...MapOption) map[string]any {
+	result, _ := defaultConverter.MapWithKeyStyle(value, style, getUsedMapOption(option...))
+	return result
+}
+
+// MapWithMethods converts struct `value` to map[string]any like Map function, and also adds the results
+// of methods named in `methods` to the map, which is usually used for API output with computed fields
+// without DTO boilerplate.
+//
+// The item of `methods` is the method name, the result key of which is the method name formatted with
+// option.KeyStyle, or in format "MethodName:key" specifying the result key explicitly. The methods must be
+// exported, have no parameter, and return one value, optionally with an error. The methods failing calling
+// are ignored, eg:
+//
+//	type User struct {
+//		FirstName string `json:"first_name"`
+//		LastName  string `json:"last_name"`
+//	}
+//	func (u User) FullName() string { return u.FirstName + " " + u.LastName }
+//	gconv.MapWithMethods(User{"john", "smith"}, []string{"FullName:full_name"})
+//	// {"first_name": "john", "last_name": "smith", "full_name": "john smith"}
+func MapWithMethods(value any, methods []string, option ...MapOption) map[string]any {
+	result, _ := defaultConverter.MapWithMethods(value, methods, getUsedMapOption(option...))
+	return result
+}
+
+// FlatMap converts `value` to a flat map[string]any, the keys of which are the paths of leaf values
+// in `value`, which is usually used for HTML form serialization of nested objects. The keys of nested
+// map/struct are joined using char '.', and the indexes of slice/array are in bracket notation, eg:
+//
+//	type User struct {
+//		Name string   `json:"name"`
+//		Tags []string `json:"tags"`
+//	}
+//	gconv.FlatMap(g.Map{"user": User{"john", []string{"a"}}}) // {"user.name": "john", "user.tags[0]": "a"}
+//
+// The flat map can be converted back to the nested struct using function Scan.
+func FlatMap(value any, option ...MapOption) map[string]any {
+	result, _ := defaultConverter.FlatMap(value, getUsedMapOption(option...))
+	return result
+}
+
 // MapStrStr converts `value` to map[string]string.
 // Note that there might be data copy for this map type converting.
 func MapStrStr(value any, option ...MapOption) map[string]string {
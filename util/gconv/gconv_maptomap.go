@@ -8,7 +8,11 @@ package gconv
 
 // MapToMap converts any map type variable `params` to another map type variable `pointer`
 // using reflect.
-// See doMapToMap.
+//
+// The keys of `params` are converted to the key type of `pointer`, eg: map[int]T to map[int64]U.
+// The keys are converted to string using String for string key type, and to integer for integer
+// key type, which fails if the key is not numeric or overflows the key type, like 300 for uint8.
+// The converted keys are then converted to the custom key type, like `type Id int64`.
 func MapToMap(params any, pointer any, mapping ...map[string]string) error {
 	return Scan(params, pointer, mapping...)
 }
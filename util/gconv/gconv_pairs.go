@@ -0,0 +1,36 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv
+
+// Pairs converts struct `value` to key-value pairs in order of attribute declaration,
+// or in order of key if `sorted` is true, which is usually used for building canonical
+// strings like signature. It returns nil if `value` is not a struct/*struct/map type.
+//
+// The key of pair is the tag name or the attribute name like function Map, and the attribute
+// with tag `omitempty` is ignored if its value is empty. The attributes of nested struct are
+// flattened using dotted keys if PairsOption.Nested is true, eg: `user.name`.
+func Pairs(value any, sorted bool, option ...PairsOption) []Pair {
+	result, _ := defaultConverter.Pairs(value, sorted, option...)
+	return result
+}
+
+// SortedPairs converts map or struct `value` to key-value pairs sorted by key recursively, which is
+// usually used for computing deterministic diffs and signatures of config maps. The nested maps and
+// structs are converted to []Pair sorted by key as well, including the ones in slices.
+// It returns nil if `value` is not a struct/*struct/map type.
+//
+// The pairs can be converted back to map using function PairsToMap.
+func SortedPairs(value any) []Pair {
+	result, _ := defaultConverter.SortedPairs(value)
+	return result
+}
+
+// PairsToMap converts `pairs` back to map[string]any, which is the inverse of function SortedPairs.
+// The values of type []Pair are converted to nested maps recursively, including the ones in slices.
+func PairsToMap(pairs []Pair) map[string]any {
+	return defaultConverter.PairsToMap(pairs)
+}
@@ -0,0 +1,39 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv
+
+// QueryString converts map/struct `value` to query string in format of `application/x-www-form-urlencoded`,
+// which is usually used for building the URL of outbound request. The keys are named in the same tag
+// priority as Map function, and the attributes having `omitempty` tag are omitted if they are empty and
+// option MapOption.OmitEmpty is set. The nested map/struct attributes are converted with dotted keys,
+// and the slice attributes are converted in style of option SliceStyle.
+//
+// Example:
+//
+//	type Query struct {
+//	    Keyword string   `json:"q"`
+//	    Tags    []string `json:"tags"`
+//	    Page    struct {
+//	        Num  int `json:"num"`
+//	        Size int `json:"size,omitempty"`
+//	    } `json:"page"`
+//	}
+//
+//	QueryString(Query{Keyword: "go frame", Tags: []string{"a", "b"}})
+//	// page.num=0&page.size=0&q=go+frame&tags=a&tags=b
+func QueryString(value any, option ...QueryOption) string {
+	result, _ := defaultConverter.QueryString(value, option...)
+	return result
+}
+
+// ScanQuery parses query string `query` and converts it to `dstPointer` using the same binding semantics
+// as Scan function. It is the counterpart of QueryString, in which the repeated keys and the keys with
+// brackets like `tags[]` are converted to slices, and the dotted and indexed keys like `page.num` and
+// `items[0].name` are converted to the nested attributes.
+func ScanQuery(query string, dstPointer any, option ...ScanOption) (err error) {
+	return defaultConverter.ScanQuery(query, dstPointer, option...)
+}
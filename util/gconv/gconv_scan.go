@@ -6,6 +6,10 @@
 
 package gconv
 
+import (
+	"github.com/gogf/gf/v2/util/gconv/internal/converter"
+)
+
 // Scan automatically checks the type of `pointer` and converts `params` to `pointer`.
 // It supports various types of parameter conversions, including:
 // 1. Basic types (int, string, float, etc.)
@@ -15,6 +19,10 @@ package gconv
 // 5. Struct types
 //
 // The `paramKeyToAttrMap` parameter is used for mapping between attribute names and parameter keys.
+//
+// If `srcValue` is pointer to struct, the source pointer referenced more than once is bound to the same
+// destination pointer, which means the shared references are preserved instead of duplicated, and the
+// reference cycles like doubly-linked nodes are reproduced in the destination instead of endless recursion.
 // TODO: change `paramKeyToAttrMap` to `ScanOption` to be more scalable; add `DeepCopy` option for `ScanOption`.
 func Scan(srcValue any, dstPointer any, paramKeyToAttrMap ...map[string]string) (err error) {
 	option := ScanOption{
@@ -57,3 +65,56 @@ func Scan(srcValue any, dstPointer any, paramKeyToAttrMap ...map[string]string)
 func ScanWithOptions(srcValue any, dstPointer any, option ...ScanOption) (err error) {
 	return defaultConverter.Scan(srcValue, dstPointer, option...)
 }
+
+// ScanWithWarnings does the same as ScanWithOptions, but it also returns the non-fatal warnings of lossy
+// type coercion for struct attributes, like float to int truncation, integer overflow and string to bool
+// converting from unexpected token. Each warning carries the attribute path, source value and reason.
+//
+// It is useful for flagging suspicious data without rejecting it. The coercion checks are done only
+// by this function, so there's no performance impact for other converting functions.
+//
+// Example:
+//
+//	warnings, err := ScanWithWarnings(g.Map{"age": 18.5}, &user)
+//	// warnings: [{Field: "Age", Value: 18.5, Reason: "fractional part truncated converting to type int"}]
+func ScanWithWarnings(srcValue any, dstPointer any, option ...ScanOption) (warnings []ScanWarning, err error) {
+	return defaultConverter.ScanWithWarnings(srcValue, dstPointer, option...)
+}
+
+// ScanPresence does the same as ScanWithOptions, but it also returns the paths of struct attributes having
+// matched keys in the source, which distinguishes the present attributes from the absent ones even if the
+// source values are empty. It respects the tag priority of attributes, and the path of nested struct attribute
+// is joined with char '.'. The attributes assigned by default values are not reported.
+//
+// It is useful for partial updates like HTTP PATCH, which updates only the present attributes.
+//
+// Example:
+//
+//	present, err := ScanPresence(g.Map{"name": "john", "address": g.Map{"city": ""}}, &user)
+//	// present: {"Name": true, "Address": true, "Address.City": true}
+func ScanPresence(srcValue any, dstPointer any, option ...ScanOption) (present map[string]bool, err error) {
+	return defaultConverter.ScanPresence(srcValue, dstPointer, option...)
+}
+
+// ScanSnapshot does the same as ScanWithOptions, but it also returns the snapshot hash of `dstPointer` after
+// converting, which is usually used for detecting the later mutations of converted value like configuration,
+// by comparing it with the result of SnapshotHash.
+//
+// Example:
+//
+//	hash, err := ScanSnapshot(g.Map{"name": "john"}, &config)
+//	// ...
+//	if SnapshotHash(&config) != hash {
+//	    // The config is mutated.
+//	}
+func ScanSnapshot(srcValue any, dstPointer any, option ...ScanOption) (hash string, err error) {
+	return defaultConverter.ScanSnapshot(srcValue, dstPointer, option...)
+}
+
+// SnapshotHash computes and returns the SHA-256 hash in hex of the content of `value`, which is stable
+// across runs for the identical content. It walks the nested values recursively, in which the pointers
+// are dereferenced, the map items are sorted by key, and only the exported struct attributes are taken
+// into account. The value implementing encoding.TextMarshaler like time.Time is hashed with its text.
+func SnapshotHash(value any) string {
+	return converter.SnapshotHash(value)
+}
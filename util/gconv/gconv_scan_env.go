@@ -0,0 +1,31 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv
+
+// ScanEnv converts environment-style string map `srcMap` to struct `dstPointer`.
+// The key of `srcMap` is split using separator into nested attribute path, for example,
+// the key `APP_SERVER_PORT` is bound to attribute `App.Server.Port` with default separator "_".
+//
+// The separator, key prefix and whether ignoring unknown keys can be configured by `option`.
+//
+// Example:
+//
+//	type Config struct {
+//	    Server struct {
+//	        Port        int
+//	        MaxBodySize int64
+//	    }
+//	}
+//
+//	var config *Config
+//	err := ScanEnv(map[string]string{
+//	    "APP_SERVER_PORT":          "8000",
+//	    "APP_SERVER_MAX_BODY_SIZE": "1024",
+//	}, &config, ScanEnvOption{Prefix: "APP"})
+func ScanEnv(srcMap map[string]string, dstPointer any, option ...ScanEnvOption) (err error) {
+	return defaultConverter.ScanEnv(srcMap, dstPointer, option...)
+}
@@ -0,0 +1,43 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv
+
+// ScanMerge converts the sources `srcs` in order to struct `dstPointer`, in which the non-zero attribute
+// values of the later source override the former ones. Each source can be a map or struct.
+// It is usually used for configuration layering, eg: defaults, file, environment and flags.
+//
+// The nested struct and map attributes are merged deeply, and the slice attributes are replaced.
+// Use ScanMergeWithOptions for other merging strategies.
+//
+// Example:
+//
+//	type Config struct {
+//	    Host  string
+//	    Port  int
+//	    Debug bool
+//	}
+//
+//	var config *Config
+//	err := ScanMerge(&config,
+//	    Config{Host: "127.0.0.1", Port: 8000},
+//	    map[string]any{"port": 8080},
+//	    map[string]any{"debug": true},
+//	)
+//	// config: {Host: "127.0.0.1", Port: 8080, Debug: true}
+func ScanMerge(dstPointer any, srcs ...any) (err error) {
+	return defaultConverter.ScanMerge(dstPointer, srcs, ScanMergeOption{
+		ScanOption: ScanOption{
+			ContinueOnError: true,
+		},
+	})
+}
+
+// ScanMergeWithOptions does the same as ScanMerge, but with merging options `option`, which specifies
+// whether replacing the nested attributes and appending the slice attributes.
+func ScanMergeWithOptions(dstPointer any, option ScanMergeOption, srcs ...any) (err error) {
+	return defaultConverter.ScanMerge(dstPointer, srcs, option)
+}
@@ -0,0 +1,36 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv
+
+import (
+	"io"
+	"reflect"
+)
+
+// ScanStream decodes the JSON array from `reader` element by element, converts each element to
+// `elemType` and sends it to `out`, which is closed when the streaming is done.
+// It is useful for processing huge JSON arrays, like request body of importing, with bounded memory.
+//
+// The converting error is sent to `out` as a value of type `error` alongside the elements,
+// and the first error is also returned. The streaming stops at the first error unless
+// option.ContinueOnError is true.
+//
+// Example:
+//
+//	out := make(chan any, 100)
+//	go ScanStream(r.Body, reflect.TypeOf(User{}), out)
+//	for item := range out {
+//	    switch v := item.(type) {
+//	    case error:
+//	        // Handle the error.
+//	    case User:
+//	        // Handle the user.
+//	    }
+//	}
+func ScanStream(reader io.Reader, elemType reflect.Type, out chan<- any, option ...ScanOption) (err error) {
+	return defaultConverter.ScanStream(reader, elemType, out, option...)
+}
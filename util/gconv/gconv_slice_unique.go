@@ -0,0 +1,32 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv
+
+// SliceUnique converts `srcValue` to the slice `dstPointer` pointing to, and removes the duplicated
+// elements by their converted values, which keeps the order of their first occurrences.
+// The element type of the destination slice should be comparable.
+//
+// Example:
+//
+//	var ids []int
+//	err := SliceUnique([]string{"3", "1", "3", "2", "1"}, &ids)
+//	// ids: [3 1 2]
+func SliceUnique(srcValue any, dstPointer any) (err error) {
+	return defaultConverter.SliceUnique(srcValue, dstPointer)
+}
+
+// ToSet converts `srcValue` to the set `dstPointer` pointing to, which is a map with empty struct
+// values like map[string]struct{}. The key type of the destination map should be comparable.
+//
+// Example:
+//
+//	var set map[int]struct{}
+//	err := ToSet([]any{1, "2", 2.0, 3}, &set)
+//	// set: map[1:{} 2:{} 3:{}]
+func ToSet(srcValue any, dstPointer any) (err error) {
+	return defaultConverter.ToSet(srcValue, dstPointer)
+}
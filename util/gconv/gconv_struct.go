@@ -19,6 +19,11 @@ package gconv
 //     It will automatically convert the first letter of the key to uppercase
 //     in mapping procedure to do the matching.
 //     It ignores the map key, if it does not match.
+//     The non-string map keys are converted to string for matching, like map[int]string, and the
+//     key of custom type implementing fmt.Stringer is converted using its String method.
+//  5. The attribute marked by tag option `required`, eg: `gconv:"name,required"`, should be present
+//     in `params`, or else it returns one error containing all the missing required attributes,
+//     including the ones of nested struct attributes present in `params`.
 func Struct(params any, pointer any, paramKeyToAttrMap ...map[string]string) (err error) {
 	return Scan(params, pointer, paramKeyToAttrMap...)
 }
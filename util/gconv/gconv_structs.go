@@ -19,6 +19,23 @@ func SliceStruct(params any, pointer any, mapping ...map[string]string) (err err
 	return Structs(params, pointer, mapping...)
 }
 
+// StructsParallel converts any slice to given struct slice like Structs, but it splits the elements
+// across `workers` goroutines for converting, which speeds up converting huge slice, eg: the result
+// of database query having hundreds of thousands of records. The order of elements is preserved.
+// It uses runtime.GOMAXPROCS(0) workers if `workers` <= 0.
+//
+// It converts all the elements, and returns an *ElementConvertError carrying the errors of all the
+// failed elements with their indexes. The result slice is not assigned to `pointer` if any element fails.
+func StructsParallel(params any, pointer any, workers int, option ...StructsOption) (err error) {
+	if len(option) == 0 {
+		option = []StructsOption{{
+			SliceOption:  converter.SliceOption{ContinueOnError: true},
+			StructOption: converter.StructOption{ContinueOnError: true},
+		}}
+	}
+	return defaultConverter.StructsParallel(params, pointer, workers, option...)
+}
+
 // StructsTag acts as Structs but also with support for priority tag feature, which retrieves the
 // specified priorityTagAndFieldName for `params` key-value items to struct attribute names mapping.
 // The parameter `priorityTag` supports multiple priorityTagAndFieldName that can be joined with char ','.
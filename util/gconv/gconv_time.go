@@ -19,7 +19,8 @@ func Time(anyInput any, format ...string) time.Time {
 }
 
 // Duration converts `any` to time.Duration.
-// If `any` is string, then it uses time.ParseDuration to convert it.
+// If `any` is string, then it uses gtime.ParseDuration to convert it, and falls back to ParseDuration
+// for the human-readable forms like "2 days" and "1 hour 30 minutes".
 // If `any` is numeric, then it converts `any` as nanoseconds.
 func Duration(anyInput any) time.Duration {
 	d, _ := defaultConverter.Duration(anyInput)
@@ -0,0 +1,33 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv
+
+// ToEnv converts struct `value` to environment-style string map, which is the reverse of ScanEnv.
+// The key is the attribute path in upper snake case joined using separator, and prefixed with
+// `prefix` if it is not empty, for example, the attribute `Server.MaxBodySize` is converted to key
+// `APP_SERVER_MAX_BODY_SIZE` with prefix "APP" and default separator "_".
+//
+// The slice attributes are joined to one key, or converted to indexed keys like `APP_HOSTS_0`,
+// which can be configured by `option`. Note that the joined values are split by ScanEnv only if
+// ScanEnvOption.SliceSeparator is specified. It returns nil if `value` is not a struct.
+//
+// Example:
+//
+//	type Config struct {
+//	    Server struct {
+//	        Port  int
+//	        Hosts []string
+//	    }
+//	}
+//
+//	envMap := ToEnv(Config{...}, "APP")
+//	// APP_SERVER_PORT=8000
+//	// APP_SERVER_HOSTS=a,b
+func ToEnv(value any, prefix string, option ...ToEnvOption) map[string]string {
+	result, _ := defaultConverter.ToEnv(value, prefix, option...)
+	return result
+}
@@ -9,6 +9,7 @@
 package gconv
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 )
@@ -175,3 +176,57 @@ func Benchmark_Structs_PPStructs_PStructs(b *testing.B) {
 		Structs(structPointerSlice, &structPointerSliceNil)
 	}
 }
+
+func Benchmark_JsonBytes_Struct(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		JsonBytes(structObj)
+	}
+}
+
+func Benchmark_JsonMarshal_Struct(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		json.Marshal(structObj)
+	}
+}
+
+func Benchmark_ScanJson_Struct(b *testing.B) {
+	var (
+		data, _ = json.Marshal(structObj)
+		obj     = new(structType)
+	)
+	for i := 0; i < b.N; i++ {
+		ScanJson(data, obj)
+	}
+}
+
+func Benchmark_JsonUnmarshal_Struct(b *testing.B) {
+	var (
+		data, _ = json.Marshal(structObj)
+		obj     = new(structType)
+	)
+	for i := 0; i < b.N; i++ {
+		json.Unmarshal(data, obj)
+	}
+}
+
+var structsParallelMaps = func() []map[string]any {
+	var maps = make([]map[string]any, 100000)
+	for i := range maps {
+		maps[i] = structMapFields8
+	}
+	return maps
+}()
+
+func Benchmark_Structs_Sequential_100k(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var objs []structType8
+		Structs(structsParallelMaps, &objs)
+	}
+}
+
+func Benchmark_StructsParallel_100k(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var objs []structType8
+		StructsParallel(structsParallelMaps, &objs, 0)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+// decimalInTest is a decimal-like type with private attributes, which cannot be converted
+// by attribute binding.
+type decimalInTest struct {
+	value *big.Rat
+}
+
+func (d decimalInTest) String() string {
+	if d.value == nil {
+		return "0"
+	}
+	return d.value.FloatString(4)
+}
+
+func TestConverter_DecimalLikeType(t *testing.T) {
+	var converter = gconv.NewConverter()
+	gtest.C(t, func(t *gtest.T) {
+		err := converter.RegisterTypeConverterFunc(func(s string) (*decimalInTest, error) {
+			r, ok := new(big.Rat).SetString(s)
+			if !ok {
+				return nil, errors.New("invalid decimal: " + s)
+			}
+			return &decimalInTest{value: r}, nil
+		})
+		t.AssertNil(err)
+		err = converter.RegisterTypeConverterFunc(func(d decimalInTest) (*string, error) {
+			s := d.String()
+			return &s, nil
+		})
+		t.AssertNil(err)
+	})
+	// String source.
+	gtest.C(t, func(t *gtest.T) {
+		var d decimalInTest
+		err := converter.Scan("12345678901234567890.1234", &d)
+		t.AssertNil(err)
+		t.Assert(d.String(), "12345678901234567890.1234")
+	})
+	// Numeric sources.
+	gtest.C(t, func(t *gtest.T) {
+		var d decimalInTest
+		err := converter.Scan(100, &d)
+		t.AssertNil(err)
+		t.Assert(d.String(), "100.0000")
+
+		err = converter.Scan(json.Number("1.5"), &d)
+		t.AssertNil(err)
+		t.Assert(d.String(), "1.5000")
+	})
+	// Struct attribute and map converting.
+	gtest.C(t, func(t *gtest.T) {
+		type Order struct {
+			Id     int
+			Amount decimalInTest
+			Fee    *decimalInTest
+		}
+		var order *Order
+		err := converter.Struct(map[string]any{
+			"id":     1,
+			"amount": "99.99",
+			"fee":    2,
+		}, &order)
+		t.AssertNil(err)
+		t.Assert(order.Id, 1)
+		t.Assert(order.Amount.String(), "99.9900")
+		t.Assert(order.Fee.String(), "2.0000")
+
+		m, err := converter.Map(order)
+		t.AssertNil(err)
+		t.Assert(m["Id"], 1)
+		t.Assert(m["Amount"], "99.9900")
+		t.Assert(m["Fee"], "2.0000")
+	})
+}
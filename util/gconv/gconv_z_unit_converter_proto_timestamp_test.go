@@ -0,0 +1,130 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+// protoTimestamp is in the shape of timestamppb.Timestamp.
+type protoTimestamp struct {
+	state   int
+	Seconds int64
+	Nanos   int32
+}
+
+func TestConverter_RegisterProtoTimestamp(t *testing.T) {
+	var (
+		converter = gconv.NewConverter()
+		ts        = &protoTimestamp{Seconds: 1700000000, Nanos: 500}
+		expect    = time.Unix(1700000000, 500).UTC()
+	)
+	// Not registered.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := converter.Time(ts)
+		t.AssertNE(err, nil)
+		t.Assert(converter.UnregisterProtoTimestamp(reflect.TypeOf(protoTimestamp{})), false)
+	})
+	// The type in other shapes is not registered.
+	gtest.C(t, func(t *gtest.T) {
+		type Duration struct {
+			Seconds int64
+			Nanos   int32
+			Unit    string
+		}
+		t.AssertNE(converter.RegisterProtoTimestamp(reflect.TypeOf(Duration{})), nil)
+		t.AssertNE(converter.RegisterProtoTimestamp(nil), nil)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		t.AssertNil(converter.RegisterProtoTimestamp(reflect.TypeOf(&protoTimestamp{})))
+		t.AssertNE(converter.RegisterProtoTimestamp(reflect.TypeOf(protoTimestamp{})), nil)
+	})
+	// Timestamp to time.
+	gtest.C(t, func(t *gtest.T) {
+		v, err := converter.Time(ts)
+		t.AssertNil(err)
+		t.Assert(v.Equal(expect), true)
+		t.Assert(v.Location(), time.UTC)
+
+		v, err = converter.Time(*ts)
+		t.AssertNil(err)
+		t.Assert(v.Equal(expect), true)
+
+		gv, err := converter.GTime(ts)
+		t.AssertNil(err)
+		t.Assert(gv.Time.Equal(expect), true)
+
+		v, err = converter.Time((*protoTimestamp)(nil))
+		t.AssertNil(err)
+		t.Assert(v.IsZero(), true)
+	})
+	// Time to timestamp.
+	gtest.C(t, func(t *gtest.T) {
+		var v *protoTimestamp
+		t.AssertNil(converter.Scan(expect, &v))
+		t.Assert(v.Seconds, 1700000000)
+		t.Assert(v.Nanos, 500)
+
+		var v2 protoTimestamp
+		t.AssertNil(converter.Scan(gtime.NewFromTime(expect), &v2))
+		t.Assert(v2.Seconds, 1700000000)
+		t.Assert(v2.Nanos, 500)
+
+		var v3 protoTimestamp
+		t.AssertNil(converter.Scan("2023-11-14T22:13:20Z", &v3))
+		t.Assert(v3.Seconds, 1700000000)
+		t.Assert(v3.Nanos, 0)
+
+		var v4 protoTimestamp
+		t.AssertNil(converter.Scan(1700000000, &v4))
+		t.Assert(v4.Seconds, 1700000000)
+	})
+	// Map params are bound by attributes as usual.
+	gtest.C(t, func(t *gtest.T) {
+		var v protoTimestamp
+		t.AssertNil(converter.Scan(g.Map{"seconds": 1, "nanos": 2}, &v))
+		t.Assert(v.Seconds, 1)
+		t.Assert(v.Nanos, 2)
+	})
+	// Struct attributes.
+	gtest.C(t, func(t *gtest.T) {
+		type Event struct {
+			CreatedAt *protoTimestamp
+			UpdatedAt time.Time
+		}
+		var event *Event
+		t.AssertNil(converter.Scan(g.Map{
+			"createdAt": expect,
+			"updatedAt": ts,
+		}, &event))
+		t.Assert(event.CreatedAt.Seconds, 1700000000)
+		t.Assert(event.CreatedAt.Nanos, 500)
+		t.Assert(event.UpdatedAt.Equal(expect), true)
+	})
+	// The struct in other shapes is not affected.
+	gtest.C(t, func(t *gtest.T) {
+		type Duration struct {
+			Seconds int64
+			Nanos   int32
+			Unit    string
+		}
+		_, err := converter.Time(Duration{Seconds: 1})
+		t.AssertNE(err, nil)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(converter.UnregisterProtoTimestamp(reflect.TypeOf(protoTimestamp{})), true)
+		_, err := converter.Time(ts)
+		t.AssertNE(err, nil)
+	})
+}
@@ -240,3 +240,59 @@ func TestConverter_RegisterAnyConverterFunc(t *testing.T) {
 		})
 	})
 }
+
+func TestUnregisterConverter(t *testing.T) {
+	type converterInTest struct {
+		Name string
+	}
+	type converterOutTest struct {
+		Place string
+	}
+	var (
+		inType  = reflect.TypeOf(converterInTest{})
+		outType = reflect.TypeOf(converterOutTest{})
+	)
+	gtest.C(t, func(t *gtest.T) {
+		err := gconv.RegisterTypeConverterFunc(func(in converterInTest) (*converterOutTest, error) {
+			return &converterOutTest{Place: "converted:" + in.Name}, nil
+		})
+		t.AssertNil(err)
+
+		var found bool
+		for _, info := range gconv.ListConverters() {
+			if info.FromType == inType && info.ToType == reflect.PointerTo(outType) {
+				found = true
+			}
+		}
+		t.Assert(found, true)
+
+		var out converterOutTest
+		err = gconv.Scan(converterInTest{Name: "john"}, &out)
+		t.AssertNil(err)
+		t.Assert(out.Place, "converted:john")
+
+		t.Assert(gconv.UnregisterConverter(inType, outType), true)
+		t.Assert(gconv.UnregisterConverter(inType, outType), false)
+		for _, info := range gconv.ListConverters() {
+			t.AssertNE(info.FromType, inType)
+		}
+
+		// Falls back to the default converting after unregistered.
+		out = converterOutTest{}
+		err = gconv.Scan(converterInTest{Name: "john"}, &out)
+		t.AssertNil(err)
+		t.Assert(out.Place, "")
+
+		// It can be registered again after unregistered.
+		err = gconv.RegisterTypeConverterFunc(func(in converterInTest) (*converterOutTest, error) {
+			return &converterOutTest{Place: in.Name}, nil
+		})
+		t.AssertNil(err)
+		t.Assert(gconv.UnregisterConverter(inType, reflect.PointerTo(outType)), true)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		conv := gconv.NewConverter()
+		t.Assert(len(conv.ListConverters()), 0)
+		t.Assert(conv.UnregisterConverter(nil, outType), false)
+	})
+}
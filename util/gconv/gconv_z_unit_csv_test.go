@@ -0,0 +1,120 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+type CSVBase struct {
+	Id int64 `json:"id"`
+}
+
+type csvUser struct {
+	CSVBase
+	Name      string      `json:"name"`
+	Score     float64     `json:"score"`
+	Age       *int        `json:"age"`
+	Active    bool        `json:"active"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt *gtime.Time `json:"updated_at"`
+	Level     int         `json:"level" gconv:"level,default:1"`
+}
+
+func TestCSVHeader(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gconv.CSVHeader(csvUser{}), []string{
+			"id", "name", "score", "age", "active", "created_at", "updated_at", "level",
+		})
+		t.Assert(gconv.CSVHeader(&csvUser{}), gconv.CSVHeader(csvUser{}))
+		t.AssertNil(gconv.CSVHeader(1))
+	})
+}
+
+func TestToCSVRow(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			age  = 18
+			user = csvUser{
+				CSVBase:   CSVBase{Id: 1},
+				Name:      "john",
+				Score:     12345678.5,
+				Age:       &age,
+				Active:    true,
+				CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			}
+		)
+		t.Assert(gconv.ToCSVRow(user, nil), []string{
+			"1", "john", "12345678.5", "18", "true", "2024-01-02 03:04:05", "", "0",
+		})
+		t.Assert(
+			gconv.ToCSVRow(&user, []string{"Name", "created_at", "unknown"}, gconv.CSVOption{
+				TimeLayout: time.RFC3339,
+			}),
+			[]string{"john", "2024-01-02T03:04:05Z", ""},
+		)
+		t.AssertNil(gconv.ToCSVRow(1, nil))
+	})
+}
+
+func TestFromCSVRow(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var user csvUser
+		err := gconv.FromCSVRow(
+			[]string{"1", "john", "1.5e3", "", "true", "2024-01-02 03:04:05", "2024-01-03 00:00:00", ""},
+			&user, nil,
+		)
+		t.AssertNil(err)
+		t.Assert(user.Id, 1)
+		t.Assert(user.Name, "john")
+		t.Assert(user.Score, 1500)
+		t.AssertNil(user.Age)
+		t.Assert(user.Active, true)
+		t.Assert(user.CreatedAt.Format("2006-01-02 15:04:05"), "2024-01-02 03:04:05")
+		t.Assert(user.UpdatedAt.String(), "2024-01-03 00:00:00")
+		t.Assert(user.Level, 1)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var user csvUser
+		err := gconv.FromCSVRow(
+			[]string{"02/01/2024", "john", "18", "extra"},
+			&user, []string{"created_at", "Name", "age"},
+			gconv.CSVOption{TimeLayout: "02/01/2006", Location: time.UTC},
+		)
+		t.AssertNil(err)
+		t.Assert(user.CreatedAt, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+		t.Assert(user.Name, "john")
+		t.Assert(*user.Age, 18)
+	})
+	// Round trip.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			user = csvUser{
+				CSVBase:   CSVBase{Id: 2},
+				Name:      "smith",
+				Score:     0.000001,
+				CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.Local),
+				Level:     3,
+			}
+			header = gconv.CSVHeader(user)
+			result csvUser
+		)
+		t.AssertNil(gconv.FromCSVRow(gconv.ToCSVRow(user, header), &result, header))
+		t.Assert(result, user)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var user csvUser
+		t.AssertNE(gconv.FromCSVRow([]string{"x"}, &user, []string{"score"}), nil)
+		t.AssertNE(gconv.FromCSVRow([]string{"x"}, user, nil), nil)
+		t.AssertNE(gconv.FromCSVRow([]string{"x"}, new(int), nil), nil)
+	})
+}
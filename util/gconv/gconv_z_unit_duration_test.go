@@ -0,0 +1,114 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+func TestDurationString(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gconv.DurationString(90*time.Minute, gconv.DurationStyleDefault), "1h30m0s")
+		t.Assert(gconv.DurationString(90*time.Minute, gconv.DurationStyleShort), "1h 30m")
+		t.Assert(gconv.DurationString(90*time.Minute, gconv.DurationStyleLong), "1 hour 30 minutes")
+		t.Assert(gconv.DurationString(50*time.Hour+time.Second, gconv.DurationStyleShort), "2d 2h 1s")
+		t.Assert(gconv.DurationString(50*time.Hour+time.Second, gconv.DurationStyleLong), "2 days 2 hours 1 second")
+		t.Assert(gconv.DurationString(time.Minute+1500*time.Millisecond, gconv.DurationStyleShort), "1m 1.5s")
+		t.Assert(gconv.DurationString(-time.Hour, gconv.DurationStyleLong), "-1 hour")
+		t.Assert(gconv.DurationString(500*time.Millisecond, gconv.DurationStyleShort), "500ms")
+		t.Assert(gconv.DurationString(500*time.Millisecond, gconv.DurationStyleLong), "500 milliseconds")
+		t.Assert(gconv.DurationString(time.Microsecond, gconv.DurationStyleLong), "1 microsecond")
+		t.Assert(gconv.DurationString(0, gconv.DurationStyleShort), "0s")
+		t.Assert(gconv.DurationString(0, gconv.DurationStyleLong), "0 seconds")
+	})
+}
+
+func TestParseDuration(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var cases = map[string]time.Duration{
+			"1h30m0s":               90 * time.Minute,
+			"1.5h":                  90 * time.Minute,
+			"3h":                    3 * time.Hour,
+			"100":                   100,
+			"2 days":                48 * time.Hour,
+			"2d3h":                  51 * time.Hour,
+			"-1d":                   -24 * time.Hour,
+			"1w 2d":                 9 * 24 * time.Hour,
+			"1.5 Weeks":             252 * time.Hour,
+			"1 hour 30 minutes":     90 * time.Minute,
+			"1 hour and 30 mins":    90 * time.Minute,
+			"3h, 20 min":            200 * time.Minute,
+			"90 minutes":            90 * time.Minute,
+			"500 milliseconds":      500 * time.Millisecond,
+			" 2 hrs 1 sec ":         2*time.Hour + time.Second,
+			"1 day 2 hours 1.5 sec": 26*time.Hour + 1500*time.Millisecond,
+		}
+		for s, expect := range cases {
+			d, err := gconv.ParseDuration(s)
+			t.AssertNil(err)
+			t.Assert(d, expect)
+		}
+		for _, s := range []string{"", "abc", "2 fortnights", "1h 2", "h", "1..5h", "200000 weeks"} {
+			_, err := gconv.ParseDuration(s)
+			t.AssertNE(err, nil)
+		}
+	})
+	// Round trip.
+	gtest.C(t, func(t *gtest.T) {
+		var durations = []time.Duration{
+			0, 1, 1500, time.Millisecond, 90 * time.Minute, 50*time.Hour + 1500*time.Millisecond,
+			-26 * time.Hour,
+		}
+		for _, style := range []gconv.DurationStyle{
+			gconv.DurationStyleDefault, gconv.DurationStyleShort, gconv.DurationStyleLong,
+		} {
+			for _, d := range durations {
+				parsed, err := gconv.ParseDuration(gconv.DurationString(d, style))
+				t.AssertNil(err)
+				t.Assert(parsed, d)
+			}
+		}
+	})
+}
+
+func TestScan_HumanDuration(t *testing.T) {
+	type Config struct {
+		Timeout  time.Duration
+		Interval *time.Duration
+		TTL      time.Duration `gconv:"ttl,default:1 week"`
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var config Config
+		err := gconv.Scan(g.Map{"timeout": "2 days", "interval": "1h 30m"}, &config)
+		t.AssertNil(err)
+		t.Assert(config.Timeout, 48*time.Hour)
+		t.Assert(*config.Interval, 90*time.Minute)
+		t.Assert(config.TTL, 7*24*time.Hour)
+		t.Assert(gconv.Duration("1.5 hours"), 90*time.Minute)
+	})
+	// The strings accepted by gtime.ParseDuration keep their results.
+	gtest.C(t, func(t *gtest.T) {
+		for _, s := range []string{"-1d2h", "1d2h", "-1.5h", "-0d2h", "1D"} {
+			expect, err := gtime.ParseDuration(s)
+			t.AssertNil(err)
+			t.Assert(gconv.Duration(s), expect)
+		}
+		t.Assert(gconv.Duration("-1d2h"), -26*time.Hour)
+		t.Assert(gconv.Duration("-0d2h"), 2*time.Hour)
+
+		var config Config
+		err := gconv.Scan(g.Map{"timeout": "-1d2h"}, &config)
+		t.AssertNil(err)
+		t.Assert(config.Timeout, -26*time.Hour)
+	})
+}
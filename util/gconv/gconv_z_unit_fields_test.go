@@ -0,0 +1,91 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+type FieldsBase struct {
+	Id int `json:"id" gconv:",required"`
+}
+
+type fieldsAddress struct {
+	City string `json:"city" dc:"City name"`
+}
+
+type fieldsUser struct {
+	FieldsBase
+	Name      string           `json:"name,omitempty" dc:"User name" gconv:",required"`
+	Age       *int             `c:"age,default:18"`
+	CreatedAt time.Time        `json:"created_at"`
+	Address   fieldsAddress    `json:"address"`
+	Backups   []*fieldsAddress `json:"backups"`
+	Parent    *fieldsUser      `json:"parent"`
+	secret    string
+}
+
+func TestFields(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		fields := gconv.Fields(fieldsUser{})
+		t.Assert(len(fields), 7)
+		t.Assert(fields[0].Name, "Id")
+		t.Assert(fields[0].Key, "id")
+		t.Assert(fields[0].Required, true)
+
+		t.Assert(fields[1].Name, "Name")
+		t.Assert(fields[1].Key, "name")
+		t.Assert(fields[1].Kind, reflect.String)
+		t.Assert(fields[1].Label, "User name")
+		t.Assert(fields[1].Required, true)
+		t.Assert(fields[1].Tag.Get("json"), "name,omitempty")
+		t.Assert(fields[1].Fields, nil)
+
+		t.Assert(fields[2].Name, "Age")
+		t.Assert(fields[2].Key, "age")
+		t.Assert(fields[2].Kind, reflect.Int)
+		t.Assert(fields[2].Type, reflect.TypeOf((*int)(nil)))
+		t.Assert(fields[2].Default, "18")
+		t.Assert(fields[2].HasDefault, true)
+		t.Assert(fields[2].Required, false)
+		t.Assert(fields[2].Options, map[string]string{"default": "18"})
+
+		t.Assert(fields[3].Kind, reflect.Struct)
+		t.Assert(fields[3].Fields, nil)
+
+		t.Assert(fields[4].Key, "address")
+		t.Assert(len(fields[4].Fields), 1)
+		t.Assert(fields[4].Fields[0].Key, "city")
+		t.Assert(fields[4].Fields[0].Label, "City name")
+
+		t.Assert(fields[5].Kind, reflect.Slice)
+		t.Assert(len(fields[5].Fields), 1)
+		t.Assert(fields[5].Fields[0].Name, "City")
+
+		// Self-referential attribute.
+		t.Assert(fields[6].Kind, reflect.Struct)
+		t.Assert(fields[6].Fields, nil)
+	})
+	// Cached and the other type forms.
+	gtest.C(t, func(t *gtest.T) {
+		fields1 := gconv.Fields(&fieldsUser{})
+		fields2 := gconv.Fields(reflect.TypeOf(fieldsUser{}))
+		t.Assert(len(fields1), 7)
+		t.Assert(&fields1[0], &fields2[0])
+	})
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gconv.Fields(1), nil)
+		t.Assert(gconv.Fields(nil), nil)
+		_, err := gconv.NewConverter().Fields([]int{})
+		t.AssertNE(err, nil)
+	})
+}
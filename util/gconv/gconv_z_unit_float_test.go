@@ -12,6 +12,8 @@ import (
 	"testing"
 
 	"github.com/gogf/gf/v2/container/gvar"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
 	"github.com/gogf/gf/v2/test/gtest"
 	"github.com/gogf/gf/v2/util/gconv"
 )
@@ -223,3 +225,93 @@ func TestFloats(t *testing.T) {
 		}
 	})
 }
+
+func TestFloat_SpecialString(t *testing.T) {
+	var (
+		infStrings = []string{
+			"Inf", "+Inf", "inf", "Infinity", "+Infinity", "infinity",
+		}
+		negativeInfStrings = []string{
+			"-Inf", "-inf", "-Infinity", "-infinity",
+		}
+		nanStrings = []string{
+			"NaN", "nan", "NAN",
+		}
+		converter = gconv.NewConverter()
+		strict    = gconv.FloatOption{Strict: true}
+	)
+	// Lenient mode in default.
+	gtest.C(t, func(t *gtest.T) {
+		for _, s := range infStrings {
+			t.Assert(math.IsInf(gconv.Float64(s), 1), true)
+			t.Assert(math.IsInf(float64(gconv.Float32(s)), 1), true)
+		}
+		for _, s := range negativeInfStrings {
+			t.Assert(math.IsInf(gconv.Float64(s), -1), true)
+			t.Assert(math.IsInf(float64(gconv.Float32(s)), -1), true)
+		}
+		for _, s := range nanStrings {
+			t.Assert(math.IsNaN(gconv.Float64(s)), true)
+			t.Assert(math.IsNaN(float64(gconv.Float32(s))), true)
+		}
+		t.Assert(gconv.Float64("1e10"), 1e10)
+		t.Assert(gconv.Float64("-1.5E-3"), -1.5e-3)
+	})
+	// Strict mode.
+	gtest.C(t, func(t *gtest.T) {
+		var specialStrings = append(append(infStrings, negativeInfStrings...), nanStrings...)
+		for _, s := range specialStrings {
+			v64, err := converter.Float64(s, strict)
+			t.Assert(gerror.Is(err, gconv.ErrSpecialFloat), true)
+			t.Assert(v64, 0)
+
+			v32, err := converter.Float32(s, strict)
+			t.Assert(gerror.Is(err, gconv.ErrSpecialFloat), true)
+			t.Assert(v32, 0)
+		}
+		v, err := converter.Float64("1e10", strict)
+		t.AssertNil(err)
+		t.Assert(v, 1e10)
+
+		// Overflow is not special float string.
+		_, err = converter.Float64("1e400", strict)
+		t.AssertNE(err, nil)
+		t.Assert(gerror.Is(err, gconv.ErrSpecialFloat), false)
+
+		// Float values are not checked.
+		v, err = converter.Float64(math.Inf(1), strict)
+		t.AssertNil(err)
+		t.Assert(math.IsInf(v, 1), true)
+	})
+}
+
+func TestScan_StrictFloat(t *testing.T) {
+	type Sample struct {
+		Value   float64
+		Ratio   *float32
+		Comment string
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var sample *Sample
+		err := gconv.Scan(g.Map{"value": "Infinity", "ratio": "NaN", "comment": "NaN"}, &sample)
+		t.AssertNil(err)
+		t.Assert(math.IsInf(sample.Value, 1), true)
+		t.Assert(math.IsNaN(float64(*sample.Ratio)), true)
+		t.Assert(sample.Comment, "NaN")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var sample *Sample
+		err := gconv.ScanWithOptions(g.Map{"value": "1e-5", "comment": "NaN"}, &sample, gconv.ScanOption{
+			StrictFloat: true,
+		})
+		t.AssertNil(err)
+		t.Assert(sample.Value, 1e-5)
+		t.Assert(sample.Comment, "NaN")
+
+		sample = nil
+		err = gconv.ScanWithOptions(g.Map{"value": 1, "ratio": "-Inf"}, &sample, gconv.ScanOption{
+			StrictFloat: true,
+		})
+		t.Assert(gerror.Is(err, gconv.ErrSpecialFloat), true)
+	})
+}
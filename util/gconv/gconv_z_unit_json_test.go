@@ -0,0 +1,260 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+type jsonUserInTest struct {
+	Id       int    `c:"uid" json:"id"`
+	Name     string `json:"name"`
+	Password string `json:"-"`
+	Address  *jsonAddressInTest
+	Tags     []jsonTagInTest `json:"tags"`
+}
+
+type jsonAddressInTest struct {
+	City string `json:"city"`
+}
+
+type jsonTagInTest struct {
+	Name string `json:"name"`
+}
+
+func TestJsonBytes(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		b, err := gconv.JsonBytes(jsonUserInTest{
+			Id:       1,
+			Name:     "john",
+			Password: "123",
+			Address:  &jsonAddressInTest{City: "Shanghai"},
+			Tags:     []jsonTagInTest{{Name: "vip"}},
+		})
+		t.AssertNil(err)
+		t.Assert(b, `{"Address":{"city":"Shanghai"},"name":"john","tags":[{"name":"vip"}],"uid":1}`)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		b, err := gconv.JsonBytes([]*jsonAddressInTest{{City: "Beijing"}, nil})
+		t.AssertNil(err)
+		t.Assert(b, `[{"city":"Beijing"},null]`)
+
+		b, err = gconv.JsonBytes(nil)
+		t.AssertNil(err)
+		t.Assert(b, `null`)
+
+		b, err = gconv.JsonBytes(g.Map{"k": 1})
+		t.AssertNil(err)
+		t.Assert(b, `{"k":1}`)
+	})
+	// Custom tags.
+	gtest.C(t, func(t *gtest.T) {
+		type User struct {
+			Id   int `orm:"user_id" json:"id"`
+			Name string
+		}
+		b, err := gconv.JsonBytes(User{Id: 1, Name: "john"}, gconv.MapOption{Tags: []string{"orm"}})
+		t.AssertNil(err)
+		t.Assert(b, `{"Name":"john","user_id":1}`)
+	})
+}
+
+func TestScanJson(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var user *jsonUserInTest
+		err := gconv.ScanJson(
+			[]byte(`{"uid":1,"name":"john","address":{"city":"Shanghai"},"tags":[{"name":"vip"}]}`),
+			&user,
+		)
+		t.AssertNil(err)
+		t.Assert(user.Id, 1)
+		t.Assert(user.Name, "john")
+		t.Assert(user.Address.City, "Shanghai")
+		t.Assert(len(user.Tags), 1)
+		t.Assert(user.Tags[0].Name, "vip")
+	})
+	// Round trip.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			src = []jsonUserInTest{{Id: 1, Name: "john"}, {Id: 2, Name: "smith"}}
+			dst []jsonUserInTest
+		)
+		b, err := gconv.JsonBytes(src)
+		t.AssertNil(err)
+		err = gconv.ScanJson(b, &dst)
+		t.AssertNil(err)
+		t.Assert(dst, src)
+	})
+	// Invalid JSON.
+	gtest.C(t, func(t *gtest.T) {
+		var user *jsonUserInTest
+		err := gconv.ScanJson([]byte(`{"uid":`), &user)
+		t.AssertNE(err, nil)
+	})
+}
+
+func TestConverter_JsonBytes_ScanJson(t *testing.T) {
+	type Order struct {
+		Id    int
+		Price decimalInTest `json:"price"`
+	}
+	var converter = gconv.NewConverter()
+	gtest.C(t, func(t *gtest.T) {
+		err := converter.RegisterTypeConverterFunc(func(s string) (*decimalInTest, error) {
+			r, ok := new(big.Rat).SetString(s)
+			if !ok {
+				return nil, errors.New("invalid decimal: " + s)
+			}
+			return &decimalInTest{value: r}, nil
+		})
+		t.AssertNil(err)
+		err = converter.RegisterTypeConverterFunc(func(d decimalInTest) (*string, error) {
+			s := d.String()
+			return &s, nil
+		})
+		t.AssertNil(err)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var order = Order{
+			Id:    1,
+			Price: decimalInTest{value: big.NewRat(12345, 100)},
+		}
+		b, err := converter.JsonBytes(order)
+		t.AssertNil(err)
+		t.Assert(b, `{"Id":1,"price":"123.4500"}`)
+
+		var decoded Order
+		err = converter.ScanJson(b, &decoded)
+		t.AssertNil(err)
+		t.Assert(decoded.Id, 1)
+		t.Assert(decoded.Price.String(), "123.4500")
+	})
+}
+
+func TestScanJson_NullAndAbsent(t *testing.T) {
+	type Address struct {
+		City string `json:"city"`
+	}
+	type UserPatch struct {
+		Name     *string `json:"name"`
+		Nickname *string
+		Age      *int
+		Address  *Address `json:"address"`
+	}
+	var (
+		name     = "john"
+		nickname = "jo"
+		age      = 18
+	)
+	// JSON null resets the attributes, and the attributes of absent keys are unchanged.
+	gtest.C(t, func(t *gtest.T) {
+		var patch = UserPatch{
+			Name:     &name,
+			Nickname: &nickname,
+			Age:      &age,
+			Address:  &Address{City: "Shanghai"},
+		}
+		err := gconv.ScanJson([]byte(`{"name":null,"nickname":null,"address":null}`), &patch)
+		t.AssertNil(err)
+		t.AssertNil(patch.Name)
+		t.AssertNil(patch.Nickname)
+		t.AssertNil(patch.Address)
+		t.Assert(*patch.Age, 18)
+	})
+	// Presence distinguishes JSON null from absent key for nil pointers.
+	gtest.C(t, func(t *gtest.T) {
+		var patch UserPatch
+		present, err := gconv.ScanJsonPresence(
+			[]byte(`{"name":"smith","nickname":null,"address":{"city":null}}`), &patch,
+		)
+		t.AssertNil(err)
+		t.Assert(*patch.Name, "smith")
+		t.AssertNil(patch.Nickname)
+		t.AssertNil(patch.Age)
+		t.Assert(patch.Address.City, "")
+		t.Assert(present, map[string]bool{
+			"Name":         true,
+			"Nickname":     true,
+			"Address":      true,
+			"Address.City": true,
+		})
+		t.Assert(present["Age"], false)
+	})
+	// OmitNil keeps the attributes for JSON null.
+	gtest.C(t, func(t *gtest.T) {
+		var patch = UserPatch{Nickname: &nickname}
+		err := gconv.ScanJson([]byte(`{"nickname":null}`), &patch, gconv.ScanOption{OmitNil: true})
+		t.AssertNil(err)
+		t.Assert(*patch.Nickname, "jo")
+	})
+}
+
+func TestScanJson_RawMessage(t *testing.T) {
+	type Payload struct {
+		Type    string          `json:"type"`
+		Body    json.RawMessage `json:"body"`
+		Items   json.RawMessage `json:"items"`
+		Extra   []byte          `json:"extra"`
+		Value   json.RawMessage `json:"value"`
+		Pointer *json.RawMessage
+	}
+	type Event struct {
+		Id      int
+		Payload *Payload
+		List    []Payload
+	}
+	var data = []byte(`{
+		"id": 1,
+		"payload": {
+			"type": "order",
+			"body": {"b": 1.50, "a": [1, {"x": null}]},
+			"items": [{"sku": "A1", "qty": 2}, 3, "s"],
+			"extra": {"k": "v"},
+			"value": "text",
+			"pointer": [1,2]
+		},
+		"list": [{"type": "user", "body": {"id": 9}}]
+	}`)
+	gtest.C(t, func(t *gtest.T) {
+		var event *Event
+		err := gconv.ScanJson(data, &event)
+		t.AssertNil(err)
+		t.Assert(event.Id, 1)
+		t.Assert(event.Payload.Type, "order")
+		t.Assert(string(event.Payload.Body), `{"b": 1.50, "a": [1, {"x": null}]}`)
+		t.Assert(string(event.Payload.Items), `[{"sku": "A1", "qty": 2}, 3, "s"]`)
+		t.Assert(string(event.Payload.Extra), `{"k": "v"}`)
+		t.Assert(string(event.Payload.Value), `"text"`)
+		t.Assert(string(*event.Payload.Pointer), `[1,2]`)
+		t.Assert(len(event.List), 1)
+		t.Assert(event.List[0].Type, "user")
+		t.Assert(string(event.List[0].Body), `{"id": 9}`)
+
+		// Deferred decoding of the raw sub-document.
+		var body map[string]any
+		t.AssertNil(json.Unmarshal(event.Payload.Body, &body))
+		t.Assert(body["b"], 1.5)
+	})
+	// Decoded source value.
+	gtest.C(t, func(t *gtest.T) {
+		var payload *Payload
+		err := gconv.Scan(g.Map{
+			"body":  g.Map{"id": 1},
+			"items": g.Slice{1, "a"},
+		}, &payload)
+		t.AssertNil(err)
+		t.Assert(string(payload.Body), `{"id":1}`)
+		t.Assert(string(payload.Items), `[1,"a"]`)
+	})
+}
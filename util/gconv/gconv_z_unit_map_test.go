@@ -7,10 +7,13 @@
 package gconv_test
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
 
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/frame/g"
 	"github.com/gogf/gf/v2/test/gtest"
 	"github.com/gogf/gf/v2/util/gconv"
@@ -376,3 +379,358 @@ func TestMaptoMapsExtra(t *testing.T) {
 		t.Assert(v, e)
 	})
 }
+
+func TestMapWithKeyStyle(t *testing.T) {
+	type Profile struct {
+		HTTPServer string
+		Extra      map[string]any
+	}
+	type User struct {
+		TAA      string
+		UserID   int
+		Nickname string `json:"nick_NAME"`
+		Profile  Profile
+		Profiles []Profile
+	}
+	user := User{
+		TAA:      "a",
+		UserID:   1,
+		Nickname: "john",
+		Profile: Profile{
+			HTTPServer: "s",
+			Extra:      g.Map{"tAA": 1},
+		},
+		Profiles: []Profile{{HTTPServer: "s", Extra: g.Map{"id": 1}}},
+	}
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gconv.MapWithKeyStyle(user, gconv.MapKeyStyleSnake), g.Map{
+			"taa":       "a",
+			"user_id":   1,
+			"nick_NAME": "john",
+			"profile":   g.Map{"http_server": "s", "extra": g.Map{"t_aa": 1}},
+			"profiles":  g.Slice{g.Map{"http_server": "s", "extra": g.Map{"id": 1}}},
+		})
+	})
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gconv.MapWithKeyStyle(user, gconv.MapKeyStyleCamel), g.Map{
+			"taa":       "a",
+			"userID":    1,
+			"nick_NAME": "john",
+			"profile":   g.Map{"httpServer": "s", "extra": g.Map{"tAA": 1}},
+			"profiles":  g.Slice{g.Map{"httpServer": "s", "extra": g.Map{"id": 1}}},
+		})
+	})
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gconv.MapWithKeyStyle(user, gconv.MapKeyStyleKebab), g.Map{
+			"taa":       "a",
+			"user-id":   1,
+			"nick_NAME": "john",
+			"profile":   g.Map{"http-server": "s", "extra": g.Map{"t-aa": 1}},
+			"profiles":  g.Slice{g.Map{"http-server": "s", "extra": g.Map{"id": 1}}},
+		})
+	})
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gconv.MapWithKeyStyle(user, gconv.MapKeyStylePascal), g.Map{
+			"TAA":       "a",
+			"UserID":    1,
+			"nick_NAME": "john",
+			"Profile":   g.Map{"HTTPServer": "s", "Extra": g.Map{"TAA": 1}},
+			"Profiles":  g.Slice{g.Map{"HTTPServer": "s", "Extra": g.Map{"Id": 1}}},
+		})
+	})
+	// Root map.
+	gtest.C(t, func(t *gtest.T) {
+		m := g.Map{"tAA": g.Map{"userName": 1}}
+		t.Assert(gconv.MapWithKeyStyle(m, gconv.MapKeyStyleSnake), g.Map{"t_aa": g.Map{"user_name": 1}})
+		t.Assert(gconv.MapWithKeyStyle(m, gconv.MapKeyStyleCamel), g.Map{"tAA": g.Map{"userName": 1}})
+		t.Assert(gconv.MapWithKeyStyle(m, gconv.MapKeyStyleKebab), g.Map{"t-aa": g.Map{"user-name": 1}})
+		t.Assert(gconv.MapWithKeyStyle(m, gconv.MapKeyStylePascal), g.Map{"TAA": g.Map{"UserName": 1}})
+	})
+	// Default style keeps the keys.
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gconv.MapWithKeyStyle(user, gconv.MapKeyStyleDefault), gconv.Map(user, gconv.MapOption{Deep: true}))
+	})
+}
+
+func TestFlatMap(t *testing.T) {
+	type ValTB struct {
+		Val  string
+		Tags []string
+	}
+	type tBB struct {
+		ValTB ValTB
+		Items []ValTB
+	}
+	type tAA struct {
+		Id  int
+		TBB *tBB
+	}
+	var aa = tAA{
+		Id: 1,
+		TBB: &tBB{
+			ValTB: ValTB{Val: "b", Tags: []string{"x", "y"}},
+			Items: []ValTB{{Val: "i0", Tags: []string{}}, {Val: "i1", Tags: []string{"z"}}},
+		},
+	}
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gconv.FlatMap(aa), g.Map{
+			"Id":                   1,
+			"TBB.ValTB.Val":        "b",
+			"TBB.ValTB.Tags[0]":    "x",
+			"TBB.ValTB.Tags[1]":    "y",
+			"TBB.Items[0].Val":     "i0",
+			"TBB.Items[0].Tags":    []string{},
+			"TBB.Items[1].Val":     "i1",
+			"TBB.Items[1].Tags[0]": "z",
+		})
+		t.Assert(gconv.FlatMap(g.Map{"a": g.Map{"b": []int{1, 2}}, "c": g.Map{}}), g.Map{
+			"a.b[0]": 1,
+			"a.b[1]": 2,
+			"c":      g.Map{},
+		})
+		t.Assert(gconv.FlatMap(nil), nil)
+	})
+	// Round trip.
+	gtest.C(t, func(t *gtest.T) {
+		var result *tAA
+		err := gconv.Scan(gconv.FlatMap(aa), &result)
+		t.AssertNil(err)
+		t.Assert(result, aa)
+	})
+	// Form data.
+	gtest.C(t, func(t *gtest.T) {
+		var result tAA
+		err := gconv.Scan(g.Map{
+			"id":               "2",
+			"tbb.valtb.val":    "v",
+			"tbb.items[1].val": "i1",
+			"tbb.items[0].val": "i0",
+		}, &result)
+		t.AssertNil(err)
+		t.Assert(result.Id, 2)
+		t.Assert(result.TBB.ValTB.Val, "v")
+		t.Assert(len(result.TBB.Items), 2)
+		t.Assert(result.TBB.Items[0].Val, "i0")
+		t.Assert(result.TBB.Items[1].Val, "i1")
+	})
+	// The huge index is rejected without allocation.
+	gtest.C(t, func(t *gtest.T) {
+		var result tAA
+		err := gconv.Scan(g.Map{
+			"id":                      "3",
+			"tbb.items[20000000].val": "huge",
+			"tbb.items[0][99999999]":  "huge",
+			"tbb.items[100000000000]": "huge",
+			"tbb.items[1].val":        "i1",
+		}, &result)
+		t.AssertNil(err)
+		t.Assert(result.Id, 3)
+		t.Assert(len(result.TBB.Items), 2)
+		t.Assert(result.TBB.Items[1].Val, "i1")
+	})
+	// The struct tag having char '.' is still supported.
+	gtest.C(t, func(t *gtest.T) {
+		var result struct {
+			Name string `json:"user.name"`
+		}
+		err := gconv.Scan(g.Map{"user.name": "john"}, &result)
+		t.AssertNil(err)
+		t.Assert(result.Name, "john")
+	})
+}
+
+type mapMethodsUser struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Age       int    `json:"age"`
+}
+
+func (u mapMethodsUser) FullName() string {
+	return u.FirstName + " " + u.LastName
+}
+
+func (u *mapMethodsUser) IsAdult() (bool, error) {
+	if u.Age < 0 {
+		return false, errors.New("invalid age")
+	}
+	return u.Age >= 18, nil
+}
+
+func (u mapMethodsUser) Profile() SubMapTest {
+	return SubMapTest{Name: u.FirstName}
+}
+
+func (u mapMethodsUser) Greet(name string) string {
+	return "hello " + name
+}
+
+func TestMapWithMethods(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		user := mapMethodsUser{FirstName: "john", LastName: "smith", Age: 20}
+		m := gconv.MapWithMethods(user, []string{"FullName:full_name", "IsAdult"})
+		t.Assert(m, g.Map{
+			"first_name": "john",
+			"last_name":  "smith",
+			"age":        20,
+			"full_name":  "john smith",
+			"IsAdult":    true,
+		})
+		m = gconv.MapWithMethods(&user, []string{"FullName", "IsAdult"}, gconv.MapOption{
+			KeyStyle: gconv.MapKeyStyleSnake,
+		})
+		t.Assert(m["full_name"], "john smith")
+		t.Assert(m["is_adult"], true)
+	})
+	// Deep converting of method result.
+	gtest.C(t, func(t *gtest.T) {
+		user := mapMethodsUser{FirstName: "john"}
+		m := gconv.MapWithMethods(user, []string{"Profile"}, gconv.MapOption{Deep: true})
+		t.Assert(m["Profile"], g.Map{"Name": "john"})
+	})
+	// Invalid methods are ignored.
+	gtest.C(t, func(t *gtest.T) {
+		user := mapMethodsUser{FirstName: "john", Age: -1}
+		m := gconv.MapWithMethods(user, []string{"Greet", "NotExist", "IsAdult", "FullName"})
+		t.Assert(len(m), 4)
+		t.Assert(m["FullName"], "john ")
+	})
+	// Errors.
+	gtest.C(t, func(t *gtest.T) {
+		user := mapMethodsUser{FirstName: "john", Age: -1}
+		_, err := gconv.NewConverter().MapWithMethods(user, []string{"IsAdult"})
+		t.Assert(err.Error(), `call method "IsAdult" failed: invalid age`)
+		_, err = gconv.NewConverter().MapWithMethods(user, []string{"Greet"})
+		t.AssertNE(err, nil)
+		_, err = gconv.NewConverter().MapWithMethods(user, []string{"NotExist"})
+		t.AssertNE(err, nil)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var user *mapMethodsUser
+		t.Assert(gconv.MapWithMethods(user, []string{"FullName"}), nil)
+	})
+}
+
+func TestMap_Error(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		err := gerror.NewCode(gcode.CodeInvalidParameter, "invalid name")
+		m := gconv.Map(err)
+		t.Assert(len(m), 2)
+		t.Assert(m["code"], gcode.CodeInvalidParameter.Code())
+		t.Assert(m["message"], "invalid name")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		m := gconv.Map(errors.New("not found"))
+		t.Assert(m, g.Map{"code": -1, "message": "not found"})
+	})
+	// Stack.
+	gtest.C(t, func(t *gtest.T) {
+		err := gerror.New("failed")
+		m := gconv.Map(err, gconv.MapOption{ErrorStack: true})
+		t.Assert(len(m), 3)
+		t.Assert(m["stack"], gerror.Stack(err))
+		m = gconv.Map(errors.New("failed"), gconv.MapOption{ErrorStack: true})
+		t.Assert(len(m), 2)
+	})
+	// Attribute of struct.
+	gtest.C(t, func(t *gtest.T) {
+		type Result struct {
+			Name  string
+			Error error
+		}
+		result := Result{
+			Name:  "john",
+			Error: gerror.NewCode(gcode.CodeNotFound, "user not found"),
+		}
+		m := gconv.Map(result, gconv.MapOption{Deep: true})
+		t.Assert(m["Name"], "john")
+		t.Assert(m["Error"], g.Map{"code": gcode.CodeNotFound.Code(), "message": "user not found"})
+
+		m = gconv.MapDeep(g.Map{"error": errors.New("failed")})
+		t.Assert(m["error"], g.Map{"code": -1, "message": "failed"})
+	})
+	// Key style.
+	gtest.C(t, func(t *gtest.T) {
+		m := gconv.Map(errors.New("failed"), gconv.MapOption{KeyStyle: gconv.MapKeyStylePascal})
+		t.Assert(m, g.Map{"Code": -1, "Message": "failed"})
+	})
+}
+
+type mapKeyColor int
+
+func (c mapKeyColor) String() string {
+	return []string{"red", "green"}[c]
+}
+
+type mapKeyId int64
+
+func TestMapToMap_TypedKeys(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var m map[int64]string
+		err := gconv.MapToMap(map[int]int{1: 2, 3: 4}, &m)
+		t.AssertNil(err)
+		t.Assert(m, map[int64]string{1: "2", 3: "4"})
+	})
+	gtest.C(t, func(t *gtest.T) {
+		type User struct {
+			Name string
+		}
+		var m map[int64]*User
+		err := gconv.MapToMap(map[int]g.Map{1: {"name": "john"}}, &m)
+		t.AssertNil(err)
+		t.Assert(m[1].Name, "john")
+	})
+	// Custom key types.
+	gtest.C(t, func(t *gtest.T) {
+		var m1 map[mapKeyId]int
+		err := gconv.MapToMap(map[string]string{"1": "2"}, &m1)
+		t.AssertNil(err)
+		t.Assert(m1[mapKeyId(1)], 2)
+
+		var m2 map[string]int
+		err = gconv.MapToMap(map[mapKeyColor]string{0: "1", 1: "2"}, &m2)
+		t.AssertNil(err)
+		t.Assert(m2, g.MapStrInt{"red": 1, "green": 2})
+
+		var m3 map[float64]bool
+		err = gconv.MapToMap(map[any]any{"1.5": 1, 2: 0}, &m3)
+		t.AssertNil(err)
+		t.Assert(m3, map[float64]bool{1.5: true, 2: false})
+	})
+	// Invalid keys.
+	gtest.C(t, func(t *gtest.T) {
+		var m1 map[uint8]int
+		t.AssertNE(gconv.MapToMap(map[int]int{300: 1}, &m1), nil)
+		t.AssertNE(gconv.MapToMap(map[int]int{-1: 1}, &m1), nil)
+
+		var m2 map[int]int
+		t.AssertNE(gconv.MapToMap(map[string]int{"x": 1}, &m2), nil)
+
+		var m3 map[struct{ X int }]int
+		t.AssertNE(gconv.MapToMap(map[string]int{"x": 1}, &m3), nil)
+	})
+}
+
+func TestScan_TypedMapKeys(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		type Item struct {
+			First  string `json:"1"`
+			Second int    `json:"2"`
+		}
+		var item Item
+		err := gconv.Scan(map[int]string{1: "a", 2: "3"}, &item)
+		t.AssertNil(err)
+		t.Assert(item.First, "a")
+		t.Assert(item.Second, 3)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		type Palette struct {
+			Red   string
+			Green int
+		}
+		var palette Palette
+		err := gconv.Scan(map[mapKeyColor]any{0: "#f00", 1: "255"}, &palette)
+		t.AssertNil(err)
+		t.Assert(palette.Red, "#f00")
+		t.Assert(palette.Green, 255)
+	})
+}
@@ -0,0 +1,162 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+func TestPairs(t *testing.T) {
+	type Base struct {
+		AppId string `json:"app_id"`
+		Nonce string `json:"nonce"`
+	}
+	type Address struct {
+		City string `json:"city"`
+		Zip  string `json:"zip,omitempty"`
+	}
+	type Request struct {
+		Base
+		Timestamp int       `json:"timestamp"`
+		Amount    float64   `json:"amount"`
+		Remark    string    `json:"remark,omitempty"`
+		Secret    string    `json:"-"`
+		Address   Address   `json:"address"`
+		CreatedAt time.Time `json:"created_at"`
+		private   string
+	}
+	var (
+		createdAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		req       = &Request{
+			Base:      Base{AppId: "app", Nonce: "abc"},
+			Timestamp: 1700000000,
+			Amount:    9.9,
+			Secret:    "secret",
+			Address:   Address{City: "Beijing"},
+			CreatedAt: createdAt,
+			private:   "private",
+		}
+	)
+	// Declaration order.
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gconv.Pairs(req, false), []gconv.Pair{
+			{Key: "app_id", Value: "app"},
+			{Key: "nonce", Value: "abc"},
+			{Key: "timestamp", Value: 1700000000},
+			{Key: "amount", Value: 9.9},
+			{Key: "address", Value: Address{City: "Beijing"}},
+			{Key: "created_at", Value: createdAt},
+		})
+	})
+	// Sorted order.
+	gtest.C(t, func(t *gtest.T) {
+		var keys []string
+		for _, pair := range gconv.Pairs(req, true) {
+			keys = append(keys, pair.Key)
+		}
+		t.Assert(keys, []string{"address", "amount", "app_id", "created_at", "nonce", "timestamp"})
+	})
+	// Nested struct with dotted keys.
+	gtest.C(t, func(t *gtest.T) {
+		req.Address.Zip = "100000"
+		t.Assert(gconv.Pairs(req, true, gconv.PairsOption{Nested: true}), []gconv.Pair{
+			{Key: "address.city", Value: "Beijing"},
+			{Key: "address.zip", Value: "100000"},
+			{Key: "amount", Value: 9.9},
+			{Key: "app_id", Value: "app"},
+			{Key: "created_at", Value: createdAt},
+			{Key: "nonce", Value: "abc"},
+			{Key: "timestamp", Value: 1700000000},
+		})
+	})
+	// Custom tags.
+	gtest.C(t, func(t *gtest.T) {
+		type User struct {
+			Id   int    `sign:"uid" json:"id"`
+			Name string `json:"name"`
+		}
+		t.Assert(gconv.Pairs(User{Id: 1, Name: "john"}, false, gconv.PairsOption{Tags: []string{"sign"}}), []gconv.Pair{
+			{Key: "uid", Value: 1},
+			{Key: "name", Value: "john"},
+		})
+	})
+	// Map is always sorted.
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gconv.Pairs(g.Map{"b": 2, "a": 1, "c": 3}, false), []gconv.Pair{
+			{Key: "a", Value: 1},
+			{Key: "b", Value: 2},
+			{Key: "c", Value: 3},
+		})
+	})
+	// Invalid value.
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gconv.Pairs(nil, false), nil)
+		t.Assert(gconv.Pairs(1, false), nil)
+		_, err := gconv.NewConverter().Pairs(1, false)
+		t.AssertNE(err, nil)
+	})
+}
+
+func TestSortedPairs(t *testing.T) {
+	var config = g.Map{
+		"server": g.Map{
+			"port":    8000,
+			"address": "127.0.0.1",
+		},
+		"name": "demo",
+		"upstreams": g.Slice{
+			g.Map{"weight": 1, "host": "a"},
+			"b",
+		},
+	}
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gconv.SortedPairs(config), []gconv.Pair{
+			{Key: "name", Value: "demo"},
+			{Key: "server", Value: []gconv.Pair{
+				{Key: "address", Value: "127.0.0.1"},
+				{Key: "port", Value: 8000},
+			}},
+			{Key: "upstreams", Value: g.Slice{
+				[]gconv.Pair{
+					{Key: "host", Value: "a"},
+					{Key: "weight", Value: 1},
+				},
+				"b",
+			}},
+		})
+	})
+	// Round trip.
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gconv.PairsToMap(gconv.SortedPairs(config)), config)
+		t.Assert(gconv.PairsToMap(nil), nil)
+	})
+	// Nested struct.
+	gtest.C(t, func(t *gtest.T) {
+		type Server struct {
+			Port    int    `json:"port"`
+			Address string `json:"address"`
+		}
+		t.Assert(gconv.SortedPairs(g.Map{"server": &Server{Port: 80}}), []gconv.Pair{
+			{Key: "server", Value: []gconv.Pair{
+				{Key: "address", Value: ""},
+				{Key: "port", Value: 80},
+			}},
+		})
+	})
+	// Invalid value.
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gconv.SortedPairs(nil), nil)
+		t.Assert(gconv.SortedPairs(1), nil)
+		_, err := gconv.NewConverter().SortedPairs(1)
+		t.AssertNE(err, nil)
+	})
+}
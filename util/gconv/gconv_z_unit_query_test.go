@@ -0,0 +1,106 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+type queryPage struct {
+	Num  int `json:"num"`
+	Size int `json:"size,omitempty"`
+}
+
+type queryItem struct {
+	Name string `json:"name"`
+}
+
+type queryParams struct {
+	Keyword string      `json:"q"`
+	Tags    []string    `json:"tags"`
+	Page    queryPage   `json:"page"`
+	Items   []queryItem `json:"items"`
+	Owner   *string     `json:"owner"`
+	Hidden  string      `json:"-"`
+}
+
+func TestQueryString(t *testing.T) {
+	params := queryParams{
+		Keyword: "go frame&more",
+		Tags:    []string{"a", "b"},
+		Page:    queryPage{Num: 2},
+		Items:   []queryItem{{Name: "x"}, {Name: "y"}},
+		Hidden:  "hidden",
+	}
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(
+			gconv.QueryString(params),
+			"items%5B0%5D.name=x&items%5B1%5D.name=y&page.num=2&page.size=0&q=go+frame%26more&tags=a&tags=b",
+		)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		option := gconv.QueryOption{MapOption: gconv.MapOption{OmitEmpty: true}}
+		t.Assert(
+			gconv.QueryString(queryPage{Num: 1}, option),
+			"num=1",
+		)
+		option.SliceStyle = gconv.QuerySliceStyleBracket
+		t.Assert(gconv.QueryString(g.Map{"tags": g.SliceStr{"a", "b"}}, option), "tags%5B%5D=a&tags%5B%5D=b")
+		option.SliceStyle = gconv.QuerySliceStyleIndex
+		t.Assert(gconv.QueryString(g.Map{"tags": g.SliceStr{"a", "b"}}, option), "tags%5B0%5D=a&tags%5B1%5D=b")
+		option.SliceStyle = gconv.QuerySliceStyleComma
+		t.Assert(gconv.QueryString(g.Map{"tags": g.SliceStr{"a", "b"}}, option), "tags=a%2Cb")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gconv.QueryString(nil), "")
+		_, err := gconv.NewConverter().QueryString(1)
+		t.AssertNE(err, nil)
+	})
+}
+
+func TestScanQuery(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			owner  = "john"
+			params = queryParams{
+				Keyword: "go frame&more",
+				Tags:    []string{"a", "b"},
+				Page:    queryPage{Num: 2, Size: 10},
+				Items:   []queryItem{{Name: "x"}, {Name: "y"}},
+				Owner:   &owner,
+			}
+			result *queryParams
+		)
+		t.AssertNil(gconv.ScanQuery(gconv.QueryString(params), &result))
+		t.Assert(result, params)
+
+		var bracket *queryParams
+		t.AssertNil(gconv.ScanQuery(
+			gconv.QueryString(params, gconv.QueryOption{SliceStyle: gconv.QuerySliceStyleBracket}), &bracket,
+		))
+		t.Assert(bracket.Tags, params.Tags)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var result *queryParams
+		t.AssertNil(gconv.ScanQuery("?q=go&tags=a&page.num=3&tags[]=b", &result))
+		t.Assert(result.Keyword, "go")
+		t.Assert(result.Tags, g.SliceStr{"a", "b"})
+		t.Assert(result.Page.Num, 3)
+
+		var single *queryParams
+		t.AssertNil(gconv.ScanQuery("tags=a", &single))
+		t.Assert(single.Tags, g.SliceStr{"a"})
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var result *queryParams
+		t.AssertNE(gconv.ScanQuery("q=%zz", &result), nil)
+	})
+}
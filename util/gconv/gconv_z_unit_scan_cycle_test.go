@@ -0,0 +1,167 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+type cycleNode struct {
+	Name string
+	Prev *cycleNode
+	Next *cycleNode
+}
+
+type cycleNodeDTO struct {
+	Name string
+	Prev *cycleNodeDTO
+	Next *cycleNodeDTO
+}
+
+type cycleTree struct {
+	Name     string
+	Parent   *cycleTree
+	Children []*cycleTree
+}
+
+type cycleTreeDTO struct {
+	Name     string
+	Parent   *cycleTreeDTO
+	Children []*cycleTreeDTO
+}
+
+func newCycleNodes() *cycleNode {
+	var (
+		a = &cycleNode{Name: "a"}
+		b = &cycleNode{Name: "b"}
+		c = &cycleNode{Name: "c"}
+	)
+	a.Next, b.Prev = b, a
+	b.Next, c.Prev = c, b
+	return a
+}
+
+func TestScan_Cycle(t *testing.T) {
+	// Doubly-linked nodes.
+	gtest.C(t, func(t *gtest.T) {
+		var dst *cycleNodeDTO
+		t.AssertNil(gconv.Scan(newCycleNodes(), &dst))
+		t.Assert(dst.Name, "a")
+		t.Assert(dst.Next.Name, "b")
+		t.Assert(dst.Next.Next.Name, "c")
+		t.Assert(dst.Prev == nil, true)
+		t.Assert(dst.Next.Prev == dst, true)
+		t.Assert(dst.Next.Next.Prev == dst.Next, true)
+		t.Assert(dst.Next.Next.Next == nil, true)
+	})
+	// Self reference.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			src = &cycleNode{Name: "self"}
+			dst = new(cycleNodeDTO)
+		)
+		src.Prev, src.Next = src, src
+		t.AssertNil(gconv.Struct(src, dst))
+		t.Assert(dst.Name, "self")
+		t.Assert(dst.Prev == dst, true)
+		t.Assert(dst.Next == dst, true)
+	})
+	// Cycles through slice elements.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			root = &cycleTree{Name: "root"}
+			dst  *cycleTreeDTO
+		)
+		root.Children = []*cycleTree{
+			{Name: "child1", Parent: root},
+			{Name: "child2", Parent: root},
+			root,
+		}
+		t.AssertNil(gconv.Scan(root, &dst))
+		t.Assert(len(dst.Children), 3)
+		t.Assert(dst.Children[0].Name, "child1")
+		t.Assert(dst.Children[0].Parent == dst, true)
+		t.Assert(dst.Children[1].Name, "child2")
+		t.Assert(dst.Children[1].Parent == dst, true)
+		t.Assert(dst.Children[2] == dst, true)
+	})
+}
+
+func TestScan_SharedReference(t *testing.T) {
+	type Pair struct {
+		First  *cycleNode
+		Second *cycleNode
+	}
+	type PairDTO struct {
+		First  *cycleNodeDTO
+		Second *cycleNodeDTO
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			shared = &cycleNode{Name: "shared"}
+			dst    *PairDTO
+		)
+		t.AssertNil(gconv.Scan(&Pair{First: shared, Second: shared}, &dst))
+		t.Assert(dst.First.Name, "shared")
+		t.Assert(dst.First == dst.Second, true)
+	})
+	// The non-pointer source has no reference to be shared.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			shared = &cycleNode{Name: "shared"}
+			dst    *PairDTO
+		)
+		t.AssertNil(gconv.Scan(Pair{First: shared, Second: shared}, &dst))
+		t.Assert(dst.First.Name, "shared")
+		t.Assert(dst.Second.Name, "shared")
+	})
+}
+
+func TestMap_DeepCycle(t *testing.T) {
+	var isSameMap = func(a, b any) bool {
+		return reflect.ValueOf(a).Pointer() == reflect.ValueOf(b).Pointer()
+	}
+	// Doubly-linked nodes.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			m     = gconv.Map(newCycleNodes(), gconv.MapOption{Deep: true})
+			mNext = m["Next"].(map[string]any)
+		)
+		t.Assert(m["Name"], "a")
+		t.Assert(m["Prev"], nil)
+		t.Assert(mNext["Name"], "b")
+		t.Assert(isSameMap(mNext["Prev"], m), true)
+		t.Assert(isSameMap(mNext["Next"].(map[string]any)["Prev"], mNext), true)
+	})
+	// Shared references.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			shared = &cycleNode{Name: "shared"}
+			m      = gconv.Map(map[string]any{
+				"first":  shared,
+				"second": shared,
+			}, gconv.MapOption{Deep: true})
+		)
+		t.Assert(m["first"].(map[string]any)["Name"], "shared")
+		t.Assert(isSameMap(m["first"], m["second"]), true)
+	})
+	// Self-referencing map.
+	gtest.C(t, func(t *gtest.T) {
+		var src = map[string]any{"name": "self"}
+		src["self"] = src
+		var (
+			m    = gconv.Map(map[string]any{"src": src}, gconv.MapOption{Deep: true})
+			mSrc = m["src"].(map[string]any)
+		)
+		t.Assert(mSrc["name"], "self")
+		t.Assert(isSameMap(mSrc["self"], mSrc), true)
+	})
+}
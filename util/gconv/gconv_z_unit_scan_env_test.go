@@ -0,0 +1,110 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+type scanEnvServer struct {
+	Port        int
+	MaxBodySize int64
+	Debug       bool `json:"dbg"`
+}
+
+type scanEnvConfig struct {
+	Name   string
+	Server scanEnvServer
+	Cache  *struct {
+		Host string
+	}
+}
+
+func TestScanEnv(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var config *scanEnvConfig
+		err := gconv.ScanEnv(map[string]string{
+			"APP_NAME":                 "demo",
+			"APP_SERVER_PORT":          "8000",
+			"APP_SERVER_MAX_BODY_SIZE": "1024",
+			"APP_SERVER_DBG":           "true",
+			"APP_CACHE_HOST":           "127.0.0.1",
+			"PATH":                     "/usr/bin",
+		}, &config, gconv.ScanEnvOption{Prefix: "APP"})
+		t.AssertNil(err)
+		t.Assert(config.Name, "demo")
+		t.Assert(config.Server.Port, 8000)
+		t.Assert(config.Server.MaxBodySize, 1024)
+		t.Assert(config.Server.Debug, true)
+		t.Assert(config.Cache.Host, "127.0.0.1")
+	})
+	// Custom separator.
+	gtest.C(t, func(t *gtest.T) {
+		var config scanEnvConfig
+		err := gconv.ScanEnv(map[string]string{
+			"app.server.port": "80",
+		}, &config, gconv.ScanEnvOption{Prefix: "app", Separator: "."})
+		t.AssertNil(err)
+		t.Assert(config.Server.Port, 80)
+	})
+	// Unknown keys.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			config scanEnvConfig
+			srcMap = map[string]string{
+				"SERVER_PORT":    "80",
+				"SERVER_UNKNOWN": "1",
+			}
+		)
+		err := gconv.ScanEnv(srcMap, &config)
+		t.AssertNE(err, nil)
+
+		err = gconv.ScanEnv(srcMap, &config, gconv.ScanEnvOption{IgnoreUnknown: true})
+		t.AssertNil(err)
+		t.Assert(config.Server.Port, 80)
+	})
+}
+
+func TestScanEnv_Slice(t *testing.T) {
+	type Upstream struct {
+		Host string
+	}
+	type Config struct {
+		Hosts     []string
+		Ports     []int
+		Upstreams []*Upstream
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var config Config
+		err := gconv.ScanEnv(map[string]string{
+			"APP_HOSTS_10":          "c",
+			"APP_HOSTS_2":           "b",
+			"APP_HOSTS_0":           "a",
+			"APP_PORTS":             "80,443",
+			"APP_UPSTREAMS_1_HOST":  "h2",
+			"APP_UPSTREAMS_0_HOST":  "h1",
+			"APP_UPSTREAMS_X_HOST":  "x",
+			"APP_UPSTREAMS_0_OTHER": "y",
+		}, &config, gconv.ScanEnvOption{Prefix: "APP", SliceSeparator: ",", IgnoreUnknown: true})
+		t.AssertNil(err)
+		t.Assert(config.Hosts, []string{"a", "b", "c"})
+		t.Assert(config.Ports, []int{80, 443})
+		t.Assert(len(config.Upstreams), 2)
+		t.Assert(config.Upstreams[0].Host, "h1")
+		t.Assert(config.Upstreams[1].Host, "h2")
+	})
+	// The value is not split without separator.
+	gtest.C(t, func(t *gtest.T) {
+		var config Config
+		err := gconv.ScanEnv(map[string]string{"HOSTS": "a,b"}, &config)
+		t.AssertNil(err)
+		t.Assert(config.Hosts, []string{"a,b"})
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+func TestScan_FieldNameTransformer(t *testing.T) {
+	pluralize := func(fieldName string) string {
+		return gstr.CaseSnake(fieldName) + "s"
+	}
+	type Item struct {
+		Name string
+	}
+	type Collection struct {
+		Tag      []string
+		Score    []int
+		Item     []Item
+		Category []string `json:"category"`
+		Owner    string
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var collection *Collection
+		err := gconv.ScanWithOptions(g.Map{
+			"tags":       g.Slice{"go", "web"},
+			"scores":     g.Slice{"1", 2},
+			"items":      g.Slice{g.Map{"names": "a"}, g.Map{"names": "b"}},
+			"categorys":  g.Slice{"ignored"},
+			"category":   g.Slice{"tool"},
+			"owner":      "john",
+			"tag":        g.Slice{"ignored"},
+			"extra_tags": g.Slice{"ignored"},
+		}, &collection, gconv.ScanOption{
+			FieldNameTransformer: pluralize,
+		})
+		t.AssertNil(err)
+		t.Assert(collection.Tag, g.SliceStr{"go", "web"})
+		t.Assert(collection.Score, g.SliceInt{1, 2})
+		// The transformer is applied to the nested structs.
+		t.Assert(collection.Item, []Item{{Name: "a"}, {Name: "b"}})
+		// The tag name wins.
+		t.Assert(collection.Category, g.SliceStr{"tool"})
+		// The attribute name is still matched if the derived key is absent.
+		t.Assert(collection.Owner, "john")
+	})
+	// Slice of structs.
+	gtest.C(t, func(t *gtest.T) {
+		var collections []Collection
+		err := gconv.ScanWithOptions(g.Slice{
+			g.Map{"tags": g.Slice{"a"}},
+			g.Map{"tags": g.Slice{"b"}},
+		}, &collections, gconv.ScanOption{
+			FieldNameTransformer: pluralize,
+		})
+		t.AssertNil(err)
+		t.Assert(len(collections), 2)
+		t.Assert(collections[0].Tag, g.SliceStr{"a"})
+		t.Assert(collections[1].Tag, g.SliceStr{"b"})
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+type scanMergeServerInTest struct {
+	Host    string
+	Port    int
+	Timeout time.Duration
+}
+
+type scanMergeConfigInTest struct {
+	Name     string
+	Debug    bool
+	Server   scanMergeServerInTest
+	Database *scanMergeServerInTest
+	Tags     []string
+	Labels   map[string]string
+	Started  time.Time
+}
+
+func TestScanMerge(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			defaults = scanMergeConfigInTest{
+				Name:     "app",
+				Server:   scanMergeServerInTest{Host: "127.0.0.1", Port: 8000, Timeout: time.Second},
+				Database: &scanMergeServerInTest{Host: "db", Port: 3306},
+				Tags:     []string{"a"},
+				Labels:   map[string]string{"env": "dev", "team": "core"},
+			}
+			file = g.Map{
+				"server":   g.Map{"port": 8080},
+				"database": g.Map{"port": 3307},
+				"tags":     g.Slice{"b", "c"},
+				"labels":   g.Map{"env": "prod"},
+			}
+			flags  = g.Map{"debug": true, "started": "2024-01-01 00:00:00"}
+			config *scanMergeConfigInTest
+		)
+		err := gconv.ScanMerge(&config, defaults, nil, file, flags)
+		t.AssertNil(err)
+		t.Assert(config.Name, "app")
+		t.Assert(config.Debug, true)
+		t.Assert(config.Server, scanMergeServerInTest{Host: "127.0.0.1", Port: 8080, Timeout: time.Second})
+		t.Assert(config.Database, &scanMergeServerInTest{Host: "db", Port: 3307})
+		t.Assert(config.Tags, []string{"b", "c"})
+		t.Assert(config.Labels, map[string]string{"env": "prod", "team": "core"})
+		t.Assert(config.Started.Format("2006-01-02"), "2024-01-01")
+
+		// The sources are not changed.
+		t.Assert(defaults.Database.Port, 3306)
+		t.Assert(defaults.Labels["env"], "dev")
+	})
+	// Current attribute values as the lowest layer.
+	gtest.C(t, func(t *gtest.T) {
+		var config = scanMergeConfigInTest{Name: "origin", Debug: true}
+		err := gconv.ScanMerge(&config, g.Map{"name": "new"}, g.Map{"debug": false})
+		t.AssertNil(err)
+		t.Assert(config.Name, "new")
+		t.Assert(config.Debug, true)
+	})
+}
+
+func TestScanMergeWithOptions(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var config *scanMergeConfigInTest
+		err := gconv.ScanMergeWithOptions(&config, gconv.ScanMergeOption{
+			ReplaceNested: true,
+			AppendSlice:   true,
+		},
+			g.Map{
+				"server":   g.Map{"host": "127.0.0.1", "port": 8000},
+				"database": g.Map{"host": "db", "port": 3306},
+				"tags":     g.Slice{"a"},
+				"labels":   g.Map{"env": "dev", "team": "core"},
+			},
+			g.Map{
+				"server":   g.Map{"port": 8080},
+				"database": g.Map{"port": 3307},
+				"tags":     g.Slice{"b"},
+				"labels":   g.Map{"env": "prod"},
+			},
+		)
+		t.AssertNil(err)
+		t.Assert(config.Server, scanMergeServerInTest{Port: 8080})
+		t.Assert(config.Database, &scanMergeServerInTest{Port: 3307})
+		t.Assert(config.Tags, []string{"a", "b"})
+		t.Assert(config.Labels, map[string]string{"env": "prod"})
+	})
+	// Error.
+	gtest.C(t, func(t *gtest.T) {
+		var config = scanMergeConfigInTest{Name: "origin"}
+		err := gconv.ScanMergeWithOptions(&config, gconv.ScanMergeOption{},
+			g.Map{"name": "new"},
+			g.Map{"server": g.Map{"port": "invalid"}},
+		)
+		t.AssertNE(err, nil)
+		t.Assert(config.Name, "origin")
+
+		t.AssertNE(gconv.ScanMerge(config, g.Map{"name": "new"}), nil)
+		t.AssertNE(gconv.ScanMerge(nil, g.Map{"name": "new"}), nil)
+		var s string
+		t.AssertNE(gconv.ScanMerge(&s, g.Map{"name": "new"}), nil)
+	})
+}
@@ -0,0 +1,87 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+type setFieldAccount struct {
+	id     int
+	email  string
+	Status int
+	Remark string
+}
+
+func (a *setFieldAccount) SetField(name string, value any) (handled bool, err error) {
+	switch name {
+	case "id":
+		a.id = gconv.Int(value)
+		return true, nil
+	case "email":
+		email := gconv.String(value)
+		if !strings.Contains(email, "@") {
+			return true, errors.New("invalid email")
+		}
+		a.email = strings.ToLower(email)
+		return true, nil
+	case "Remark":
+		a.Remark = "remark: " + gconv.String(value)
+		return true, nil
+	}
+	return false, nil
+}
+
+func TestScan_SetField(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var account *setFieldAccount
+		err := gconv.Scan(g.Map{
+			"id":     1,
+			"email":  "John@Example.com",
+			"status": 2,
+			"Remark": "vip",
+		}, &account)
+		t.AssertNil(err)
+		t.Assert(account.id, 1)
+		t.Assert(account.email, "john@example.com")
+		// Unhandled fields fall back to the reflection assignment.
+		t.Assert(account.Status, 2)
+		t.Assert(account.Remark, "remark: vip")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var accounts []setFieldAccount
+		err := gconv.Scan(g.Slice{
+			g.Map{"id": 1, "email": "a@b.com"},
+			g.Map{"id": 2, "email": "c@d.com", "status": 1},
+		}, &accounts)
+		t.AssertNil(err)
+		t.Assert(len(accounts), 2)
+		t.Assert(accounts[0].id, 1)
+		t.Assert(accounts[1].email, "c@d.com")
+		t.Assert(accounts[1].Status, 1)
+	})
+	// Error of setter.
+	gtest.C(t, func(t *gtest.T) {
+		var account setFieldAccount
+		err := gconv.ScanWithOptions(g.Map{"id": 1, "email": "invalid"}, &account, gconv.ScanOption{})
+		t.AssertNE(err, nil)
+		t.Assert(strings.Contains(err.Error(), "invalid email"), true)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var account setFieldAccount
+		err := gconv.Scan(g.Map{"id": 1, "email": "invalid"}, &account)
+		t.AssertNil(err)
+		t.Assert(account.id, 1)
+		t.Assert(account.email, "")
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+type snapshotNode struct {
+	Name string
+	Next *snapshotNode
+}
+
+type snapshotConfig struct {
+	Name     string
+	Port     int
+	Tags     []string
+	Labels   map[string]any
+	Database *struct {
+		Host    string
+		Timeout time.Duration
+	}
+	StartAt time.Time
+	cache   int
+}
+
+func TestScanSnapshot(t *testing.T) {
+	var src = g.Map{
+		"name":     "server",
+		"port":     8000,
+		"tags":     g.Slice{"a", "b"},
+		"labels":   g.Map{"zone": "z1", "weight": 1, "meta": g.Map{"x": 1}},
+		"database": g.Map{"host": "127.0.0.1", "timeout": "3s"},
+		"startAt":  "2024-01-01 00:00:00",
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var config1, config2 snapshotConfig
+		hash1, err := gconv.ScanSnapshot(src, &config1)
+		t.AssertNil(err)
+		t.Assert(len(hash1), 64)
+		hash2, err := gconv.ScanSnapshot(src, &config2)
+		t.AssertNil(err)
+		t.Assert(hash1, hash2)
+		t.Assert(gconv.SnapshotHash(&config1), hash1)
+		t.Assert(gconv.SnapshotHash(config1), hash1)
+
+		// The unexported attributes are ignored.
+		config1.cache = 1
+		t.Assert(gconv.SnapshotHash(&config1), hash1)
+
+		// Mutations of nested values.
+		config1.Database.Host = "localhost"
+		t.AssertNE(gconv.SnapshotHash(&config1), hash1)
+		config1.Database.Host = "127.0.0.1"
+		t.Assert(gconv.SnapshotHash(&config1), hash1)
+
+		config1.Labels["meta"].(map[string]any)["x"] = 2
+		t.AssertNE(gconv.SnapshotHash(&config1), hash1)
+		config1.Labels["meta"].(map[string]any)["x"] = 1
+		t.Assert(gconv.SnapshotHash(&config1), hash1)
+
+		config1.Tags[1] = "c"
+		t.AssertNE(gconv.SnapshotHash(&config1), hash1)
+		config1.Tags[1] = "b"
+
+		config1.StartAt = config1.StartAt.Add(time.Second)
+		t.AssertNE(gconv.SnapshotHash(&config1), hash1)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var config snapshotConfig
+		_, err := gconv.ScanSnapshot(g.Map{"port": "abc"}, &config, gconv.ScanOption{})
+		t.AssertNE(err, nil)
+	})
+}
+
+func TestSnapshotHash(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		// Stable across runs.
+		t.Assert(gconv.SnapshotHash(nil), gconv.SnapshotHash(nil))
+		t.Assert(
+			gconv.SnapshotHash(g.Map{"a": 1, "b": "2", "c": g.Slice{1, 2}}),
+			gconv.SnapshotHash(g.Map{"c": g.Slice{1, 2}, "b": "2", "a": 1}),
+		)
+		t.AssertNE(gconv.SnapshotHash(g.Map{"a": 1}), gconv.SnapshotHash(g.Map{"a": "1"}))
+		t.AssertNE(gconv.SnapshotHash(g.Slice{"ab", "c"}), gconv.SnapshotHash(g.Slice{"a", "bc"}))
+		t.AssertNE(gconv.SnapshotHash([]int(nil)), gconv.SnapshotHash([]int{}))
+	})
+	// Cyclic references.
+	gtest.C(t, func(t *gtest.T) {
+		node := &snapshotNode{Name: "a"}
+		node.Next = node
+		hash := gconv.SnapshotHash(node)
+		t.Assert(gconv.SnapshotHash(node), hash)
+		node.Name = "b"
+		t.AssertNE(gconv.SnapshotHash(node), hash)
+	})
+}
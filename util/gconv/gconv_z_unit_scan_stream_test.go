@@ -0,0 +1,110 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+type scanStreamUser struct {
+	Id   int
+	Name string
+}
+
+func collectScanStream(content string, elemType reflect.Type, option ...gconv.ScanOption) (items []any, err error) {
+	out := make(chan any)
+	done := make(chan struct{})
+	go func() {
+		err = gconv.ScanStream(strings.NewReader(content), elemType, out, option...)
+		close(done)
+	}()
+	for item := range out {
+		items = append(items, item)
+	}
+	<-done
+	return
+}
+
+func TestScanStream(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		items, err := collectScanStream(
+			`[{"id":1,"name":"john"},{"id":"2","name":"smith"}]`,
+			reflect.TypeOf(scanStreamUser{}),
+		)
+		t.AssertNil(err)
+		t.Assert(items, []any{
+			scanStreamUser{Id: 1, Name: "john"},
+			scanStreamUser{Id: 2, Name: "smith"},
+		})
+	})
+	// Pointer element.
+	gtest.C(t, func(t *gtest.T) {
+		items, err := collectScanStream(`[{"id":1}]`, reflect.TypeOf(&scanStreamUser{}))
+		t.AssertNil(err)
+		t.Assert(len(items), 1)
+		t.Assert(items[0].(*scanStreamUser).Id, 1)
+	})
+	// Basic element.
+	gtest.C(t, func(t *gtest.T) {
+		items, err := collectScanStream(`[1, "2", 3.0]`, reflect.TypeOf(int64(0)))
+		t.AssertNil(err)
+		t.Assert(items, []any{int64(1), int64(2), int64(3)})
+	})
+	// Empty content.
+	gtest.C(t, func(t *gtest.T) {
+		items, err := collectScanStream(``, reflect.TypeOf(0))
+		t.AssertNil(err)
+		t.Assert(len(items), 0)
+		items, err = collectScanStream(`[]`, reflect.TypeOf(0))
+		t.AssertNil(err)
+		t.Assert(len(items), 0)
+	})
+}
+
+func TestScanStream_Error(t *testing.T) {
+	// Not array.
+	gtest.C(t, func(t *gtest.T) {
+		items, err := collectScanStream(`{"id":1}`, reflect.TypeOf(scanStreamUser{}))
+		t.AssertNE(err, nil)
+		t.Assert(len(items), 1)
+		t.Assert(items[0], err)
+	})
+	// Malformed element stops streaming.
+	gtest.C(t, func(t *gtest.T) {
+		items, err := collectScanStream(`[{"id":1},{"id":}, {"id":3}]`, reflect.TypeOf(scanStreamUser{}))
+		t.AssertNE(err, nil)
+		t.Assert(len(items), 2)
+		t.Assert(items[0], scanStreamUser{Id: 1})
+		t.Assert(items[1], err)
+	})
+	// Converting error.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			content  = `[1,"abc",3]`
+			elemType = reflect.TypeOf(&big.Int{})
+		)
+		items, err := collectScanStream(content, elemType, gconv.ScanOption{})
+		t.AssertNE(err, nil)
+		t.Assert(len(items), 2)
+		t.Assert(strings.Contains(err.Error(), "index 1"), true)
+
+		items, err = collectScanStream(content, elemType, gconv.ScanOption{
+			ContinueOnError: true,
+		})
+		t.AssertNE(err, nil)
+		t.Assert(len(items), 3)
+		t.Assert(items[0].(*big.Int).String(), "1")
+		t.Assert(items[1], err)
+		t.Assert(items[2].(*big.Int).String(), "3")
+	})
+}
@@ -8,7 +8,9 @@ package gconv_test
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/gogf/gf/v2/container/gvar"
 	"github.com/gogf/gf/v2/encoding/gjson"
@@ -441,3 +443,381 @@ func TestScanDeepSlice(t *testing.T) {
 		t.Assert(len(req2), 2)
 	})
 }
+
+func TestScanFromJson(t *testing.T) {
+	type Item struct {
+		Name string
+		Tags []string
+	}
+	type User struct {
+		Id    int
+		Items []Item
+		Sub   *Item
+	}
+	var j = gjson.New(`{"data":{"id":1,"items":[{"name":"a","tags":["x","y"]}],"sub":{"name":"b"}}}`)
+	gtest.C(t, func(t *gtest.T) {
+		var user *User
+		err := gconv.Scan(j.GetJson("data"), &user)
+		t.AssertNil(err)
+		t.Assert(user.Id, 1)
+		t.Assert(user.Items, []Item{{Name: "a", Tags: []string{"x", "y"}}})
+		t.Assert(user.Sub.Name, "b")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			items []Item
+			ids   []int
+		)
+		t.AssertNil(gconv.Scan(j.GetJson("data.items"), &items))
+		t.Assert(items, []Item{{Name: "a", Tags: []string{"x", "y"}}})
+		t.AssertNil(gconv.Scan(gjson.New(`[1,2]`), &ids))
+		t.Assert(ids, []int{1, 2})
+	})
+	// Nested Json values.
+	gtest.C(t, func(t *gtest.T) {
+		var data struct {
+			Ids   []int
+			Items []Item
+			Sub   Item
+		}
+		err := gconv.Scan(g.Map{
+			"ids":   gjson.New(`[1,2]`),
+			"items": gjson.New(`[{"name":"a"},{"name":"b"}]`),
+			"sub":   gjson.New(`{"name":"c"}`),
+		}, &data)
+		t.AssertNil(err)
+		t.Assert(data.Ids, []int{1, 2})
+		t.Assert(len(data.Items), 2)
+		t.Assert(data.Items[1].Name, "b")
+		t.Assert(data.Sub.Name, "c")
+	})
+}
+
+func TestScanToArray(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var rgba [4]byte
+		t.AssertNil(gconv.Scan([]any{255, "128", 0, 64}, &rgba))
+		t.Assert(rgba, [4]byte{255, 128, 0, 64})
+
+		var point [3]float64
+		t.AssertNil(gconv.Scan(`[1.5, 2, 3.25]`, &point))
+		t.Assert(point, [3]float64{1.5, 2, 3.25})
+	})
+	// Shorter source is zero-filled.
+	gtest.C(t, func(t *gtest.T) {
+		var point = [3]float64{7, 8, 9}
+		t.AssertNil(gconv.Scan([]float64{1.5}, &point))
+		t.Assert(point, [3]float64{1.5, 0, 0})
+	})
+	// Longer source is truncated, or returns error in strict mode.
+	gtest.C(t, func(t *gtest.T) {
+		var rgba [4]byte
+		t.AssertNil(gconv.Scan([]int{1, 2, 3, 4, 5}, &rgba))
+		t.Assert(rgba, [4]byte{1, 2, 3, 4})
+
+		err := gconv.ScanWithOptions([]int{1, 2, 3, 4, 5}, &rgba, gconv.ScanOption{ArrayStrict: true})
+		t.AssertNE(err, nil)
+	})
+	// Array attributes.
+	gtest.C(t, func(t *gtest.T) {
+		var data struct {
+			Color  [4]byte
+			Points [][3]float64
+		}
+		err := gconv.Scan(g.Map{
+			"color":  []int{1, 2, 3},
+			"points": []any{[]float64{1, 2, 3}, []float64{4, 5}},
+		}, &data)
+		t.AssertNil(err)
+		t.Assert(data.Color, [4]byte{1, 2, 3, 0})
+		t.Assert(data.Points, [][3]float64{{1, 2, 3}, {4, 5, 0}})
+	})
+}
+
+func TestScanWithLocation(t *testing.T) {
+	type Event struct {
+		Start    time.Time
+		End      *time.Time
+		Created  *gtime.Time
+		Absolute time.Time
+	}
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	var data = g.Map{
+		// Daylight saving time begins at 2024-03-10 02:00:00.
+		"start":    "2024-03-10 01:30:00",
+		"end":      "2024-03-10 03:30:00",
+		"created":  "2024-11-03 00:30:00",
+		"absolute": "2024-03-10T01:30:00Z",
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var event *Event
+		err := gconv.ScanWithOptions(data, &event, gconv.ScanOption{Location: loc})
+		t.AssertNil(err)
+		t.Assert(event.Start.Format(time.RFC3339), "2024-03-10T01:30:00-05:00")
+		t.Assert(event.End.Format(time.RFC3339), "2024-03-10T03:30:00-04:00")
+		t.Assert(event.Created.Format("c"), "2024-11-03T00:30:00-04:00")
+		t.Assert(event.Absolute.Format(time.RFC3339), "2024-03-09T20:30:00-05:00")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var event *Event
+		err := gconv.ScanWithOptions(data, &event, gconv.ScanOption{Location: loc, TimeToUTC: true})
+		t.AssertNil(err)
+		t.Assert(event.Start.Format(time.RFC3339), "2024-03-10T06:30:00Z")
+		t.Assert(event.End.Format(time.RFC3339), "2024-03-10T07:30:00Z")
+		t.Assert(event.Created.Format("c"), "2024-11-03T04:30:00+00:00")
+		t.Assert(event.Absolute.Format(time.RFC3339), "2024-03-10T01:30:00Z")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var start time.Time
+		err := gconv.ScanWithOptions("2024-03-10 03:30:00", &start, gconv.ScanOption{Location: loc})
+		t.AssertNil(err)
+		t.Assert(start.Format(time.RFC3339), "2024-03-10T03:30:00-04:00")
+	})
+}
+
+func TestScanToBigNumber(t *testing.T) {
+	const (
+		bigIntStr   = "123456789012345678901234567890123456789012345678901234567890"
+		bigFloatStr = "12345678901234567890.12345678901234567890123456789"
+	)
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			i  *big.Int
+			iv big.Int
+			f  *big.Float
+		)
+		t.AssertNil(gconv.Scan(bigIntStr, &i))
+		t.Assert(i.String(), bigIntStr)
+		t.AssertNil(gconv.Scan(uint64(18446744073709551615), &iv))
+		t.Assert(iv.String(), "18446744073709551615")
+		t.AssertNil(gconv.Scan(bigFloatStr, &f))
+		t.Assert(f.Text('f', 29), bigFloatStr)
+		t.Assert(gconv.String(i), bigIntStr)
+		t.Assert(gconv.String(iv), "18446744073709551615")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		type Account struct {
+			Balance  *big.Int
+			Supply   big.Int
+			Rate     *big.Float
+			Fraction big.Float
+		}
+		var account Account
+		err := gconv.Scan(g.Map{
+			"balance":  bigIntStr,
+			"supply":   -9223372036854775808,
+			"rate":     bigFloatStr,
+			"fraction": 0.5,
+		}, &account)
+		t.AssertNil(err)
+		t.Assert(account.Balance.String(), bigIntStr)
+		t.Assert(account.Supply.String(), "-9223372036854775808")
+		t.Assert(account.Rate.Text('f', 29), bigFloatStr)
+		t.Assert(account.Fraction.String(), "0.5")
+	})
+	// Malformed values.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			i *big.Int
+			f *big.Float
+		)
+		t.AssertNE(gconv.Scan("12a34", &i), nil)
+		t.AssertNE(gconv.Scan(1.5, &i), nil)
+		t.AssertNE(gconv.Scan("1.2.3", &f), nil)
+		type Account struct {
+			Balance big.Int
+		}
+		var account Account
+		t.AssertNE(gconv.ScanWithOptions(g.Map{"balance": "not a number"}, &account, gconv.ScanOption{}), nil)
+	})
+}
+
+type scanTextLevel int
+
+func (l *scanTextLevel) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "debug":
+		*l = 1
+	case "info":
+		*l = 2
+	default:
+		return gerror.Newf(`invalid level "%s"`, text)
+	}
+	return nil
+}
+
+type scanBinaryValue struct {
+	Data string
+}
+
+func (v *scanBinaryValue) UnmarshalBinary(data []byte) error {
+	v.Data = "binary:" + string(data)
+	return nil
+}
+
+func TestScanWithUnmarshaler(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			level    scanTextLevel
+			levelPtr *scanTextLevel
+			levels   []scanTextLevel
+		)
+		t.AssertNil(gconv.Scan("info", &level))
+		t.Assert(level, 2)
+		t.AssertNil(gconv.Scan("debug", &levelPtr))
+		t.Assert(*levelPtr, 1)
+		t.AssertNil(gconv.Scan([]string{"debug", "info"}, &levels))
+		t.Assert(levels, []scanTextLevel{1, 2})
+		// Non-text value is converted as its underlying type.
+		t.AssertNil(gconv.Scan(2, &level))
+		t.Assert(level, 2)
+		t.AssertNE(gconv.Scan("unknown", &level), nil)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		type Inner struct {
+			Level    scanTextLevel
+			LevelPtr *scanTextLevel
+		}
+		type Config struct {
+			Level    scanTextLevel
+			LevelPtr *scanTextLevel
+			Levels   []scanTextLevel
+			LevelMap map[string]scanTextLevel
+			Inner    Inner
+			Inners   []*Inner
+			Binary   scanBinaryValue
+			Binaries []*scanBinaryValue
+		}
+		var config *Config
+		err := gconv.Scan(g.Map{
+			"level":    "info",
+			"levelPtr": "debug",
+			"levels":   g.SliceStr{"debug", "info"},
+			"levelMap": g.Map{"a": "info"},
+			"inner":    g.Map{"level": "debug", "levelPtr": "info"},
+			"inners":   g.Slice{g.Map{"level": "info"}},
+			"binary":   []byte("a"),
+			"binaries": g.Slice{[]byte("b")},
+		}, &config)
+		t.AssertNil(err)
+		t.Assert(config.Level, 2)
+		t.Assert(*config.LevelPtr, 1)
+		t.Assert(config.Levels, []scanTextLevel{1, 2})
+		t.Assert(config.LevelMap, map[string]scanTextLevel{"a": 2})
+		t.Assert(config.Inner.Level, 1)
+		t.Assert(*config.Inner.LevelPtr, 2)
+		t.Assert(len(config.Inners), 1)
+		t.Assert(config.Inners[0].Level, 2)
+		t.Assert(config.Binary.Data, "binary:a")
+		t.Assert(len(config.Binaries), 1)
+		t.Assert(config.Binaries[0].Data, "binary:b")
+	})
+}
+
+func TestScanWithWarnings(t *testing.T) {
+	type Address struct {
+		Zip int8
+	}
+	type User struct {
+		Name    string
+		Age     int
+		Count   uint8
+		Enabled bool
+		Score   float32
+		Address Address
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var user *User
+		warnings, err := gconv.ScanWithWarnings(g.Map{
+			"name":    "john",
+			"age":     18.5,
+			"count":   256,
+			"enabled": "maybe",
+			"score":   "1e40",
+			"address": g.Map{"zip": 300},
+		}, &user)
+		t.AssertNil(err)
+		t.Assert(user.Name, "john")
+		t.Assert(user.Age, 18)
+		t.Assert(user.Enabled, true)
+		t.Assert(len(warnings), 5)
+
+		warningMap := make(map[string]gconv.ScanWarning)
+		for _, warning := range warnings {
+			warningMap[warning.Field] = warning
+		}
+		t.Assert(warningMap["Age"].Value, 18.5)
+		t.Assert(warningMap["Age"].Reason, "fractional part truncated converting to type int")
+		t.Assert(warningMap["Count"].Reason, "value overflows type uint8")
+		t.Assert(warningMap["Enabled"].Reason, "unexpected token converted to bool")
+		t.Assert(warningMap["Score"].Reason, "value overflows type float32")
+		t.Assert(warningMap["Address.Zip"].Value, 300)
+		t.Assert(warningMap["Address.Zip"].Reason, "value overflows type int8")
+	})
+	// No warnings.
+	gtest.C(t, func(t *gtest.T) {
+		var user *User
+		warnings, err := gconv.ScanWithWarnings(g.Map{
+			"name":    "john",
+			"age":     "18",
+			"count":   18.0,
+			"enabled": "on",
+			"score":   "99.5",
+			"address": g.Map{"zip": "100"},
+		}, &user)
+		t.AssertNil(err)
+		t.Assert(user.Age, 18)
+		t.Assert(user.Enabled, true)
+		t.Assert(len(warnings), 0)
+	})
+}
+
+func TestScanPresence(t *testing.T) {
+	type Address struct {
+		City string
+		Zip  string `json:"zip_code"`
+	}
+	type User struct {
+		Id      int
+		Name    string `c:"nick" json:"name"`
+		Email   string
+		Age     int `c:"default:18"`
+		Status  *int
+		Address Address
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var user *User
+		present, err := gconv.ScanPresence(g.Map{
+			"id":     1,
+			"nick":   "",
+			"name":   "john",
+			"status": nil,
+			"address": g.Map{
+				"city":     "",
+				"zip_code": "100000",
+			},
+		}, &user)
+		t.AssertNil(err)
+		t.Assert(user.Id, 1)
+		t.Assert(user.Name, "")
+		t.Assert(user.Age, 18)
+		t.Assert(user.Address.Zip, "100000")
+		t.Assert(present, map[string]bool{
+			"Id":           true,
+			"Name":         true,
+			"Status":       true,
+			"Address":      true,
+			"Address.City": true,
+			"Address.Zip":  true,
+		})
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var user User
+		present, err := gconv.ScanPresence(g.Map{"email": "john@example.com"}, &user)
+		t.AssertNil(err)
+		t.Assert(present, map[string]bool{"Email": true})
+	})
+}
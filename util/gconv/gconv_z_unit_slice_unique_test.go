@@ -0,0 +1,88 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+func TestSliceUnique(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var ids []int
+		t.AssertNil(gconv.SliceUnique([]string{"3", "1", "3", "2", "1"}, &ids))
+		t.Assert(ids, []int{3, 1, 2})
+
+		// Duplicated by converted values.
+		var names []string
+		t.AssertNil(gconv.SliceUnique(g.Slice{1, "1", 2, 1.0}, &names))
+		t.Assert(names, []string{"1", "2"})
+
+		var single []int
+		t.AssertNil(gconv.SliceUnique(5, &single))
+		t.Assert(single, []int{5})
+
+		var fromJson []int64
+		t.AssertNil(gconv.SliceUnique(`[1,2,2,1]`, &fromJson))
+		t.Assert(fromJson, []int64{1, 2})
+	})
+	// Comparable struct elements.
+	gtest.C(t, func(t *gtest.T) {
+		type User struct {
+			Id   int
+			Name string
+		}
+		var users []User
+		t.AssertNil(gconv.SliceUnique(g.Slice{
+			g.Map{"id": 1, "name": "john"},
+			g.Map{"id": 2, "name": "smith"},
+			g.Map{"id": "1", "name": "john"},
+		}, &users))
+		t.Assert(users, []User{{1, "john"}, {2, "smith"}})
+	})
+	// Non-comparable elements.
+	gtest.C(t, func(t *gtest.T) {
+		var items [][]int
+		t.AssertNE(gconv.SliceUnique(g.Slice{g.Slice{1}}, &items), nil)
+
+		var anyItems = []any{1}
+		t.AssertNE(gconv.SliceUnique(g.Slice{1, g.Slice{1}}, &anyItems), nil)
+		t.Assert(anyItems, []any{1})
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var ids []int
+		t.AssertNE(gconv.SliceUnique(g.Slice{1}, ids), nil)
+		t.AssertNE(gconv.SliceUnique(g.Slice{1}, nil), nil)
+	})
+}
+
+func TestToSet(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var set map[int]struct{}
+		t.AssertNil(gconv.ToSet(g.Slice{1, "2", 2.0, 3}, &set))
+		t.Assert(len(set), 3)
+		for _, key := range []int{1, 2, 3} {
+			_, ok := set[key]
+			t.Assert(ok, true)
+		}
+
+		var strSet = map[string]struct{}{"old": {}}
+		t.AssertNil(gconv.ToSet([]int{1, 1}, &strSet))
+		t.Assert(strSet, map[string]struct{}{"1": {}})
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var set map[string]bool
+		t.AssertNE(gconv.ToSet(g.Slice{1}, &set), nil)
+
+		var anySet map[any]struct{}
+		t.AssertNE(gconv.ToSet(g.Slice{g.Map{"a": 1}}, &anySet), nil)
+		t.Assert(anySet, nil)
+	})
+}
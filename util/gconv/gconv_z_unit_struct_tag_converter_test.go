@@ -0,0 +1,117 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+func TestStruct_TagConverter(t *testing.T) {
+	type User struct {
+		Name   string `json:"name" gconv:"converter:test_upper"`
+		Remark string `json:"remark"`
+	}
+	type Order struct {
+		Name   string  `json:"name" gconv:"converter:test_trim"`
+		Amount int64   `json:"amount" gconv:"converter:test_cents"`
+		Refund *int64  `json:"refund" c:"converter:test_cents"`
+		Code   *string `json:"code" gconv:"converter:test_upper"`
+	}
+	gtest.C(t, func(t *gtest.T) {
+		t.AssertNil(gconv.RegisterNamedConverter("test_upper", func(s string) (string, error) {
+			return strings.ToUpper(s), nil
+		}))
+		t.AssertNil(gconv.RegisterNamedConverter("test_trim", func(s string) (*string, error) {
+			s = strings.TrimSpace(s)
+			return &s, nil
+		}))
+		t.AssertNil(gconv.RegisterNamedConverter("test_cents", func(yuan float64) (int64, error) {
+			if yuan < 0 {
+				return 0, errors.New("negative amount")
+			}
+			return int64(math.Round(yuan * 100)), nil
+		}))
+	})
+	defer func() {
+		gconv.UnregisterNamedConverter("test_upper")
+		gconv.UnregisterNamedConverter("test_trim")
+		gconv.UnregisterNamedConverter("test_cents")
+	}()
+
+	// The same type is converted differently in different structs.
+	gtest.C(t, func(t *gtest.T) {
+		var user *User
+		err := gconv.Scan(g.Map{"name": " john ", "remark": " ok "}, &user)
+		t.AssertNil(err)
+		t.Assert(user.Name, " JOHN ")
+		t.Assert(user.Remark, " ok ")
+
+		var order *Order
+		err = gconv.Scan(g.Map{"name": " john ", "amount": "12.34", "refund": 1.5, "code": "ab"}, &order)
+		t.AssertNil(err)
+		t.Assert(order.Name, "john")
+		t.Assert(order.Amount, 1234)
+		t.Assert(*order.Refund, 150)
+		t.Assert(*order.Code, "AB")
+	})
+	// Converter error.
+	gtest.C(t, func(t *gtest.T) {
+		var order *Order
+		err := gconv.ScanWithOptions(g.Map{"amount": -1}, &order, gconv.ScanOption{})
+		t.AssertNE(err, nil)
+		var fieldErr *gconv.FieldConvertError
+		t.Assert(errors.As(err, &fieldErr), true)
+		t.Assert(fieldErr.Path, "Amount")
+		t.Assert(gerror.Unwrap(fieldErr).Error(), "negative amount")
+	})
+	// Unregistered converter.
+	gtest.C(t, func(t *gtest.T) {
+		type Item struct {
+			Name string `gconv:"converter:test_unknown"`
+		}
+		var item Item
+		err := gconv.ScanWithOptions(g.Map{"Name": "john"}, &item, gconv.ScanOption{})
+		t.AssertNE(err, nil)
+		t.Assert(gerror.Code(err), gcode.CodeConversionFailed)
+		t.Assert(gerror.HasCode(err, gcode.CodeInvalidConfiguration), true)
+	})
+}
+
+func TestRegisterNamedConverter(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.AssertNE(gconv.RegisterNamedConverter("", func(s string) (string, error) { return s, nil }), nil)
+		t.AssertNE(gconv.RegisterNamedConverter("test_invalid", nil), nil)
+		t.AssertNE(gconv.RegisterNamedConverter("test_invalid", func(s string) string { return s }), nil)
+
+		t.AssertNil(gconv.RegisterNamedConverter("test_dup", func(s string) (string, error) { return s, nil }))
+		t.AssertNE(gconv.RegisterNamedConverter("test_dup", func(s string) (string, error) { return s, nil }), nil)
+		t.Assert(gconv.UnregisterNamedConverter("test_dup"), true)
+		t.Assert(gconv.UnregisterNamedConverter("test_dup"), false)
+	})
+	// Converter instance.
+	gtest.C(t, func(t *gtest.T) {
+		type User struct {
+			Name string `gconv:"converter:upper"`
+		}
+		var converter = gconv.NewConverter()
+		t.AssertNil(converter.RegisterNamedConverter("upper", func(s string) (string, error) {
+			return strings.ToUpper(s), nil
+		}))
+		var user User
+		t.AssertNil(converter.Scan(g.Map{"Name": "john"}, &user))
+		t.Assert(user.Name, "JOHN")
+	})
+}
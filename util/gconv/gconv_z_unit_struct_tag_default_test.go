@@ -0,0 +1,260 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+func TestStruct_TagDefault(t *testing.T) {
+	type Config struct {
+		Port    int           `gconv:"default:8000"`
+		Name    string        `gconv:"name,default:server"`
+		Debug   bool          `gconv:"default:true" json:"debug"`
+		Timeout time.Duration `c:"timeout,default:30s"`
+		Host    string
+	}
+	// Missing keys use default values.
+	gtest.C(t, func(t *gtest.T) {
+		var config *Config
+		err := gconv.Struct(g.Map{"host": "127.0.0.1"}, &config)
+		t.AssertNil(err)
+		t.Assert(config.Port, 8000)
+		t.Assert(config.Name, "server")
+		t.Assert(config.Debug, true)
+		t.Assert(config.Timeout, 30*time.Second)
+		t.Assert(config.Host, "127.0.0.1")
+	})
+	// Empty source map.
+	gtest.C(t, func(t *gtest.T) {
+		var config Config
+		err := gconv.Struct(g.Map{}, &config)
+		t.AssertNil(err)
+		t.Assert(config.Port, 8000)
+		t.Assert(config.Name, "server")
+		t.Assert(config.Debug, true)
+		t.Assert(config.Timeout, 30*time.Second)
+	})
+	// Explicitly provided values, including zero values, are not overridden.
+	gtest.C(t, func(t *gtest.T) {
+		var config Config
+		err := gconv.Scan(g.Map{
+			"port":    0,
+			"name":    "",
+			"debug":   false,
+			"timeout": "1m",
+		}, &config)
+		t.AssertNil(err)
+		t.Assert(config.Port, 0)
+		t.Assert(config.Name, "")
+		t.Assert(config.Debug, false)
+		t.Assert(config.Timeout, time.Minute)
+	})
+	// Custom parameter key mapping.
+	gtest.C(t, func(t *gtest.T) {
+		var config Config
+		err := gconv.Struct(g.Map{"listen": 9000}, &config, map[string]string{
+			"listen": "Port",
+		})
+		t.AssertNil(err)
+		t.Assert(config.Port, 9000)
+		t.Assert(config.Name, "server")
+	})
+	// Tag options are not used as map keys.
+	gtest.C(t, func(t *gtest.T) {
+		m := gconv.Map(Config{Port: 80})
+		t.Assert(m["Port"], 80)
+		t.Assert(m["name"], "")
+		t.Assert(m["debug"], false)
+		t.Assert(m["timeout"], time.Duration(0))
+	})
+}
+
+type defaultProviderRegion string
+
+type defaultProviderEndpoint struct {
+	Host string
+}
+
+func TestStruct_TagDefault_ColonName(t *testing.T) {
+	type Book struct {
+		Title  string `json:"dc:title"`
+		Author string `json:"dc:creator,omitempty"`
+		Year   int    `json:"year" gconv:"default:2000"`
+		Label  string `gconv:"x:label"`
+	}
+	// The names having char ':' in tags are not parsed as tag options.
+	gtest.C(t, func(t *gtest.T) {
+		var book Book
+		err := gconv.Scan(g.Map{"dc:title": "T", "dc:creator": "A", "x:label": "L"}, &book)
+		t.AssertNil(err)
+		t.Assert(book.Title, "T")
+		t.Assert(book.Author, "A")
+		t.Assert(book.Year, 2000)
+		t.Assert(book.Label, "L")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		m := gconv.Map(Book{Title: "T", Author: "A", Year: 2020, Label: "L"})
+		t.Assert(len(m), 4)
+		t.Assert(m["dc:title"], "T")
+		t.Assert(m["dc:creator"], "A")
+		t.Assert(m["year"], 2020)
+		t.Assert(m["x:label"], "L")
+	})
+}
+
+func TestScan_DefaultProvider(t *testing.T) {
+	type ctxKey string
+	type Config struct {
+		Name     string
+		Region   defaultProviderRegion
+		Endpoint *defaultProviderEndpoint
+	}
+	var called int
+	gtest.C(t, func(t *gtest.T) {
+		err := gconv.RegisterDefaultProvider(func(ctx context.Context) (defaultProviderRegion, error) {
+			called++
+			if v := ctx.Value(ctxKey("region")); v != nil {
+				return defaultProviderRegion(v.(string)), nil
+			}
+			return "us-east", nil
+		})
+		t.AssertNil(err)
+		err = gconv.RegisterDefaultProvider(func(ctx context.Context) (defaultProviderEndpoint, error) {
+			return defaultProviderEndpoint{Host: "127.0.0.1"}, nil
+		})
+		t.AssertNil(err)
+	})
+	defer gconv.UnregisterDefaultProvider(reflect.TypeOf(defaultProviderRegion("")))
+	defer gconv.UnregisterDefaultProvider(reflect.TypeOf(defaultProviderEndpoint{}))
+
+	// Missing attributes.
+	gtest.C(t, func(t *gtest.T) {
+		called = 0
+		var config *Config
+		err := gconv.Scan(g.Map{"name": "app"}, &config)
+		t.AssertNil(err)
+		t.Assert(config.Name, "app")
+		t.Assert(config.Region, "us-east")
+		t.Assert(config.Endpoint.Host, "127.0.0.1")
+		t.Assert(called, 1)
+	})
+	// The provider is not called for non-zero attributes.
+	gtest.C(t, func(t *gtest.T) {
+		called = 0
+		var config *Config
+		err := gconv.Scan(g.Map{"region": "eu-west", "endpoint": g.Map{"host": "localhost"}}, &config)
+		t.AssertNil(err)
+		t.Assert(config.Region, "eu-west")
+		t.Assert(config.Endpoint.Host, "localhost")
+		t.Assert(called, 0)
+	})
+	// Context of option.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			config Config
+			ctx    = context.WithValue(context.Background(), ctxKey("region"), "ap-south")
+		)
+		err := gconv.ScanWithOptions(g.Map{"name": "app"}, &config, gconv.ScanOption{Context: ctx})
+		t.AssertNil(err)
+		t.Assert(config.Region, "ap-south")
+	})
+	// Invalid and repeated registering.
+	gtest.C(t, func(t *gtest.T) {
+		t.AssertNE(gconv.RegisterDefaultProvider(func() (int, error) { return 0, nil }), nil)
+		t.AssertNE(gconv.RegisterDefaultProvider(func(ctx context.Context) int { return 0 }), nil)
+		t.AssertNE(gconv.RegisterDefaultProvider(func(ctx context.Context) (defaultProviderRegion, error) {
+			return "", nil
+		}), nil)
+	})
+}
+
+func TestScan_DefaultProviderError(t *testing.T) {
+	type Config struct {
+		Name  string
+		Token defaultProviderRegion
+	}
+	gtest.C(t, func(t *gtest.T) {
+		err := gconv.RegisterDefaultProvider(func(ctx context.Context) (defaultProviderRegion, error) {
+			return "", errors.New("token service unavailable")
+		})
+		t.AssertNil(err)
+		defer gconv.UnregisterDefaultProvider(reflect.TypeOf(defaultProviderRegion("")))
+
+		var config Config
+		err = gconv.ScanWithOptions(g.Map{"name": "app"}, &config, gconv.ScanOption{})
+		t.AssertNE(err, nil)
+		t.Assert(gerror.Cause(err).Error(), "token service unavailable")
+
+		err = gconv.Scan(g.Map{"name": "app"}, &config)
+		t.AssertNil(err)
+		t.Assert(config.Name, "app")
+	})
+}
+
+func TestStruct_TagDefaultNow(t *testing.T) {
+	type Audit struct {
+		Id        int
+		CreatedAt time.Time   `gconv:"default:now"`
+		UpdatedAt *gtime.Time `gconv:"default:now"`
+		Remark    string      `gconv:"default:now"`
+	}
+	type Order struct {
+		Audit Audit
+	}
+	var (
+		now     = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		nowFunc = func() time.Time { return now }
+	)
+	gtest.C(t, func(t *gtest.T) {
+		var audit Audit
+		err := gconv.ScanWithOptions(g.Map{"id": 1}, &audit, gconv.ScanOption{Now: nowFunc})
+		t.AssertNil(err)
+		t.Assert(audit.CreatedAt.Equal(now), true)
+		t.Assert(audit.UpdatedAt.Time.Equal(now), true)
+		t.Assert(audit.Remark, "now")
+	})
+	// The explicitly provided values are not overridden.
+	gtest.C(t, func(t *gtest.T) {
+		var audit Audit
+		err := gconv.ScanWithOptions(g.Map{"createdAt": "2020-01-01 00:00:00"}, &audit, gconv.ScanOption{
+			Now:      nowFunc,
+			Location: time.UTC,
+		})
+		t.AssertNil(err)
+		t.Assert(audit.CreatedAt, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+		t.Assert(audit.UpdatedAt.Time.Equal(now), true)
+	})
+	// Nested struct attributes.
+	gtest.C(t, func(t *gtest.T) {
+		var order Order
+		err := gconv.ScanWithOptions(g.Map{"audit": g.Map{"id": 1}}, &order, gconv.ScanOption{Now: nowFunc})
+		t.AssertNil(err)
+		t.Assert(order.Audit.CreatedAt.Equal(now), true)
+	})
+	// It uses time.Now in default.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			audit Audit
+			start = time.Now()
+		)
+		err := gconv.Scan(g.Map{"id": 1}, &audit)
+		t.AssertNil(err)
+		t.Assert(audit.CreatedAt.Before(start), false)
+		t.Assert(audit.CreatedAt.After(time.Now()), false)
+	})
+}
@@ -0,0 +1,130 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+type flagsPermission uint64
+
+var flagsPermissionMapping = map[string]uint64{
+	"read":   1,
+	"write":  2,
+	"delete": 4,
+	"admin":  7,
+}
+
+func TestFlagsToNames(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gconv.FlagsToNames(0, flagsPermissionMapping), []string{})
+		t.Assert(gconv.FlagsToNames(5, flagsPermissionMapping), []string{"read", "delete"})
+		t.Assert(gconv.FlagsToNames(7, flagsPermissionMapping), []string{"read", "write", "delete", "admin"})
+		t.Assert(gconv.FlagsToNames(8, flagsPermissionMapping), []string{})
+	})
+}
+
+func TestNamesToFlags(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		flags, err := gconv.NamesToFlags([]string{"read", " delete", ""}, flagsPermissionMapping)
+		t.AssertNil(err)
+		t.Assert(flags, 5)
+
+		flags, err = gconv.NamesToFlags([]string{"read", "execute"}, flagsPermissionMapping)
+		t.AssertNE(err, nil)
+		t.Assert(flags, 0)
+
+		flags, err = gconv.NamesToFlags(
+			[]string{"read", "execute"}, flagsPermissionMapping, gconv.FlagsOption{IgnoreUnknown: true},
+		)
+		t.AssertNil(err)
+		t.Assert(flags, 1)
+
+		names := gconv.FlagsToNames(6, flagsPermissionMapping)
+		flags, err = gconv.NamesToFlags(names, flagsPermissionMapping)
+		t.AssertNil(err)
+		t.Assert(flags, 6)
+	})
+}
+
+func TestScan_TagFlags(t *testing.T) {
+	type Role struct {
+		Name       string
+		Permission flagsPermission  `json:"permission" gconv:",flags"`
+		Extra      *flagsPermission `c:"extra,flags"`
+	}
+	gtest.C(t, func(t *gtest.T) {
+		err := gconv.RegisterFlagsMapping(reflect.TypeOf(flagsPermission(0)), flagsPermissionMapping)
+		t.AssertNil(err)
+		t.AssertNE(gconv.RegisterFlagsMapping(reflect.TypeOf(flagsPermission(0)), flagsPermissionMapping), nil)
+		t.AssertNE(gconv.RegisterFlagsMapping(reflect.TypeOf(""), flagsPermissionMapping), nil)
+	})
+	defer gconv.UnregisterFlagsMapping(reflect.TypeOf(flagsPermission(0)))
+
+	gtest.C(t, func(t *gtest.T) {
+		var role *Role
+		err := gconv.Scan(g.Map{
+			"name":       "editor",
+			"permission": []string{"read", "write"},
+			"extra":      "delete",
+		}, &role)
+		t.AssertNil(err)
+		t.Assert(role.Name, "editor")
+		t.Assert(role.Permission, 3)
+		t.Assert(*role.Extra, 4)
+	})
+	// String of names separated by char ','.
+	gtest.C(t, func(t *gtest.T) {
+		var role *Role
+		err := gconv.Scan(g.Map{"permission": "read,delete"}, &role)
+		t.AssertNil(err)
+		t.Assert(role.Permission, 5)
+	})
+	// Numeric source value.
+	gtest.C(t, func(t *gtest.T) {
+		var role *Role
+		err := gconv.Scan(g.Map{"permission": 6, "extra": "1"}, &role)
+		t.AssertNil(err)
+		t.Assert(role.Permission, 6)
+		t.Assert(*role.Extra, 1)
+	})
+	// Unknown names.
+	gtest.C(t, func(t *gtest.T) {
+		var role *Role
+		err := gconv.ScanWithOptions(g.Map{"permission": []any{"read", "execute"}}, &role)
+		t.AssertNE(err, nil)
+
+		role = nil
+		err = gconv.ScanWithOptions(
+			g.Map{"permission": []any{"read", "execute"}}, &role,
+			gconv.ScanOption{IgnoreUnknownFlags: true},
+		)
+		t.AssertNil(err)
+		t.Assert(role.Permission, 1)
+	})
+}
+
+func TestScan_TagFlagsWithoutMapping(t *testing.T) {
+	type Role struct {
+		Permission uint32 `gconv:",flags"`
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var role *Role
+		err := gconv.ScanWithOptions(g.Map{"permission": []string{"read"}}, &role)
+		t.AssertNE(err, nil)
+
+		role = nil
+		err = gconv.ScanWithOptions(g.Map{"permission": 3}, &role)
+		t.AssertNil(err)
+		t.Assert(role.Permission, 3)
+	})
+}
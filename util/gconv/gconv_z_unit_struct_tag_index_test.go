@@ -0,0 +1,152 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+func TestScan_TagIndex(t *testing.T) {
+	type User struct {
+		Id     int     `gconv:"index:0"`
+		Name   string  `gconv:"name,index:1"`
+		Score  float64 `c:"index:2"`
+		Status int     `gconv:"index:3,default:1"`
+		Remark string
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var user *User
+		err := gconv.Scan([]string{"1", "john", "98.5", "2"}, &user)
+		t.AssertNil(err)
+		t.Assert(user, &User{Id: 1, Name: "john", Score: 98.5, Status: 2})
+	})
+	// Short row leaves trailing attributes zero, except the default values.
+	gtest.C(t, func(t *gtest.T) {
+		var user User
+		err := gconv.Scan([]string{"2", "smith"}, &user)
+		t.AssertNil(err)
+		t.Assert(user, User{Id: 2, Name: "smith", Status: 1})
+	})
+	// Extra columns are ignored.
+	gtest.C(t, func(t *gtest.T) {
+		var user User
+		err := gconv.Scan([]any{3, "alice", 60, 0, "extra"}, &user)
+		t.AssertNil(err)
+		t.Assert(user, User{Id: 3, Name: "alice", Score: 60})
+	})
+	// Rows to slice of structs, like csv ingestion.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			users []User
+			rows  = [][]string{
+				{"1", "john", "98.5"},
+				{"2", "smith", "60", "0"},
+			}
+		)
+		err := gconv.Scan(rows, &users)
+		t.AssertNil(err)
+		t.Assert(users, []User{
+			{Id: 1, Name: "john", Score: 98.5, Status: 1},
+			{Id: 2, Name: "smith", Score: 60},
+		})
+	})
+	// Key-based binding is not affected.
+	gtest.C(t, func(t *gtest.T) {
+		var user User
+		err := gconv.Scan(map[string]any{"id": 4, "name": "bob", "Remark": "ok"}, &user)
+		t.AssertNil(err)
+		t.Assert(user, User{Id: 4, Name: "bob", Status: 1, Remark: "ok"})
+	})
+}
+
+func TestScan_TagIndexError(t *testing.T) {
+	type Row struct {
+		Id    int   `gconv:"index:0"`
+		Items []int `gconv:"index:1"`
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var row Row
+		err := gconv.ScanWithOptions([]any{"1", map[string]any{"a": 1}}, &row, gconv.ScanOption{})
+		t.AssertNE(err, nil)
+	})
+}
+
+type indexLevel struct {
+	Value int64
+}
+
+func TestScan_TagIndexHeterogeneous(t *testing.T) {
+	type Record struct {
+		Id        int64      `gconv:"index:0"`
+		Name      string     `gconv:"index:1"`
+		CreatedAt time.Time  `gconv:"index:2"`
+		Level     indexLevel `gconv:"index:3"`
+	}
+	var (
+		converter = gconv.NewConverter()
+		createdAt = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+	err := converter.RegisterTypeConverterFunc(func(from int64) (*indexLevel, error) {
+		return &indexLevel{Value: from * 10}, nil
+	})
+	// int/string/time positions.
+	gtest.C(t, func(t *gtest.T) {
+		var record Record
+		err := converter.Scan([]any{1, "john", createdAt}, &record)
+		t.AssertNil(err)
+		t.Assert(record.Id, 1)
+		t.Assert(record.Name, "john")
+		t.Assert(record.CreatedAt.Equal(createdAt), true)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var record Record
+		err := converter.Scan([]any{"2", 3, "2024-01-02 03:04:05"}, &record)
+		t.AssertNil(err)
+		t.Assert(record.Id, 2)
+		t.Assert(record.Name, "3")
+		t.Assert(record.CreatedAt.Format("2006-01-02 15:04:05"), "2024-01-02 03:04:05")
+	})
+	// The element of type int uses the converter registered for int64.
+	gtest.C(t, func(t *gtest.T) {
+		t.AssertNil(err)
+		var record Record
+		err := converter.Scan([]any{1, "john", createdAt, 7}, &record)
+		t.AssertNil(err)
+		t.Assert(record.Level, indexLevel{Value: 70})
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var record Record
+		err := converter.Scan([]any{1, "john", createdAt, int8(2)}, &record)
+		t.AssertNil(err)
+		t.Assert(record.Level, indexLevel{Value: 20})
+	})
+}
+
+func TestScan_TagIndexErrorIndex(t *testing.T) {
+	type Row struct {
+		Id    int   `gconv:"index:0"`
+		Items []int `gconv:"index:1"`
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var row Row
+		err := gconv.ScanWithOptions([]any{"1", map[string]any{"a": 1}}, &row, gconv.ScanOption{})
+		t.AssertNE(err, nil)
+		t.Assert(gerror.Code(err), gcode.CodeConversionFailed)
+		t.Assert(gstr.HasPrefix(err.Error(), `bind element at index 1 failed: `), true)
+		var fieldErr *gconv.FieldConvertError
+		t.Assert(errors.As(err, &fieldErr), true)
+		t.Assert(fieldErr.Path, "Items")
+	})
+}
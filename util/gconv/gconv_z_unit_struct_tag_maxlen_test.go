@@ -0,0 +1,79 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+func TestScan_TagMaxLen(t *testing.T) {
+	type Comment struct {
+		Title   string  `json:"title" gconv:"maxlen:5"`
+		Content *string `gconv:"maxlen:3"`
+		Data    []byte  `gconv:"maxlen:2"`
+		Score   int     `gconv:"maxlen:1"`
+		Author  string
+	}
+	// Truncating in default.
+	gtest.C(t, func(t *gtest.T) {
+		var comment *Comment
+		err := gconv.Scan(g.Map{
+			"title":   "你好世界和平",
+			"content": "abcdef",
+			"data":    "xyz",
+			"score":   100,
+			"author":  "john smith",
+		}, &comment)
+		t.AssertNil(err)
+		t.Assert(comment.Title, "你好世界和")
+		t.Assert(*comment.Content, "abc")
+		t.Assert(comment.Data, []byte("xy"))
+		t.Assert(comment.Score, 100)
+		t.Assert(comment.Author, "john smith")
+	})
+	// The values within the maximum length are untouched.
+	gtest.C(t, func(t *gtest.T) {
+		var comment *Comment
+		err := gconv.Scan(g.Map{"title": "hello", "content": "ab"}, &comment)
+		t.AssertNil(err)
+		t.Assert(comment.Title, "hello")
+		t.Assert(*comment.Content, "ab")
+	})
+	// Returning error in strict mode.
+	gtest.C(t, func(t *gtest.T) {
+		var comment *Comment
+		err := gconv.ScanWithOptions(g.Map{"title": "hello", "content": "abc"}, &comment, gconv.ScanOption{
+			MaxLenStrict: true,
+		})
+		t.AssertNil(err)
+		t.Assert(comment.Title, "hello")
+
+		comment = nil
+		err = gconv.ScanWithOptions(g.Map{"title": "hello world"}, &comment, gconv.ScanOption{
+			MaxLenStrict: true,
+		})
+		t.Assert(gerror.Is(err, gconv.ErrMaxLenExceeded), true)
+
+		var fieldErr *gconv.FieldConvertError
+		t.Assert(gerror.As(err, &fieldErr), true)
+		t.Assert(fieldErr.Path, "Title")
+
+		var comments []Comment
+		err = gconv.ScanWithOptions(g.Slice{
+			g.Map{"title": "a"},
+			g.Map{"data": "xyz"},
+		}, &comments, gconv.ScanOption{
+			MaxLenStrict: true,
+		})
+		t.Assert(gerror.Is(err, gconv.ErrMaxLenExceeded), true)
+	})
+}
@@ -0,0 +1,75 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+func TestScan_TagNested(t *testing.T) {
+	type Metadata struct {
+		Title string
+		Tags  []string
+		Size  int64
+	}
+	type Upload struct {
+		Name     string
+		Metadata *Metadata          `json:"metadata" gconv:"nested:json"`
+		Labels   []string           `gconv:"nested:json"`
+		Extra    map[string]any     `c:"extra,nested:json"`
+		Filter   struct{ Page int } `gconv:"nested:form"`
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var upload *Upload
+		err := gconv.Scan(g.Map{
+			"name":     "a.png",
+			"metadata": `{"title":"avatar","tags":["a","b"],"size":1024}`,
+			"labels":   []byte(`["x","y"]`),
+			"extra":    `{"k":1}`,
+			"filter":   `page=2`,
+		}, &upload)
+		t.AssertNil(err)
+		t.Assert(upload.Name, "a.png")
+		t.Assert(upload.Metadata, &Metadata{Title: "avatar", Tags: []string{"a", "b"}, Size: 1024})
+		t.Assert(upload.Labels, []string{"x", "y"})
+		t.Assert(upload.Extra, g.Map{"k": 1})
+		t.Assert(upload.Filter.Page, 2)
+	})
+	// Decoded values are bound as usual.
+	gtest.C(t, func(t *gtest.T) {
+		var upload *Upload
+		err := gconv.Scan(g.Map{
+			"metadata": g.Map{"title": "avatar"},
+			"labels":   "",
+		}, &upload)
+		t.AssertNil(err)
+		t.Assert(upload.Metadata.Title, "avatar")
+		t.AssertNil(upload.Labels)
+	})
+	// Decoding error with field path.
+	gtest.C(t, func(t *gtest.T) {
+		type Form struct {
+			Upload Upload
+		}
+		var form *Form
+		err := gconv.ScanWithOptions(g.Map{
+			"upload": g.Map{
+				"metadata": `{"title":`,
+			},
+		}, &form, gconv.ScanOption{})
+		t.AssertNE(err, nil)
+		t.Assert(gerror.Code(err), gcode.CodeConversionFailed)
+		t.Assert(strings.Contains(err.Error(), `attribute "Upload.Metadata"`), true)
+	})
+}
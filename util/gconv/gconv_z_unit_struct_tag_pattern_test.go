@@ -0,0 +1,87 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+func TestScan_TagPattern(t *testing.T) {
+	type Article struct {
+		Id       int               `json:"id"`
+		Title    map[string]string `gconv:"pattern:title_*"`
+		Summary  *map[string]any   `gconv:"pattern:summary_*_text"`
+		Priority map[string]int    `gconv:"pattern:priority-*"`
+		Extra    map[string]any    `gconv:",remaining"`
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var article *Article
+		err := gconv.Scan(g.Map{
+			"id":                1,
+			"title_en":          "Hello",
+			"title_fr":          "Bonjour",
+			"title_zh-CN":       "你好",
+			"title_":            "empty capture",
+			"summary_en_text":   "greeting",
+			"summary_fr_text":   "salutation",
+			"summary_de":        "gruß",
+			"priority-internal": "2",
+			"priority-public":   1,
+			"author":            "john",
+		}, &article)
+		t.AssertNil(err)
+		t.Assert(article.Id, 1)
+		t.Assert(article.Title, g.MapStrStr{
+			"en":    "Hello",
+			"fr":    "Bonjour",
+			"zh-CN": "你好",
+		})
+		t.Assert(*article.Summary, g.Map{
+			"en": "greeting",
+			"fr": "salutation",
+		})
+		t.Assert(article.Priority, map[string]int{
+			"internal": 2,
+			"public":   1,
+		})
+		// The collected keys are not captured by the remaining attribute.
+		t.Assert(article.Extra, g.Map{
+			"title_":     "empty capture",
+			"summary_de": "gruß",
+			"author":     "john",
+		})
+	})
+	// No matched keys.
+	gtest.C(t, func(t *gtest.T) {
+		var article *Article
+		err := gconv.Scan(g.Map{"id": 1}, &article)
+		t.AssertNil(err)
+		t.Assert(article.Title, nil)
+		t.Assert(article.Summary, nil)
+	})
+	// The tag option is ignored for the attribute that is not map or has no wildcard.
+	gtest.C(t, func(t *gtest.T) {
+		type Invalid struct {
+			Title string            `gconv:"pattern:title_*"`
+			Names map[string]string `gconv:"pattern:name"`
+		}
+		var invalid *Invalid
+		err := gconv.Scan(g.Map{
+			"title":    "Hello",
+			"title_en": "Hello",
+			"names":    g.MapStrStr{"en": "john"},
+			"name_en":  "john",
+		}, &invalid)
+		t.AssertNil(err)
+		t.Assert(invalid.Title, "Hello")
+		t.Assert(invalid.Names, g.MapStrStr{"en": "john"})
+	})
+}
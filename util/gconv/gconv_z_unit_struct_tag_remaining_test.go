@@ -0,0 +1,79 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+func TestScan_TagRemaining(t *testing.T) {
+	type User struct {
+		Id    int            `json:"id"`
+		Name  string         `json:"name"`
+		Extra map[string]any `gconv:",remaining"`
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			user   *User
+			params = g.Map{
+				"id":      1,
+				"name":    "john",
+				"version": "v2",
+				"options": g.Map{"debug": true},
+			}
+		)
+		err := gconv.Scan(params, &user)
+		t.AssertNil(err)
+		t.Assert(user.Id, 1)
+		t.Assert(user.Name, "john")
+		t.Assert(user.Extra, g.Map{
+			"version": "v2",
+			"options": g.Map{"debug": true},
+		})
+		// Round trip.
+		t.Assert(gconv.Map(user), params)
+	})
+	// No unknown keys.
+	gtest.C(t, func(t *gtest.T) {
+		var user *User
+		err := gconv.Scan(g.Map{"id": 1, "name": "john"}, &user)
+		t.AssertNil(err)
+		t.Assert(user.Extra, nil)
+		t.Assert(gconv.Map(user), g.Map{"id": 1, "name": "john"})
+	})
+	// Known attributes have priority over the remaining items.
+	gtest.C(t, func(t *gtest.T) {
+		var user = &User{
+			Id:    1,
+			Name:  "john",
+			Extra: g.Map{"name": "smith", "age": 18},
+		}
+		t.Assert(gconv.Map(user), g.Map{"id": 1, "name": "john", "age": 18})
+	})
+}
+
+func TestScan_TagRemainingStringMap(t *testing.T) {
+	type Header struct {
+		ContentType string            `json:"Content-Type"`
+		Others      map[string]string `c:",remaining"`
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var header Header
+		err := gconv.Scan(g.Map{
+			"Content-Type": "application/json",
+			"X-Trace":      "abc",
+			"X-Retry":      3,
+		}, &header)
+		t.AssertNil(err)
+		t.Assert(header.ContentType, "application/json")
+		t.Assert(header.Others, g.MapStrStr{"X-Trace": "abc", "X-Retry": "3"})
+	})
+}
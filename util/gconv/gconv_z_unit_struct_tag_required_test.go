@@ -0,0 +1,145 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+func TestStruct_TagRequired(t *testing.T) {
+	type User struct {
+		Id    int    `gconv:"id,required"`
+		Name  string `json:"name" gconv:",required"`
+		Email string
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var user *User
+		err := gconv.Scan(g.Map{"id": 1, "name": "john"}, &user)
+		t.AssertNil(err)
+		t.Assert(user.Id, 1)
+		t.Assert(user.Name, "john")
+	})
+	// The zero value is present.
+	gtest.C(t, func(t *gtest.T) {
+		var user *User
+		err := gconv.Scan(g.Map{"id": 0, "name": ""}, &user)
+		t.AssertNil(err)
+		t.Assert(user.Id, 0)
+	})
+	// All missing required attributes are collected.
+	gtest.C(t, func(t *gtest.T) {
+		var user *User
+		err := gconv.Scan(g.Map{"email": "john@goframe.org"}, &user)
+		t.Assert(gerror.Code(err), gcode.CodeMissingParameter)
+		t.Assert(err.Error(), "missing required fields: Id, Name")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var user User
+		err := gconv.Struct(g.Map{"name": "john"}, &user)
+		t.Assert(err.Error(), "missing required fields: Id")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var user User
+		err := gconv.Scan(g.Map{}, &user)
+		t.Assert(err.Error(), "missing required fields: Id, Name")
+	})
+}
+
+func TestStruct_TagRequired_Nested(t *testing.T) {
+	type Address struct {
+		City   string `json:"city" gconv:",required"`
+		Street string `json:"street"`
+	}
+	type User struct {
+		Name    string   `json:"name" gconv:",required"`
+		Address Address  `json:"address" gconv:",required"`
+		Backup  *Address `json:"backup"`
+		Items   []struct {
+			Id int `json:"id" gconv:",required"`
+		} `json:"items"`
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var user User
+		err := gconv.Scan(g.Map{
+			"name":    "john",
+			"address": g.Map{"city": "Shenzhen"},
+			"items":   g.Slice{g.Map{"id": 1}},
+		}, &user)
+		t.AssertNil(err)
+		t.Assert(user.Address.City, "Shenzhen")
+		t.Assert(user.Items[0].Id, 1)
+	})
+	// The optional nested attribute is not checked if it is absent.
+	gtest.C(t, func(t *gtest.T) {
+		var user User
+		err := gconv.Scan(g.Map{"address": g.Map{"street": "Main"}, "backup": g.Map{"street": "Second"}}, &user)
+		t.Assert(err.Error(), "missing required fields: Name, Address.City, Backup.City")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var user User
+		err := gconv.Scan(g.Map{"name": "john"}, &user)
+		t.Assert(err.Error(), "missing required fields: Address")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var user User
+		err := gconv.Scan(g.Map{
+			"name":    "john",
+			"address": g.Map{"city": "Shenzhen"},
+			"items":   g.Slice{g.Map{"id": 1}, g.Map{}},
+		}, &user)
+		t.Assert(err.Error(), "missing required fields: Items.Id")
+	})
+	// Slice of structs.
+	gtest.C(t, func(t *gtest.T) {
+		var addresses []Address
+		err := gconv.Scan(g.Slice{g.Map{"city": "Shenzhen"}, g.Map{"street": "Main"}}, &addresses)
+		t.Assert(err.Error(), "missing required fields: City")
+	})
+}
+
+func TestStruct_TagFlagOptionAsName(t *testing.T) {
+	type Setting struct {
+		Required  bool   `c:"required"`
+		Flags     int    `c:"flags"`
+		Wrapper   string `gconv:"wrapper"`
+		Remaining string `gconv:"remaining"`
+	}
+	// The flag option names as the only item are the attribute names, not the options,
+	// which need a leading char ',' like `c:",required"`.
+	gtest.C(t, func(t *gtest.T) {
+		var setting *Setting
+		err := gconv.Scan(g.Map{}, &setting)
+		t.AssertNil(err)
+		t.Assert(setting.Required, false)
+
+		err = gconv.Scan(g.Map{
+			"required":  true,
+			"flags":     3,
+			"wrapper":   "w",
+			"remaining": "r",
+		}, &setting)
+		t.AssertNil(err)
+		t.Assert(setting.Required, true)
+		t.Assert(setting.Flags, 3)
+		t.Assert(setting.Wrapper, "w")
+		t.Assert(setting.Remaining, "r")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gconv.Map(Setting{Required: true, Flags: 1}), g.Map{
+			"required":  true,
+			"flags":     1,
+			"wrapper":   "",
+			"remaining": "",
+		})
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+// wrapperStringValue is like `wrapperspb.StringValue`, which has unexported fields.
+type wrapperStringValue struct {
+	state int
+	Value string
+}
+
+type wrapperInt64Value struct {
+	Value int64
+}
+
+func TestScan_TagWrapper(t *testing.T) {
+	type Request struct {
+		Name  *wrapperStringValue `json:"name" gconv:",wrapper"`
+		Age   *wrapperInt64Value  `gconv:",wrapper"`
+		Score wrapperInt64Value   `c:"score,wrapper"`
+		Other *wrapperStringValue
+	}
+	// Wrapping scalar values.
+	gtest.C(t, func(t *gtest.T) {
+		var req *Request
+		err := gconv.Scan(g.Map{
+			"name":  "john",
+			"age":   "18",
+			"score": 100,
+		}, &req)
+		t.AssertNil(err)
+		t.Assert(req.Name.Value, "john")
+		t.Assert(req.Age.Value, 18)
+		t.Assert(req.Score.Value, 100)
+		t.Assert(req.Other, nil)
+	})
+	// Nil value and wrapper value.
+	gtest.C(t, func(t *gtest.T) {
+		var req *Request
+		err := gconv.Scan(g.Map{
+			"name": nil,
+			"age":  g.Map{"value": 20},
+		}, &req)
+		t.AssertNil(err)
+		t.Assert(req.Name, nil)
+		t.Assert(req.Age.Value, 20)
+	})
+	// Unwrapping to scalar attributes.
+	gtest.C(t, func(t *gtest.T) {
+		type Entity struct {
+			Name  string `gconv:",wrapper"`
+			Age   int    `gconv:",wrapper"`
+			Score int    `gconv:",wrapper"`
+		}
+		var entity *Entity
+		err := gconv.Scan(g.Map{
+			"name":  &wrapperStringValue{Value: "john"},
+			"age":   (*wrapperInt64Value)(nil),
+			"score": wrapperInt64Value{Value: 100},
+		}, &entity)
+		t.AssertNil(err)
+		t.Assert(entity.Name, "john")
+		t.Assert(entity.Age, 0)
+		t.Assert(entity.Score, 100)
+	})
+	// Struct to struct.
+	gtest.C(t, func(t *gtest.T) {
+		type Entity struct {
+			Name  string `gconv:",wrapper"`
+			Age   int    `gconv:",wrapper"`
+			Score int    `gconv:",wrapper"`
+		}
+		var (
+			entity *Entity
+			req    = &Request{
+				Name:  &wrapperStringValue{Value: "john"},
+				Age:   &wrapperInt64Value{Value: 18},
+				Score: wrapperInt64Value{Value: 100},
+			}
+		)
+		err := gconv.Scan(req, &entity)
+		t.AssertNil(err)
+		t.Assert(entity, &Entity{Name: "john", Age: 18, Score: 100})
+
+		var req2 *Request
+		err = gconv.Scan(entity, &req2)
+		t.AssertNil(err)
+		t.Assert(req2.Name.Value, "john")
+		t.Assert(req2.Age.Value, 18)
+		t.Assert(req2.Score.Value, 100)
+	})
+}
+
+func TestMap_TagWrapper(t *testing.T) {
+	type Request struct {
+		Name  *wrapperStringValue `json:"name" gconv:",wrapper"`
+		Age   *wrapperInt64Value  `gconv:",wrapper"`
+		Other *wrapperInt64Value
+	}
+	gtest.C(t, func(t *gtest.T) {
+		m := gconv.Map(&Request{
+			Name:  &wrapperStringValue{Value: "john"},
+			Other: &wrapperInt64Value{Value: 1},
+		})
+		t.Assert(m["name"], "john")
+		t.Assert(m["Age"], nil)
+		t.Assert(m["Other"].(*wrapperInt64Value).Value, 1)
+	})
+}
@@ -7,10 +7,13 @@
 package gconv_test
 
 import (
+	"errors"
 	"strconv"
 	"testing"
 	"time"
 
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/frame/g"
 	"github.com/gogf/gf/v2/os/gtime"
 	"github.com/gogf/gf/v2/test/gtest"
@@ -345,3 +348,90 @@ func TestStructs(t *testing.T) {
 		}
 	})
 }
+
+func Test_Struct_IndexedKeys(t *testing.T) {
+	type Item struct {
+		Sku   string
+		Count int
+	}
+	type Order struct {
+		Id    int
+		Items []Item
+	}
+	type Form struct {
+		Name   string
+		Tags   []string
+		Items  []*Item
+		Orders []Order
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var form *Form
+		err := gconv.Scan(g.Map{
+			"name":                   "john",
+			"tags[0]":                "a",
+			"tags[1]":                "b",
+			"items[0].sku":           "s0",
+			"items[0].count":         "1",
+			"items[2].sku":           "s2",
+			"orders[0].id":           100,
+			"orders[0].items[1].sku": "o1",
+		}, &form)
+		t.AssertNil(err)
+		t.Assert(form.Name, "john")
+		t.Assert(form.Tags, []string{"a", "b"})
+		t.Assert(len(form.Items), 3)
+		t.Assert(form.Items[0], &Item{Sku: "s0", Count: 1})
+		t.Assert(form.Items[1], &Item{})
+		t.Assert(form.Items[2], &Item{Sku: "s2"})
+		t.Assert(len(form.Orders), 1)
+		t.Assert(form.Orders[0].Id, 100)
+		t.Assert(form.Orders[0].Items, []Item{{}, {Sku: "o1"}})
+	})
+}
+
+type testFieldErrorValue struct {
+	Val string
+}
+
+func (v *testFieldErrorValue) UnmarshalValue(value any) error {
+	if s, ok := value.(string); ok && s == "bad" {
+		return gerror.New("bad value")
+	}
+	v.Val = gconv.String(value)
+	return nil
+}
+
+func Test_Struct_FieldConvertError(t *testing.T) {
+	type ValTA struct {
+		Val testFieldErrorValue
+	}
+	type TAA struct {
+		ValTA ValTA
+	}
+	type Root struct {
+		TAA TAA
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var root *Root
+		err := gconv.ScanWithOptions(g.Map{
+			"TAA": g.Map{"ValTA": g.Map{"Val": "bad"}},
+		}, &root, gconv.ScanOption{ContinueOnError: false})
+		t.AssertNE(err, nil)
+		t.Assert(gerror.Code(err), gcode.CodeConversionFailed)
+		t.Assert(gerror.HasCode(err, gcode.CodeConversionFailed), true)
+		t.Assert(err.Error(), `convert value of type "string" to attribute "TAA.ValTA.Val" failed: bad value`)
+
+		var fieldErr *gconv.FieldConvertError
+		t.Assert(errors.As(err, &fieldErr), true)
+		t.Assert(fieldErr.Path, "TAA.ValTA.Val")
+		t.Assert(fieldErr.SrcType, "string")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var root *Root
+		err := gconv.Scan(g.Map{
+			"TAA": g.Map{"ValTA": g.Map{"Val": "good"}},
+		}, &root)
+		t.AssertNil(err)
+		t.Assert(root.TAA.ValTA.Val.Val, "good")
+	})
+}
@@ -0,0 +1,94 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+func TestStructsParallel(t *testing.T) {
+	type User struct {
+		Id   int
+		Name string
+	}
+	var params = make([]map[string]any, 1000)
+	for i := range params {
+		params[i] = g.Map{"id": i, "name": gconv.String(i)}
+	}
+	gtest.C(t, func(t *gtest.T) {
+		for _, workers := range []int{0, 1, 3, 8, 2000} {
+			var users []User
+			err := gconv.StructsParallel(params, &users, workers)
+			t.AssertNil(err)
+			t.Assert(len(users), len(params))
+			for i, user := range users {
+				t.Assert(user.Id, i)
+				t.Assert(user.Name, gconv.String(i))
+			}
+		}
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var users []*User
+		err := gconv.StructsParallel(params, &users, 4)
+		t.AssertNil(err)
+		t.Assert(len(users), len(params))
+		t.Assert(users[999].Id, 999)
+	})
+	// Existing elements are reused.
+	gtest.C(t, func(t *gtest.T) {
+		var users = []*User{{Id: 100, Name: "john"}}
+		err := gconv.StructsParallel(g.Slice{g.Map{"id": 1}, g.Map{"id": 2}}, &users, 2)
+		t.AssertNil(err)
+		t.Assert(users[0], &User{Id: 1, Name: "john"})
+		t.Assert(users[1], &User{Id: 2})
+	})
+	// Empty params.
+	gtest.C(t, func(t *gtest.T) {
+		var users []User
+		err := gconv.StructsParallel(g.Slice{}, &users, 2)
+		t.AssertNil(err)
+		t.Assert(len(users), 0)
+	})
+}
+
+func TestStructsParallel_Errors(t *testing.T) {
+	type User struct {
+		Id  int
+		Age int
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			users  = []User{{Id: 100}}
+			params = g.Slice{
+				g.Map{"id": 1, "age": 18},
+				g.Map{"id": 2, "age": g.Slice{1}},
+				g.Map{"id": 3, "age": 20},
+				g.Map{"id": 4, "age": g.Map{"k": "v"}},
+			}
+		)
+		err := gconv.NewConverter().StructsParallel(params, &users, 2)
+		t.AssertNE(err, nil)
+		t.Assert(gerror.Code(err), gcode.CodeConversionFailed)
+
+		var elementErr *gconv.ElementConvertError
+		t.Assert(errors.As(err, &elementErr), true)
+		t.Assert(elementErr.Indexes, g.Slice{1, 3})
+		t.Assert(len(elementErr.Errors), 2)
+		var fieldErr *gconv.FieldConvertError
+		t.Assert(errors.As(elementErr.Errors[0], &fieldErr), true)
+		t.Assert(fieldErr.Path, "Age")
+		// The result slice is not assigned.
+		t.Assert(len(users), 1)
+	})
+}
@@ -0,0 +1,139 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gconv_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+type ToEnvBase struct {
+	Env string
+}
+
+type toEnvUpstream struct {
+	Host   string
+	Weight int
+}
+
+type toEnvConfig struct {
+	ToEnvBase
+	Name      string
+	Server    scanEnvServer
+	Cache     *toEnvUpstream
+	Hosts     []string
+	Upstreams []toEnvUpstream
+	Labels    map[string]string
+	StartAt   time.Time
+	Secret    string `json:"-"`
+	secret    string
+}
+
+func TestToEnv(t *testing.T) {
+	var startAt = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	gtest.C(t, func(t *gtest.T) {
+		envMap := gconv.ToEnv(&toEnvConfig{
+			ToEnvBase: ToEnvBase{Env: "dev"},
+			Name:      "demo",
+			Server:    scanEnvServer{Port: 8000, MaxBodySize: 1024, Debug: true},
+			Hosts:     []string{"a", "b"},
+			Upstreams: []toEnvUpstream{{Host: "h1", Weight: 1}},
+			Labels:    map[string]string{"k": "v"},
+			StartAt:   startAt,
+			Secret:    "x",
+			secret:    "y",
+		}, "APP")
+		t.Assert(envMap, map[string]string{
+			"APP_ENV":                  "dev",
+			"APP_NAME":                 "demo",
+			"APP_SERVER_PORT":          "8000",
+			"APP_SERVER_MAX_BODY_SIZE": "1024",
+			"APP_SERVER_DBG":           "true",
+			"APP_HOSTS":                "a,b",
+			"APP_UPSTREAMS":            `[{"Host":"h1","Weight":1}]`,
+			"APP_LABELS":               `{"k":"v"}`,
+			"APP_START_AT":             "2024-01-02T03:04:05Z",
+		})
+	})
+	// Index style and custom separators.
+	gtest.C(t, func(t *gtest.T) {
+		envMap := gconv.ToEnv(toEnvConfig{
+			Cache:     &toEnvUpstream{Host: "c"},
+			Hosts:     []string{"a", "b"},
+			Upstreams: []toEnvUpstream{{Host: "h1", Weight: 1}, {Host: "h2", Weight: 2}},
+		}, "", gconv.ToEnvOption{Separator: "__", SliceStyle: gconv.EnvSliceStyleIndex})
+		t.Assert(envMap["CACHE__HOST"], "c")
+		t.Assert(envMap["CACHE__WEIGHT"], "0")
+		t.Assert(envMap["HOSTS__0"], "a")
+		t.Assert(envMap["HOSTS__1"], "b")
+		t.Assert(envMap["UPSTREAMS__0__HOST"], "h1")
+		t.Assert(envMap["UPSTREAMS__1__WEIGHT"], "2")
+		t.Assert(envMap["ENV"], "")
+		_, ok := envMap["HOSTS"]
+		t.Assert(ok, false)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		envMap := gconv.ToEnv(toEnvConfig{Hosts: []string{"a", "b"}}, "APP_", gconv.ToEnvOption{
+			SliceSeparator: ";",
+		})
+		t.Assert(envMap["APP_HOSTS"], "a;b")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		t.AssertNil(gconv.ToEnv(nil, "APP"))
+		t.AssertNil(gconv.ToEnv(1, "APP"))
+		t.AssertNil(gconv.ToEnv((*toEnvConfig)(nil), "APP"))
+	})
+}
+
+func TestToEnv_RoundTrip(t *testing.T) {
+	var config = toEnvConfig{
+		ToEnvBase: ToEnvBase{Env: "prod"},
+		Name:      "demo",
+		Server:    scanEnvServer{Port: 8000, MaxBodySize: 1024, Debug: true},
+		Cache:     &toEnvUpstream{Host: "c", Weight: 3},
+		Hosts:     []string{"a", "b"},
+		Upstreams: []toEnvUpstream{{Host: "h1", Weight: 1}, {Host: "h2", Weight: 2}},
+		Labels:    map[string]string{"k": "v"},
+		StartAt:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.Local),
+	}
+	// Join style.
+	gtest.C(t, func(t *gtest.T) {
+		var result *toEnvConfig
+		err := gconv.ScanEnv(gconv.ToEnv(config, "APP"), &result, gconv.ScanEnvOption{
+			Prefix:         "APP",
+			SliceSeparator: ",",
+		})
+		t.AssertNil(err)
+		t.Assert(result, config)
+	})
+	// Index style.
+	gtest.C(t, func(t *gtest.T) {
+		var result *toEnvConfig
+		err := gconv.ScanEnv(gconv.ToEnv(config, "APP", gconv.ToEnvOption{
+			Separator:  ".",
+			SliceStyle: gconv.EnvSliceStyleIndex,
+		}), &result, gconv.ScanEnvOption{
+			Prefix:    "APP",
+			Separator: ".",
+		})
+		t.AssertNil(err)
+		t.Assert(result, config)
+	})
+	// Empty slice.
+	gtest.C(t, func(t *gtest.T) {
+		var result toEnvConfig
+		err := gconv.ScanEnv(gconv.ToEnv(toEnvConfig{Hosts: []string{}}, "APP"), &result, gconv.ScanEnvOption{
+			Prefix:         "APP",
+			SliceSeparator: ",",
+		})
+		t.AssertNil(err)
+		t.Assert(len(result.Hosts), 0)
+	})
+}
@@ -9,6 +9,8 @@ package converter
 
 import (
 	"reflect"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/gogf/gf/v2/errors/gcode"
@@ -34,13 +36,33 @@ type (
 	converterFunc    = reflect.Value
 )
 
+// ConverterInfo is the information of registered custom converter.
+type ConverterInfo struct {
+	FromType reflect.Type // The input parameter type of converter function, which is not pointer.
+	ToType   reflect.Type // The output parameter type of converter function, which is pointer.
+}
+
 // Converter implements the interface Converter.
 type Converter struct {
-	internalConverter    *structcache.Converter
-	typeConverterFuncMap map[converterInType]map[converterOutType]converterFunc
+	internalConverter     *structcache.Converter
+	typeConverterFuncMap  map[converterInType]map[converterOutType]converterFunc
+	defaultProviderMap    map[reflect.Type]reflect.Value         // Lazy default value providers keyed by attribute type.
+	flagsMappingMap       map[reflect.Type]map[string]uint64     // Bitflags name mappings keyed by attribute type.
+	namedConverterMap     map[string]reflect.Value               // Converter functions keyed by name for tag option `converter`.
+	fieldsCacheMap        sync.Map                               // Cached attribute descriptors keyed by struct type.
+	protoTimestampTypeMap map[reflect.Type]*protoTimestampFields // Attribute indexes keyed by registered protobuf Timestamp type.
 }
 
 var (
+	// stringType is the reflection type of string, which is used for scalar source converter searching.
+	stringType = reflect.TypeOf("")
+
+	// int64Type, uint64Type and float64Type are the reflection types of the widest numeric types,
+	// which are used for numeric source converter searching.
+	int64Type   = reflect.TypeOf(int64(0))
+	uint64Type  = reflect.TypeOf(uint64(0))
+	float64Type = reflect.TypeOf(float64(0))
+
 	// Empty strings.
 	emptyStringMap = map[string]struct{}{
 		"":      {},
@@ -54,8 +76,12 @@ var (
 // NewConverter creates and returns management object for type converting.
 func NewConverter() *Converter {
 	cf := &Converter{
-		internalConverter:    structcache.NewConverter(),
-		typeConverterFuncMap: make(map[converterInType]map[converterOutType]converterFunc),
+		internalConverter:     structcache.NewConverter(),
+		typeConverterFuncMap:  make(map[converterInType]map[converterOutType]converterFunc),
+		defaultProviderMap:    make(map[reflect.Type]reflect.Value),
+		flagsMappingMap:       make(map[reflect.Type]map[string]uint64),
+		namedConverterMap:     make(map[string]reflect.Value),
+		protoTimestampTypeMap: make(map[reflect.Type]*protoTimestampFields),
 	}
 	cf.registerBuiltInAnyConvertFunc()
 	return cf
@@ -126,6 +152,58 @@ func (c *Converter) RegisterTypeConverterFunc(f any) (err error) {
 	return
 }
 
+// UnregisterConverter removes the custom converter registered for converting `fromType` to `toType`.
+// The `toType` can be either the pointer type as registered or its element type.
+// It returns true if the converter is found and removed.
+func (c *Converter) UnregisterConverter(fromType, toType reflect.Type) bool {
+	if fromType == nil || toType == nil {
+		return false
+	}
+	if toType.Kind() != reflect.Pointer {
+		toType = reflect.PointerTo(toType)
+	}
+	registeredOutTypeMap, ok := c.typeConverterFuncMap[fromType]
+	if !ok {
+		return false
+	}
+	if _, ok = registeredOutTypeMap[toType]; !ok {
+		return false
+	}
+	delete(registeredOutTypeMap, toType)
+	if len(registeredOutTypeMap) == 0 {
+		delete(c.typeConverterFuncMap, fromType)
+	}
+	// It unmarks the output type only if there's no other converter for the same output type.
+	for _, outTypeMap := range c.typeConverterFuncMap {
+		if _, ok = outTypeMap[toType]; ok {
+			return true
+		}
+	}
+	c.internalConverter.UnmarkTypeConvertFunc(toType)
+	return true
+}
+
+// ListConverters returns all the registered custom converters, which are sorted by
+// their input and output type names.
+func (c *Converter) ListConverters() []ConverterInfo {
+	var infos = make([]ConverterInfo, 0)
+	for inType, outTypeMap := range c.typeConverterFuncMap {
+		for outType := range outTypeMap {
+			infos = append(infos, ConverterInfo{
+				FromType: inType,
+				ToType:   outType,
+			})
+		}
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].FromType.String() != infos[j].FromType.String() {
+			return infos[i].FromType.String() < infos[j].FromType.String()
+		}
+		return infos[i].ToType.String() < infos[j].ToType.String()
+	})
+	return infos
+}
+
 // RegisterAnyConverterFunc registers custom type converting function for specified types.
 func (c *Converter) RegisterAnyConverterFunc(convertFunc AnyConvertFunc, types ...reflect.Type) {
 	for _, t := range types {
@@ -177,4 +255,10 @@ func (c *Converter) registerBuiltInAnyConvertFunc() {
 	c.RegisterAnyConverterFunc(
 		c.builtInAnyConvertFuncForGTime, gtimeType,
 	)
+	c.RegisterAnyConverterFunc(
+		c.builtInAnyConvertFuncForBigInt, reflectTypeBigInt,
+	)
+	c.RegisterAnyConverterFunc(
+		c.builtInAnyConvertFuncForBigFloat, reflectTypeBigFloat,
+	)
 }
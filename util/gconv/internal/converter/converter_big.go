@@ -0,0 +1,181 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+var (
+	reflectTypeBigInt   = reflect.TypeOf(big.Int{})
+	reflectTypeBigFloat = reflect.TypeOf(big.Float{})
+)
+
+// bigInt converts `anyInput` to *big.Int without precision loss.
+// The string value is parsed in base 10, and the float value must be an integer.
+func (c *Converter) bigInt(anyInput any) (*big.Int, error) {
+	switch value := anyInput.(type) {
+	case big.Int:
+		return new(big.Int).Set(&value), nil
+	case *big.Int:
+		return new(big.Int).Set(value), nil
+	case big.Float:
+		return c.bigInt(&value)
+	case *big.Float:
+		if !value.IsInt() {
+			return nil, gerror.NewCodef(gcode.CodeInvalidParameter, `cannot convert non-integer "%s" to big.Int`, value.String())
+		}
+		v, _ := value.Int(nil)
+		return v, nil
+	case int, int8, int16, int32, int64:
+		i, err := c.Int64(value)
+		if err != nil {
+			return nil, err
+		}
+		return big.NewInt(i), nil
+	case uint, uint8, uint16, uint32, uint64:
+		u, err := c.Uint64(value)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetUint64(u), nil
+	case float32, float64:
+		f, err := c.Float64(value)
+		if err != nil {
+			return nil, err
+		}
+		if math.IsNaN(f) || math.IsInf(f, 0) || f != math.Trunc(f) {
+			return nil, gerror.NewCodef(gcode.CodeInvalidParameter, `cannot convert non-integer "%v" to big.Int`, f)
+		}
+		v, _ := big.NewFloat(f).Int(nil)
+		return v, nil
+	case []byte:
+		return c.bigInt(string(value))
+	case string:
+		s := strings.TrimSpace(value)
+		if s == "" {
+			return new(big.Int), nil
+		}
+		v, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return nil, gerror.NewCodef(gcode.CodeInvalidParameter, `invalid big.Int value "%s"`, value)
+		}
+		return v, nil
+	default:
+		s, err := c.String(anyInput)
+		if err != nil {
+			return nil, err
+		}
+		return c.bigInt(s)
+	}
+}
+
+// bigFloat converts `anyInput` to *big.Float.
+// The precision of the result parsed from string is large enough to hold all the digits of it.
+func (c *Converter) bigFloat(anyInput any) (*big.Float, error) {
+	switch value := anyInput.(type) {
+	case big.Float:
+		return new(big.Float).Copy(&value), nil
+	case *big.Float:
+		return new(big.Float).Copy(value), nil
+	case big.Int:
+		return c.bigFloat(&value)
+	case *big.Int:
+		return new(big.Float).SetPrec(bigFloatPrec(value.BitLen())).SetInt(value), nil
+	case int, int8, int16, int32, int64:
+		i, err := c.Int64(value)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Float).SetInt64(i), nil
+	case uint, uint8, uint16, uint32, uint64:
+		u, err := c.Uint64(value)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Float).SetUint64(u), nil
+	case float32, float64:
+		f, err := c.Float64(value)
+		if err != nil {
+			return nil, err
+		}
+		if math.IsNaN(f) {
+			return nil, gerror.NewCode(gcode.CodeInvalidParameter, `cannot convert NaN to big.Float`)
+		}
+		return new(big.Float).SetFloat64(f), nil
+	case []byte:
+		return c.bigFloat(string(value))
+	case string:
+		s := strings.TrimSpace(value)
+		if s == "" {
+			return new(big.Float), nil
+		}
+		// Each decimal digit needs less than 4 bits.
+		v, _, err := big.ParseFloat(s, 10, bigFloatPrec(len(s)*4), big.ToNearestEven)
+		if err != nil {
+			return nil, gerror.WrapCodef(gcode.CodeInvalidParameter, err, `invalid big.Float value "%s"`, value)
+		}
+		return v, nil
+	default:
+		s, err := c.String(anyInput)
+		if err != nil {
+			return nil, err
+		}
+		return c.bigFloat(s)
+	}
+}
+
+// bigFloatPrec returns the precision for big.Float holding `bits` bits, which is 64 at least.
+func bigFloatPrec(bits int) uint {
+	if bits < 64 {
+		return 64
+	}
+	return uint(bits)
+}
+
+func (c *Converter) builtInAnyConvertFuncForBigInt(from any, to reflect.Value) error {
+	v, err := c.bigInt(from)
+	if err != nil {
+		return err
+	}
+	to.Addr().Interface().(*big.Int).Set(v)
+	return nil
+}
+
+func (c *Converter) builtInAnyConvertFuncForBigFloat(from any, to reflect.Value) error {
+	v, err := c.bigFloat(from)
+	if err != nil {
+		return err
+	}
+	to.Addr().Interface().(*big.Float).Copy(v)
+	return nil
+}
+
+// bindBigNumber converts `value` and binds it to `reflectValue` if `reflectValue` is type of
+// big.Int/big.Float. It returns false if `reflectValue` is not a big number type.
+func (c *Converter) bindBigNumber(reflectValue reflect.Value, value any) (ok bool, err error) {
+	if v, isReflectValue := value.(reflect.Value); isReflectValue {
+		if !v.IsValid() {
+			return false, nil
+		}
+		value = v.Interface()
+	}
+	switch reflectValue.Type() {
+	case reflectTypeBigInt:
+		return true, c.builtInAnyConvertFuncForBigInt(value, reflectValue)
+	case reflectTypeBigFloat:
+		return true, c.builtInAnyConvertFuncForBigFloat(value, reflectValue)
+	default:
+		return false, nil
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/gogf/gf/v2/internal/json"
 	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/util/gconv/internal/structcache"
 )
 
 // ConvertOption is the option for converting.
@@ -395,14 +396,31 @@ func (c *Converter) doConvertForDefault(in doConvertInput, option ConvertOption)
 		}
 
 		// custom converter.
-		dstReflectValue, ok, err := c.callCustomConverterWithRefer(fromReflectValue, referReflectValue)
-		if err != nil {
-			return nil, err
+		dstReflectValue, ok, customErr := c.callCustomConverterWithRefer(fromReflectValue, referReflectValue)
+		if customErr != nil {
+			return nil, customErr
 		}
 		if ok {
 			return dstReflectValue.Interface(), nil
 		}
 
+		// Custom unmarshalling interfaces, eg: encoding.TextUnmarshaler.
+		// The value of the same type is assigned directly in the following logic.
+		if fromReflectValue.IsValid() && !fromReflectValue.Type().AssignableTo(referReflectValue.Type()) &&
+			structcache.IsCommonInterfaceType(referReflectValue.Type()) {
+			dstReflectValue = reflect.New(referReflectValue.Type()).Elem()
+			if dstReflectValue.Kind() == reflect.Pointer {
+				dstReflectValue.Set(reflect.New(dstReflectValue.Type().Elem()))
+			}
+			ok, err = bindVarToReflectValueWithInterfaceCheck(dstReflectValue, fromReflectValue.Interface())
+			if ok {
+				if err != nil {
+					return nil, err
+				}
+				return dstReflectValue.Interface(), nil
+			}
+		}
+
 		defer func() {
 			if recover() != nil {
 				in.alreadySetToReferValue = false
@@ -476,7 +494,7 @@ func (c *Converter) doConvertWithReflectValueSet(reflectValue reflect.Value, in
 // callCustomConverter call the custom converter. It will try some possible type.
 func (c *Converter) callCustomConverter(srcReflectValue, dstReflectValue reflect.Value) (converted bool, err error) {
 	// search type converter function.
-	registeredConverterFunc, srcType, ok := c.getRegisteredTypeConverterFuncAndSrcType(srcReflectValue, dstReflectValue)
+	registeredConverterFunc, srcReflectValue, srcType, ok := c.searchTypeConverterFunc(srcReflectValue, dstReflectValue)
 	if ok {
 		return c.doCallCustomTypeConverter(srcReflectValue, dstReflectValue, registeredConverterFunc, srcType)
 	}
@@ -497,7 +515,7 @@ func (c *Converter) callCustomConverterWithRefer(
 	srcReflectValue, referReflectValue reflect.Value,
 ) (dstReflectValue reflect.Value, converted bool, err error) {
 	// search type converter function.
-	registeredConverterFunc, srcType, ok := c.getRegisteredTypeConverterFuncAndSrcType(
+	registeredConverterFunc, srcReflectValue, srcType, ok := c.searchTypeConverterFunc(
 		srcReflectValue, referReflectValue,
 	)
 	if ok {
@@ -519,6 +537,69 @@ func (c *Converter) callCustomConverterWithRefer(
 	return dstReflectValue, true, nil
 }
 
+// searchTypeConverterFunc searches the registered type converter function for given source and destination.
+//
+// If there's no converter function registered for the source type, and the source is a numeric value,
+// it then searches the converter function registered for the widest type of its kind, that is int64,
+// uint64 or float64, and returns the source value converted to the type. This makes converters like
+// `func(int64) (*Level, error)` also work for the elements of type int in heterogeneous []any.
+//
+// If there's still no converter function, and the source is a scalar value like integer, float or
+// named string type (eg: json.Number), it then searches the converter function registered for type
+// string, and returns the source value converted to string. This makes converters like
+// `func(string) (*decimal.Decimal, error)` also work for numeric sources without precision loss
+// of float converting.
+func (c *Converter) searchTypeConverterFunc(
+	srcReflectValue, dstReflectValueForRefer reflect.Value,
+) (f converterFunc, srcValue reflect.Value, srcType reflect.Type, ok bool) {
+	f, srcType, ok = c.getRegisteredTypeConverterFuncAndSrcType(srcReflectValue, dstReflectValueForRefer)
+	if ok || !srcReflectValue.IsValid() {
+		return f, srcReflectValue, srcType, ok
+	}
+	switch srcReflectValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		if widestType := getWidestNumericType(srcReflectValue.Kind()); srcReflectValue.Type() != widestType {
+			srcValue = srcReflectValue.Convert(widestType)
+			if f, srcType, ok = c.getRegisteredTypeConverterFuncAndSrcType(srcValue, dstReflectValueForRefer); ok {
+				return f, srcValue, srcType, true
+			}
+		}
+	case reflect.String:
+		if srcReflectValue.Type() == stringType {
+			return f, srcReflectValue, srcType, false
+		}
+	default:
+		return f, srcReflectValue, srcType, false
+	}
+	if _, ok = c.typeConverterFuncMap[stringType]; !ok {
+		return f, srcReflectValue, srcType, false
+	}
+	s, err := c.String(srcReflectValue.Interface())
+	if err != nil {
+		return f, srcReflectValue, srcType, false
+	}
+	srcValue = reflect.ValueOf(s)
+	if f, srcType, ok = c.getRegisteredTypeConverterFuncAndSrcType(srcValue, dstReflectValueForRefer); !ok {
+		return f, srcReflectValue, srcType, false
+	}
+	return f, srcValue, srcType, true
+}
+
+// getWidestNumericType returns the widest numeric type of numeric kind `kind`, that is int64 for the
+// signed integers, uint64 for the unsigned integers and float64 for the floats.
+func getWidestNumericType(kind reflect.Kind) reflect.Type {
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return uint64Type
+	case reflect.Float32, reflect.Float64:
+		return float64Type
+	default:
+		return int64Type
+	}
+}
+
 func (c *Converter) getRegisteredTypeConverterFuncAndSrcType(
 	srcReflectValue, dstReflectValueForRefer reflect.Value,
 ) (f converterFunc, srcType reflect.Type, ok bool) {
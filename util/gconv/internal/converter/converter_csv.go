@@ -0,0 +1,233 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/util/gconv/internal/structcache"
+)
+
+// defaultCSVTimeLayout is the default layout for time cells of CSV row.
+const defaultCSVTimeLayout = "2006-01-02 15:04:05"
+
+// CSVOption is the option for converting between struct and CSV row.
+type CSVOption struct {
+	// TimeLayout specifies the layout for formatting and parsing the time attributes,
+	// which is "2006-01-02 15:04:05" in default.
+	TimeLayout string
+
+	// Location specifies the location for parsing the time cells without time zone,
+	// which is time.Local in default.
+	Location *time.Location
+}
+
+// CSVHeader retrieves and returns the column names of struct type `structType` in order of
+// attribute declaration, which are the converting keys from tags like gconv/c/json, or the attribute
+// names if no tag. The attributes of embedded struct without tag are flattened.
+// The `structType` can be a reflect.Type, or a struct/*struct value.
+func (c *Converter) CSVHeader(structType any) ([]string, error) {
+	cachedStructInfo, err := c.getCSVStructInfo(structType)
+	if err != nil {
+		return nil, err
+	}
+	var header = make([]string, 0)
+	for _, cachedFieldInfo := range cachedStructInfo.GetFieldConvertInfos() {
+		header = append(header, cachedFieldInfo.PriorityTagAndFieldName[0])
+	}
+	return header, nil
+}
+
+// ToCSVRow converts struct `value` to CSV row, the cells of which are the attribute values in order
+// of column names `fields`. It uses the columns of CSVHeader if `fields` is empty.
+//
+// The column name can be the converting key or the attribute name, and the cell of unknown column
+// or nil attribute is empty. The time attributes are formatted using CSVOption.TimeLayout, and the
+// others are converted using String, in which the float numbers are never formatted in exponent.
+func (c *Converter) ToCSVRow(value any, fields []string, option ...CSVOption) ([]string, error) {
+	var (
+		usedOption   = getCSVOption(option...)
+		reflectValue = reflect.ValueOf(value)
+	)
+	for reflectValue.Kind() == reflect.Pointer {
+		if reflectValue.IsNil() {
+			return nil, gerror.NewCode(gcode.CodeInvalidParameter, `the struct value cannot be nil`)
+		}
+		reflectValue = reflectValue.Elem()
+	}
+	cachedStructInfo, err := c.getCSVStructInfo(reflectValue)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		fields, _ = c.CSVHeader(reflectValue)
+	}
+	var row = make([]string, len(fields))
+	for i, field := range fields {
+		cachedFieldInfo := getCSVFieldInfo(cachedStructInfo, field)
+		if cachedFieldInfo == nil {
+			continue
+		}
+		// It does not use GetFieldReflectValueFrom, which initializes the nil embedded struct.
+		fieldValue, err := reflectValue.FieldByIndexErr(cachedFieldInfo.FieldIndexes)
+		if err != nil {
+			continue
+		}
+		if row[i], err = c.formatCSVCell(fieldValue, usedOption); err != nil {
+			return nil, gerror.WrapCodef(
+				gcode.CodeInvalidParameter, err, `format cell of column "%s" failed`, field,
+			)
+		}
+	}
+	return row, nil
+}
+
+// FromCSVRow converts CSV row `row` to struct `dstPointer`, the cells of which are bound to the
+// attributes in order of column names `fields`. It uses the columns of CSVHeader if `fields` is empty.
+//
+// The empty cells and the cells out of `fields` are ignored, so the attributes keep their default
+// values. The time cells are parsed using CSVOption.TimeLayout, or in the common time formats if it
+// fails, and the other cells are converted like Scan.
+func (c *Converter) FromCSVRow(row []string, dstPointer any, fields []string, option ...CSVOption) error {
+	var (
+		usedOption   = getCSVOption(option...)
+		reflectValue = reflect.ValueOf(dstPointer)
+	)
+	if reflectValue.Kind() != reflect.Pointer || reflectValue.IsNil() {
+		return gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`destination pointer should be type of non-nil pointer, but got: %v`,
+			reflectValue.Type(),
+		)
+	}
+	cachedStructInfo, err := c.getCSVStructInfo(reflectValue.Type())
+	if err != nil {
+		return err
+	}
+	if len(fields) == 0 {
+		fields, _ = c.CSVHeader(reflectValue.Type())
+	}
+	var params = make(map[string]any, len(fields))
+	for i, field := range fields {
+		if i >= len(row) {
+			break
+		}
+		if row[i] == "" {
+			continue
+		}
+		var cell any = row[i]
+		if cachedFieldInfo := getCSVFieldInfo(cachedStructInfo, field); cachedFieldInfo != nil {
+			if t, ok := parseCSVTimeCell(cachedFieldInfo.StructField.Type, row[i], usedOption); ok {
+				cell = t
+			}
+		}
+		params[field] = cell
+	}
+	return c.Scan(params, dstPointer)
+}
+
+// getCSVOption returns the CSVOption with default values.
+func getCSVOption(option ...CSVOption) CSVOption {
+	var usedOption CSVOption
+	if len(option) > 0 {
+		usedOption = option[0]
+	}
+	if usedOption.TimeLayout == "" {
+		usedOption.TimeLayout = defaultCSVTimeLayout
+	}
+	if usedOption.Location == nil {
+		usedOption.Location = time.Local
+	}
+	return usedOption
+}
+
+// getCSVStructInfo retrieves and returns the cached struct info of struct type `structType`.
+func (c *Converter) getCSVStructInfo(structType any) (*structcache.CachedStructInfo, error) {
+	var reflectType reflect.Type
+	switch v := structType.(type) {
+	case reflect.Type:
+		reflectType = v
+	case reflect.Value:
+		reflectType = v.Type()
+	default:
+		reflectType = reflect.TypeOf(structType)
+	}
+	for reflectType != nil && reflectType.Kind() == reflect.Pointer {
+		reflectType = reflectType.Elem()
+	}
+	if reflectType == nil || reflectType.Kind() != reflect.Struct {
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`the struct type should be type of struct/*struct, but given "%v"`,
+			reflectType,
+		)
+	}
+	cachedStructInfo := c.internalConverter.GetCachedStructInfo(reflectType, "")
+	if cachedStructInfo == nil {
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidParameter, `the struct type "%v" has no attribute for CSV`, reflectType,
+		)
+	}
+	return cachedStructInfo, nil
+}
+
+// getCSVFieldInfo returns the attribute info of column `field`, which is the converting key or the
+// attribute name. It returns nil if no attribute matches.
+func getCSVFieldInfo(cachedStructInfo *structcache.CachedStructInfo, field string) *structcache.CachedFieldInfo {
+	if cachedFieldInfo := cachedStructInfo.GetFieldInfo(field); cachedFieldInfo != nil {
+		return cachedFieldInfo
+	}
+	for _, cachedFieldInfo := range cachedStructInfo.GetFieldConvertInfos() {
+		if cachedFieldInfo.FieldName() == field {
+			return cachedFieldInfo
+		}
+	}
+	return nil
+}
+
+// formatCSVCell converts attribute value `fieldValue` to the cell of CSV row.
+func (c *Converter) formatCSVCell(fieldValue reflect.Value, option CSVOption) (string, error) {
+	for fieldValue.Kind() == reflect.Pointer || fieldValue.Kind() == reflect.Interface {
+		if fieldValue.IsNil() {
+			return "", nil
+		}
+		fieldValue = fieldValue.Elem()
+	}
+	switch fieldValue.Type() {
+	case reflectTypeTime:
+		if t := fieldValue.Interface().(time.Time); !t.IsZero() {
+			return t.Format(option.TimeLayout), nil
+		}
+		return "", nil
+	case reflectTypeGTime:
+		if t := fieldValue.Interface().(gtime.Time); !t.IsZero() {
+			return t.Time.Format(option.TimeLayout), nil
+		}
+		return "", nil
+	}
+	return c.String(fieldValue.Interface())
+}
+
+// parseCSVTimeCell parses `cell` using the time layout of `option` if `fieldType` is a time type.
+// It returns false if `fieldType` is not a time type or the parsing fails.
+func parseCSVTimeCell(fieldType reflect.Type, cell string, option CSVOption) (time.Time, bool) {
+	for fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	if fieldType != reflectTypeTime && fieldType != reflectTypeGTime {
+		return time.Time{}, false
+	}
+	t, err := time.ParseInLocation(option.TimeLayout, cell, option.Location)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,111 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/util/gconv/internal/structcache"
+)
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// RegisterDefaultProvider registers lazy default value provider, which supplies the value for the
+// struct attribute of type `T` if the attribute is still zero after converting.
+//
+// The parameter `fn` must be defined as pattern `func(context.Context) (T, error)`, and it is called
+// only when the attribute is missing in the source or converted to zero value.
+// It is suggested to do it in boot procedure of the process.
+func (c *Converter) RegisterDefaultProvider(fn any) (err error) {
+	var fReflectType = reflect.TypeOf(fn)
+	if fReflectType == nil || fReflectType.Kind() != reflect.Func ||
+		fReflectType.NumIn() != 1 || fReflectType.NumOut() != 2 ||
+		fReflectType.In(0) != contextType ||
+		!fReflectType.Out(1).Implements(errorType) {
+		return gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			"parameter must be type of default provider function and defined as pattern "+
+				"`func(context.Context) (T, error)`, but defined as `%v`",
+			fReflectType,
+		)
+	}
+	outType := fReflectType.Out(0)
+	if _, ok := c.defaultProviderMap[outType]; ok {
+		return gerror.NewCodef(
+			gcode.CodeInvalidOperation,
+			"the default provider for type `%s` has already been registered",
+			outType.String(),
+		)
+	}
+	c.defaultProviderMap[outType] = reflect.ValueOf(fn)
+	return nil
+}
+
+// UnregisterDefaultProvider removes the default provider registered for type `t`.
+// It returns true if the provider is found and removed.
+func (c *Converter) UnregisterDefaultProvider(t reflect.Type) bool {
+	if _, ok := c.defaultProviderMap[t]; !ok {
+		return false
+	}
+	delete(c.defaultProviderMap, t)
+	return true
+}
+
+// bindStructWithDefaultProviders calls the registered default providers for the zero attributes of
+// `structValue`. The attribute of type `*T` that is nil is also supplied by provider of type `T`.
+func (c *Converter) bindStructWithDefaultProviders(
+	structValue reflect.Value,
+	cachedStructInfo *structcache.CachedStructInfo,
+	option StructOption,
+) error {
+	var ctx = option.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	for _, cachedFieldInfo := range cachedStructInfo.GetFieldConvertInfos() {
+		var (
+			fieldValue = cachedFieldInfo.GetFieldReflectValueFrom(structValue)
+			fieldType  = fieldValue.Type()
+			isPointer  bool
+		)
+		if !fieldValue.IsZero() || !fieldValue.CanSet() {
+			continue
+		}
+		provider, ok := c.defaultProviderMap[fieldType]
+		if !ok && fieldType.Kind() == reflect.Pointer {
+			provider, ok = c.defaultProviderMap[fieldType.Elem()]
+			isPointer = true
+		}
+		if !ok {
+			continue
+		}
+		results := provider.Call([]reflect.Value{reflect.ValueOf(ctx)})
+		if !results[1].IsNil() {
+			if option.ContinueOnError {
+				continue
+			}
+			return gerror.WrapCodef(
+				gcode.CodeOperationFailed, results[1].Interface().(error),
+				`default provider failed for attribute "%s"`, cachedFieldInfo.FieldName(),
+			)
+		}
+		if isPointer {
+			pointer := reflect.New(fieldType.Elem())
+			pointer.Elem().Set(results[0])
+			fieldValue.Set(pointer)
+		} else {
+			fieldValue.Set(results[0])
+		}
+	}
+	return nil
+}
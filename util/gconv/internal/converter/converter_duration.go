@@ -0,0 +1,202 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// DurationStyle is the format style of human-readable duration string.
+type DurationStyle string
+
+const (
+	DurationStyleDefault DurationStyle = ""      // Go format, eg: 26h30m0s.
+	DurationStyleShort   DurationStyle = "short" // Eg: 1d 2h 30m.
+	DurationStyleLong    DurationStyle = "long"  // Eg: 1 day 2 hours 30 minutes.
+)
+
+const durationDay = 24 * time.Hour
+
+// durationUnit is the unit of human-readable duration string.
+type durationUnit struct {
+	duration time.Duration
+	short    string // Unit name of DurationStyleShort.
+	long     string // Singular unit name of DurationStyleLong.
+}
+
+var (
+	// durationFormatUnits are the units for formatting the duration not less than one second.
+	durationFormatUnits = []durationUnit{
+		{durationDay, "d", "day"},
+		{time.Hour, "h", "hour"},
+		{time.Minute, "m", "minute"},
+	}
+
+	// durationParseUnits maps the unit names in lower case to the unit durations for parsing.
+	durationParseUnits = map[string]time.Duration{
+		"ns": time.Nanosecond, "nanosecond": time.Nanosecond, "nanoseconds": time.Nanosecond,
+		"us": time.Microsecond, "µs": time.Microsecond, "μs": time.Microsecond,
+		"microsecond": time.Microsecond, "microseconds": time.Microsecond,
+		"ms": time.Millisecond, "millisecond": time.Millisecond, "milliseconds": time.Millisecond,
+		"s": time.Second, "sec": time.Second, "secs": time.Second, "second": time.Second, "seconds": time.Second,
+		"m": time.Minute, "min": time.Minute, "mins": time.Minute, "minute": time.Minute, "minutes": time.Minute,
+		"h": time.Hour, "hr": time.Hour, "hrs": time.Hour, "hour": time.Hour, "hours": time.Hour,
+		"d": durationDay, "day": durationDay, "days": durationDay,
+		"w": 7 * durationDay, "wk": 7 * durationDay, "wks": 7 * durationDay,
+		"week": 7 * durationDay, "weeks": 7 * durationDay,
+	}
+)
+
+// DurationString formats duration `d` as human-readable string in `style`, which can be parsed back
+// by ParseDuration. The days are the largest unit, and the zero units are omitted.
+//
+// Eg: 26*time.Hour + 30*time.Minute is formatted as "1d 2h 30m" in DurationStyleShort, and as
+// "1 day 2 hours 30 minutes" in DurationStyleLong. It uses d.String() for DurationStyleDefault.
+func DurationString(d time.Duration, style DurationStyle) string {
+	if style != DurationStyleShort && style != DurationStyleLong {
+		return d.String()
+	}
+	var (
+		parts = make([]string, 0, 4)
+		sign  string
+		// The duration is converted to uint64, as the absolute value of math.MinInt64 overflows int64.
+		rest = uint64(d)
+	)
+	if d < 0 {
+		sign = "-"
+		rest = -rest
+	}
+	if rest < uint64(time.Second) {
+		return sign + formatSubSecondDuration(rest, style)
+	}
+	for _, unit := range durationFormatUnits {
+		if count := rest / uint64(unit.duration); count > 0 {
+			parts = append(parts, formatDurationPart(strconv.FormatUint(count, 10), count == 1, unit, style))
+			rest %= uint64(unit.duration)
+		}
+	}
+	if rest > 0 {
+		// The seconds may have fraction, eg: 1.5s.
+		seconds := strconv.FormatFloat(float64(rest)/float64(time.Second), 'f', -1, 64)
+		parts = append(parts, formatDurationPart(
+			seconds, seconds == "1", durationUnit{time.Second, "s", "second"}, style,
+		))
+	}
+	return sign + strings.Join(parts, " ")
+}
+
+// formatSubSecondDuration formats the duration `d` less than one second in `style`.
+func formatSubSecondDuration(d uint64, style DurationStyle) string {
+	if style == DurationStyleShort {
+		return time.Duration(d).String()
+	}
+	var unit = durationUnit{time.Nanosecond, "ns", "nanosecond"}
+	switch {
+	case d == 0:
+		unit = durationUnit{time.Second, "s", "second"}
+	case d%uint64(time.Millisecond) == 0:
+		unit = durationUnit{time.Millisecond, "ms", "millisecond"}
+	case d%uint64(time.Microsecond) == 0:
+		unit = durationUnit{time.Microsecond, "µs", "microsecond"}
+	}
+	count := d / uint64(unit.duration)
+	return formatDurationPart(strconv.FormatUint(count, 10), count == 1, unit, style)
+}
+
+// formatDurationPart formats the part of duration string with number `count` of `unit`.
+func formatDurationPart(count string, singular bool, unit durationUnit, style DurationStyle) string {
+	if style == DurationStyleShort {
+		return count + unit.short
+	}
+	if singular {
+		return count + " " + unit.long
+	}
+	return count + " " + unit.long + "s"
+}
+
+// ParseDuration parses duration string `s`, which supports the Go format like "1h30m" and "1.5h",
+// and the human-readable forms like "2 days", "1w 2d", "1 hour 30 minutes" and "3h, 20 mins".
+// The units are case-insensitive, and the numbers can have fraction. The string of pure integer
+// is parsed as nanoseconds like Duration.
+//
+// The supported units are: ns, us/µs, ms, s/sec, m/min, h/hr, d/day and w/wk/week, along with their
+// full names in singular or plural form.
+func ParseDuration(s string) (time.Duration, error) {
+	var trimmed = strings.TrimSpace(s)
+	if trimmed == "" {
+		return 0, gerror.NewCode(gcode.CodeInvalidParameter, `empty duration string`)
+	}
+	if i, err := strconv.ParseInt(trimmed, 10, 64); err == nil {
+		return time.Duration(i), nil
+	}
+	if d, err := time.ParseDuration(trimmed); err == nil {
+		return d, nil
+	}
+	var (
+		negative bool
+		total    float64
+		rest     = strings.ToLower(trimmed)
+		parts    int
+	)
+	switch rest[0] {
+	case '-':
+		negative = true
+		rest = rest[1:]
+	case '+':
+		rest = rest[1:]
+	}
+	for {
+		rest = strings.TrimLeft(rest, " \t,")
+		if strings.HasPrefix(rest, "and ") {
+			rest = strings.TrimLeft(rest[4:], " \t")
+		}
+		if rest == "" {
+			break
+		}
+		// Number.
+		var i = 0
+		for i < len(rest) && (rest[i] == '.' || (rest[i] >= '0' && rest[i] <= '9')) {
+			i++
+		}
+		number, err := strconv.ParseFloat(rest[:i], 64)
+		if i == 0 || err != nil {
+			return 0, gerror.NewCodef(gcode.CodeInvalidParameter, `invalid duration string "%s"`, s)
+		}
+		rest = strings.TrimLeft(rest[i:], " \t")
+		// Unit.
+		i = 0
+		for i < len(rest) && rest[i] != ' ' && rest[i] != '\t' && rest[i] != ',' &&
+			rest[i] != '.' && (rest[i] < '0' || rest[i] > '9') {
+			i++
+		}
+		unit, ok := durationParseUnits[rest[:i]]
+		if !ok {
+			return 0, gerror.NewCodef(
+				gcode.CodeInvalidParameter, `unknown unit "%s" in duration string "%s"`, rest[:i], s,
+			)
+		}
+		rest = rest[i:]
+		total += number * float64(unit)
+		parts++
+	}
+	if parts == 0 {
+		return 0, gerror.NewCodef(gcode.CodeInvalidParameter, `invalid duration string "%s"`, s)
+	}
+	if total >= math.MaxInt64 {
+		return 0, gerror.NewCodef(gcode.CodeInvalidParameter, `duration string "%s" overflows`, s)
+	}
+	if negative {
+		return -time.Duration(math.Round(total)), nil
+	}
+	return time.Duration(math.Round(total)), nil
+}
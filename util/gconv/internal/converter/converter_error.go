@@ -0,0 +1,100 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// FieldConvertError is the error for converting value to struct attribute, which carries
+// the full attribute path from the root struct and the type of the source value.
+// Its error code is gcode.CodeConversionFailed.
+type FieldConvertError struct {
+	Path    string // Attribute path from the root struct, eg: `User.Address.City`.
+	SrcType string // Type of the source value that failed converting.
+	err     error  // Underlying error.
+}
+
+// newFieldConvertError creates and returns a FieldConvertError for attribute `fieldName`.
+// If `err` is already a FieldConvertError of nested struct, it prefixes its path with `fieldName`.
+func newFieldConvertError(err error, fieldName string, srcValue any) error {
+	var fieldErr *FieldConvertError
+	if errors.As(err, &fieldErr) {
+		fieldErr.Path = fieldName + "." + fieldErr.Path
+		return fieldErr
+	}
+	var srcType string
+	switch v := srcValue.(type) {
+	case reflect.Value:
+		if v.IsValid() {
+			srcType = v.Type().String()
+		}
+	default:
+		srcType = fmt.Sprintf("%T", srcValue)
+	}
+	return &FieldConvertError{
+		Path:    fieldName,
+		SrcType: srcType,
+		err:     err,
+	}
+}
+
+// Error implements the interface of Error, it returns the error message with attribute path.
+func (e *FieldConvertError) Error() string {
+	return fmt.Sprintf(
+		`convert value of type "%s" to attribute "%s" failed: %s`,
+		e.SrcType, e.Path, e.err.Error(),
+	)
+}
+
+// Code returns the error code gcode.CodeConversionFailed.
+func (e *FieldConvertError) Code() gcode.Code {
+	return gcode.CodeConversionFailed
+}
+
+// Unwrap returns the underlying error.
+func (e *FieldConvertError) Unwrap() error {
+	return e.err
+}
+
+// Stack returns the error stack of the underlying error.
+func (e *FieldConvertError) Stack() string {
+	return gerror.Stack(e.err)
+}
+
+// ElementConvertError is the error for converting elements of slice, which carries the errors
+// of all the failed elements with their indexes in the source slice, ordered by index.
+// Its error code is gcode.CodeConversionFailed.
+type ElementConvertError struct {
+	Indexes []int   // Indexes of the failed elements in the source slice.
+	Errors  []error // Errors of the failed elements, which are in the same order as Indexes.
+}
+
+// Error implements the interface of Error, it returns the error messages of all failed elements.
+func (e *ElementConvertError) Error() string {
+	var messages = make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = fmt.Sprintf(`convert element at index %d failed: %s`, e.Indexes[i], err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Code returns the error code gcode.CodeConversionFailed.
+func (e *ElementConvertError) Code() gcode.Code {
+	return gcode.CodeConversionFailed
+}
+
+// Unwrap returns the errors of all failed elements.
+func (e *ElementConvertError) Unwrap() []error {
+	return e.Errors
+}
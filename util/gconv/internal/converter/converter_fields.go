@@ -0,0 +1,121 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/util/gconv/internal/structcache"
+	"github.com/gogf/gf/v2/util/gtag"
+)
+
+// FieldInfo is the descriptor of struct attribute, which is retrieved by Fields.
+type FieldInfo struct {
+	Name       string            // Attribute name, eg: UserName.
+	Key        string            // Converting key from tags like gconv/c/json, or the attribute name if no tag.
+	Kind       reflect.Kind      // Kind of the attribute type, which is dereferenced if it is pointer.
+	Type       reflect.Type      // Type of the attribute.
+	Default    string            // Default value specified by tag option, eg: `gconv:"default:10"`.
+	HasDefault bool              // Whether the default value is specified by tag option.
+	Required   bool              // Whether the attribute is required by tag option, eg: `gconv:",required"`.
+	Label      string            // Label from tag `description/des/dc`, which is usually used for display.
+	Options    map[string]string // All the converting tag options, eg: {"default": "10"}.
+	Tag        reflect.StructTag // Raw tag of the attribute.
+	Fields     []FieldInfo       // Descriptors of nested struct, or element struct of slice/array/map attribute.
+}
+
+// labelTags are the tags for the label of FieldInfo in priority.
+var labelTags = []string{gtag.Description, gtag.DescriptionShort, gtag.DescriptionShort2}
+
+// Fields retrieves and returns the descriptors of attributes of struct type `structType` recursively,
+// using the same tag parsing as Scan, in which the attributes of embedded struct without tag are
+// flattened. The `structType` can be a reflect.Type, or a struct/*struct value.
+//
+// The result is cached for each struct type, which should not be modified.
+func (c *Converter) Fields(structType any) ([]FieldInfo, error) {
+	var reflectType reflect.Type
+	switch v := structType.(type) {
+	case reflect.Type:
+		reflectType = v
+	case reflect.Value:
+		reflectType = v.Type()
+	default:
+		reflectType = reflect.TypeOf(structType)
+	}
+	for reflectType != nil && reflectType.Kind() == reflect.Pointer {
+		reflectType = reflectType.Elem()
+	}
+	if reflectType == nil || reflectType.Kind() != reflect.Struct {
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`the struct type should be type of struct/*struct, but given "%v"`,
+			reflectType,
+		)
+	}
+	if v, ok := c.fieldsCacheMap.Load(reflectType); ok {
+		return v.([]FieldInfo), nil
+	}
+	var fields = c.doFields(reflectType, make(map[reflect.Type]struct{}))
+	c.fieldsCacheMap.Store(reflectType, fields)
+	return fields, nil
+}
+
+// doFields makes and returns the descriptors of attributes of struct type `structType`.
+// The `parentTypes` are the struct types of the parent attributes, which stops the recursion
+// for the self-referential struct types.
+func (c *Converter) doFields(structType reflect.Type, parentTypes map[reflect.Type]struct{}) []FieldInfo {
+	var (
+		cachedStructInfo = c.internalConverter.GetCachedStructInfo(structType, "")
+		fields           = make([]FieldInfo, 0)
+	)
+	if cachedStructInfo == nil {
+		return fields
+	}
+	parentTypes[structType] = struct{}{}
+	defer delete(parentTypes, structType)
+	for _, cachedFieldInfo := range cachedStructInfo.GetFieldConvertInfos() {
+		var (
+			field = FieldInfo{
+				Name:       cachedFieldInfo.FieldName(),
+				Key:        cachedFieldInfo.PriorityTagAndFieldName[0],
+				Type:       cachedFieldInfo.StructField.Type,
+				Default:    cachedFieldInfo.DefaultValue,
+				HasDefault: cachedFieldInfo.HasDefaultValue,
+				Required:   cachedFieldInfo.IsRequired,
+				Options:    structcache.ParseTagOptions(cachedFieldInfo.StructField),
+				Tag:        cachedFieldInfo.StructField.Tag,
+			}
+			elemType = field.Type
+		)
+		for elemType.Kind() == reflect.Pointer {
+			elemType = elemType.Elem()
+		}
+		field.Kind = elemType.Kind()
+		for _, tag := range labelTags {
+			if field.Label = field.Tag.Get(tag); field.Label != "" {
+				break
+			}
+		}
+		for {
+			switch elemType.Kind() {
+			case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+				elemType = elemType.Elem()
+				continue
+			}
+			break
+		}
+		if elemType.Kind() == reflect.Struct && hasExportedField(elemType) {
+			if _, ok := parentTypes[elemType]; !ok {
+				field.Fields = c.doFields(elemType, parentTypes)
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
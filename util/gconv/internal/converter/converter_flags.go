@@ -0,0 +1,189 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// FlagsOption is the option for converting flag names to bitflags.
+type FlagsOption struct {
+	// IgnoreUnknown specifies whether to ignore the names that are not in the mapping.
+	// It returns error for unknown names in default.
+	IgnoreUnknown bool
+}
+
+// FlagsToNames converts bitflags `flags` to the flag names according to `mapping`.
+// The name whose flag value is zero is ignored, and the name whose flag value has multiple bits
+// is contained only if all its bits are set in `flags`.
+// The returned names are sorted by flag value and then name.
+func FlagsToNames(flags uint64, mapping map[string]uint64) []string {
+	var names = make([]string, 0)
+	for name, flag := range mapping {
+		if flag != 0 && flags&flag == flag {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if mapping[names[i]] != mapping[names[j]] {
+			return mapping[names[i]] < mapping[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// NamesToFlags converts flag names `names` to bitflags according to `mapping`.
+// The empty names are ignored.
+func NamesToFlags(names []string, mapping map[string]uint64, option ...FlagsOption) (uint64, error) {
+	var (
+		flags      uint64
+		usedOption FlagsOption
+	)
+	if len(option) > 0 {
+		usedOption = option[0]
+	}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		flag, ok := mapping[name]
+		if !ok {
+			if usedOption.IgnoreUnknown {
+				continue
+			}
+			return 0, gerror.NewCodef(gcode.CodeInvalidParameter, `unknown flag name "%s"`, name)
+		}
+		flags |= flag
+	}
+	return flags, nil
+}
+
+// RegisterFlagsMapping registers the flag names mapping for the bitflags attributes of integer type `t`,
+// which are specified by tag option `flags`, eg: `gconv:",flags"`.
+// It is suggested to do it in boot procedure of the process.
+func (c *Converter) RegisterFlagsMapping(t reflect.Type, mapping map[string]uint64) (err error) {
+	if t == nil {
+		return gerror.NewCode(gcode.CodeInvalidParameter, "the flags type should not be nil")
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+	default:
+		return gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			"the flags type should be integer type, but given `%s`",
+			t.String(),
+		)
+	}
+	if _, ok := c.flagsMappingMap[t]; ok {
+		return gerror.NewCodef(
+			gcode.CodeInvalidOperation,
+			"the flags mapping for type `%s` has already been registered",
+			t.String(),
+		)
+	}
+	var copiedMapping = make(map[string]uint64, len(mapping))
+	for name, flag := range mapping {
+		copiedMapping[name] = flag
+	}
+	c.flagsMappingMap[t] = copiedMapping
+	return nil
+}
+
+// UnregisterFlagsMapping removes the flag names mapping registered for type `t`.
+// It returns true if the mapping is found and removed.
+func (c *Converter) UnregisterFlagsMapping(t reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if _, ok := c.flagsMappingMap[t]; !ok {
+		return false
+	}
+	delete(c.flagsMappingMap, t)
+	return true
+}
+
+// bindVarToFlagsField binds the flag names `srcValue` to the bitflags attribute `fieldValue`.
+// The `srcValue` can be a slice of names or a string of names separated by char ','.
+// It returns false if `srcValue` is not flag names, like the numeric value, which is bound
+// using the common converting.
+func (c *Converter) bindVarToFlagsField(
+	fieldValue reflect.Value, srcValue any, option StructOption,
+) (ok bool, err error) {
+	var names []string
+	switch v := srcValue.(type) {
+	case string:
+		if _, parseErr := strconv.ParseUint(v, 10, 64); parseErr == nil {
+			return false, nil
+		}
+		names = strings.Split(v, ",")
+	case []byte:
+		return c.bindVarToFlagsField(fieldValue, string(v), option)
+	default:
+		switch reflect.Indirect(reflect.ValueOf(srcValue)).Kind() {
+		case reflect.Slice, reflect.Array:
+			if names, err = c.SliceStr(srcValue, SliceOption{ContinueOnError: option.ContinueOnError}); err != nil {
+				return false, err
+			}
+		default:
+			return false, nil
+		}
+	}
+	var flagsType = fieldValue.Type()
+	for flagsType.Kind() == reflect.Pointer {
+		flagsType = flagsType.Elem()
+	}
+	mapping, found := c.flagsMappingMap[flagsType]
+	if !found {
+		return false, gerror.NewCodef(
+			gcode.CodeInvalidConfiguration,
+			"no flags mapping registered for type `%s`",
+			flagsType.String(),
+		)
+	}
+	flags, err := NamesToFlags(names, mapping, FlagsOption{IgnoreUnknown: option.IgnoreUnknownFlags})
+	if err != nil {
+		return false, err
+	}
+	for fieldValue.Kind() == reflect.Pointer {
+		if fieldValue.IsNil() {
+			fieldValue.Set(reflect.New(fieldValue.Type().Elem()))
+		}
+		fieldValue = fieldValue.Elem()
+	}
+	switch fieldValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if fieldValue.OverflowInt(int64(flags)) || int64(flags) < 0 {
+			return false, gerror.NewCodef(
+				gcode.CodeInvalidParameter, "flags value %d overflows type `%s`", flags, flagsType.String(),
+			)
+		}
+		fieldValue.SetInt(int64(flags))
+	default:
+		if fieldValue.OverflowUint(flags) {
+			return false, gerror.NewCodef(
+				gcode.CodeInvalidParameter, "flags value %d overflows type `%s`", flags, flagsType.String(),
+			)
+		}
+		fieldValue.SetUint(flags)
+	}
+	return true, nil
+}
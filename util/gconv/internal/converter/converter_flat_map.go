@@ -0,0 +1,123 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/gogf/gf/v2/internal/utils"
+)
+
+// FlatMap converts `value` to a flat map[string]any, the keys of which are the paths of leaf values
+// in `value`. The keys of nested map/struct are joined using char '.', and the indexes of slice/array
+// are in bracket notation, eg:
+//
+//	{"user": {"name": "john", "tags": ["a", "b"]}}
+//	=>
+//	{"user.name": "john", "user.tags[0]": "a", "user.tags[1]": "b"}
+//
+// The empty nested map/slice is kept as a leaf value, so that the flat map can be converted back
+// losslessly using function Scan.
+func (c *Converter) FlatMap(value any, option ...MapOption) (map[string]any, error) {
+	var usedOption = c.getMapOption(option...)
+	usedOption.Deep = true
+	dataMap, err := c.Map(value, usedOption)
+	if err != nil || dataMap == nil {
+		return nil, err
+	}
+	var flatMap = make(map[string]any, len(dataMap))
+	for k, v := range dataMap {
+		doFlatMapValue(flatMap, k, v)
+	}
+	return flatMap, nil
+}
+
+// doFlatMapValue puts `value` into `flatMap` with key `key`, or with the paths prefixed by `key`
+// recursively if `value` is a non-empty map/slice/array.
+func doFlatMapValue(flatMap map[string]any, key string, value any) {
+	var reflectValue = reflect.ValueOf(value)
+	switch reflectValue.Kind() {
+	case reflect.Map:
+		if reflectValue.Len() == 0 || reflectValue.Type().Key().Kind() != reflect.String {
+			break
+		}
+		var iter = reflectValue.MapRange()
+		for iter.Next() {
+			doFlatMapValue(flatMap, key+"."+iter.Key().String(), iter.Value().Interface())
+		}
+		return
+
+	case reflect.Slice, reflect.Array:
+		// The bytes are treated as a leaf value.
+		if reflectValue.Len() == 0 || reflectValue.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		for i := 0; i < reflectValue.Len(); i++ {
+			doFlatMapValue(flatMap, key+"["+strconv.Itoa(i)+"]", reflectValue.Index(i).Interface())
+		}
+		return
+
+	default:
+	}
+	flatMap[key] = value
+}
+
+// hasFlatMapKey checks and returns whether `data` has any key in format of FlatMap.
+func hasFlatMapKey(data map[string]any) bool {
+	for k := range data {
+		for i := 0; i < len(k); i++ {
+			if k[i] == '.' || k[i] == '[' {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// unflatMap converts the keys in format of FlatMap of `data` to nested maps/slices, and returns
+// a new map containing the nested values. The original keys are also kept in the returned map,
+// as they might be the names of struct tags.
+func unflatMap(data map[string]any) map[string]any {
+	var result = make(map[string]any, len(data))
+	for k, v := range data {
+		result[k] = v
+	}
+	for k, v := range data {
+		segments := utils.ParseIndexedKey(k)
+		if len(segments) < 2 {
+			continue
+		}
+		// The root of path is always a map key.
+		result[segments[0].Name] = setFlatMapPath(result[segments[0].Name], segments[1:], v)
+	}
+	return result
+}
+
+// setFlatMapPath sets `value` to `container` by `segments`, which creates the nested maps/slices
+// if necessary, and returns the container that might be newly created. The indexes of `segments`
+// are limited by utils.ParseIndexedKey, so that the slice allocation is bounded.
+func setFlatMapPath(container any, segments []utils.IndexedKeySegment, value any) any {
+	if len(segments) == 0 {
+		return value
+	}
+	var segment = segments[0]
+	if segment.Index < 0 {
+		m, ok := container.(map[string]any)
+		if !ok {
+			m = make(map[string]any)
+		}
+		m[segment.Name] = setFlatMapPath(m[segment.Name], segments[1:], value)
+		return m
+	}
+	array, _ := container.([]any)
+	if len(array) <= segment.Index {
+		array = append(array, make([]any, segment.Index+1-len(array))...)
+	}
+	array[segment.Index] = setFlatMapPath(array[segment.Index], segments[1:], value)
+	return array
+}
@@ -7,6 +7,7 @@
 package converter
 
 import (
+	"math"
 	"reflect"
 	"strconv"
 
@@ -17,8 +18,32 @@ import (
 	"github.com/gogf/gf/v2/util/gconv/internal/localinterface"
 )
 
+// FloatOption is the option for float converting.
+type FloatOption struct {
+	// Strict specifies rejecting the special float strings like "Inf", "+Infinity" and "NaN",
+	// which returns error ErrSpecialFloat. The special float strings in standard Go representations
+	// are accepted in default.
+	Strict bool
+}
+
+var (
+	// ErrSpecialFloat is the error that indicates the special float string like "Inf" and "NaN"
+	// is rejected in strict float converting.
+	ErrSpecialFloat = gerror.NewWithOption(gerror.Option{
+		Text: "special float value is not allowed",
+		Code: gcode.CodeInvalidParameter,
+	})
+)
+
+func (c *Converter) getFloatOption(option ...FloatOption) FloatOption {
+	if len(option) > 0 {
+		return option[0]
+	}
+	return FloatOption{}
+}
+
 // Float32 converts `any` to float32.
-func (c *Converter) Float32(anyInput any) (float32, error) {
+func (c *Converter) Float32(anyInput any, option ...FloatOption) (float32, error) {
 	if empty.IsNil(anyInput) {
 		return 0, nil
 	}
@@ -45,7 +70,7 @@ func (c *Converter) Float32(anyInput any) (float32, error) {
 			}
 			return 0, nil
 		case reflect.String:
-			f, err := strconv.ParseFloat(rv.String(), 32)
+			f, err := parseFloatString(rv.String(), 32, c.getFloatOption(option...))
 			if err != nil {
 				return 0, gerror.WrapCodef(
 					gcode.CodeInvalidParameter, err, "converting string to float32 failed for: %v", anyInput,
@@ -59,7 +84,7 @@ func (c *Converter) Float32(anyInput any) (float32, error) {
 			if f, ok := value.(localinterface.IFloat32); ok {
 				return f.Float32(), nil
 			}
-			return c.Float32(rv.Elem().Interface())
+			return c.Float32(rv.Elem().Interface(), option...)
 		default:
 			if f, ok := value.(localinterface.IFloat32); ok {
 				return f.Float32(), nil
@@ -68,7 +93,7 @@ func (c *Converter) Float32(anyInput any) (float32, error) {
 			if err != nil {
 				return 0, err
 			}
-			v, err := strconv.ParseFloat(s, 32)
+			v, err := parseFloatString(s, 32, c.getFloatOption(option...))
 			if err != nil {
 				return 0, gerror.WrapCodef(
 					gcode.CodeInvalidParameter, err, "converting string to float32 failed for: %v", anyInput,
@@ -80,7 +105,7 @@ func (c *Converter) Float32(anyInput any) (float32, error) {
 }
 
 // Float64 converts `any` to float64.
-func (c *Converter) Float64(anyInput any) (float64, error) {
+func (c *Converter) Float64(anyInput any, option ...FloatOption) (float64, error) {
 	if empty.IsNil(anyInput) {
 		return 0, nil
 	}
@@ -112,7 +137,7 @@ func (c *Converter) Float64(anyInput any) (float64, error) {
 			}
 			return 0, nil
 		case reflect.String:
-			f, err := strconv.ParseFloat(rv.String(), 64)
+			f, err := parseFloatString(rv.String(), 64, c.getFloatOption(option...))
 			if err != nil {
 				return 0, gerror.WrapCodef(
 					gcode.CodeInvalidParameter, err, "converting string to float64 failed for: %v", anyInput,
@@ -126,7 +151,7 @@ func (c *Converter) Float64(anyInput any) (float64, error) {
 			if f, ok := value.(localinterface.IFloat64); ok {
 				return f.Float64(), nil
 			}
-			return c.Float64(rv.Elem().Interface())
+			return c.Float64(rv.Elem().Interface(), option...)
 		default:
 			if f, ok := value.(localinterface.IFloat64); ok {
 				return f.Float64(), nil
@@ -135,7 +160,7 @@ func (c *Converter) Float64(anyInput any) (float64, error) {
 			if err != nil {
 				return 0, err
 			}
-			v, err := strconv.ParseFloat(s, 64)
+			v, err := parseFloatString(s, 64, c.getFloatOption(option...))
 			if err != nil {
 				return 0, gerror.WrapCodef(
 					gcode.CodeInvalidParameter, err, "converting string to float64 failed for: %v", anyInput,
@@ -145,3 +170,38 @@ func (c *Converter) Float64(anyInput any) (float64, error) {
 		}
 	}
 }
+
+// parseFloatString parses float string `s` in `bitSize`, in which the special float strings like "Inf"
+// and "NaN" are rejected if option.Strict is set.
+func parseFloatString(s string, bitSize int, option FloatOption) (float64, error) {
+	f, err := strconv.ParseFloat(s, bitSize)
+	if err != nil {
+		return 0, err
+	}
+	if option.Strict && (math.IsInf(f, 0) || math.IsNaN(f)) {
+		return 0, ErrSpecialFloat
+	}
+	return f, nil
+}
+
+// checkStrictFloatField checks whether `srcValue` is special float string for float attribute `fieldValue`,
+// which returns error ErrSpecialFloat if it is.
+func (c *Converter) checkStrictFloatField(fieldValue reflect.Value, srcValue any) error {
+	var fieldType = fieldValue.Type()
+	for fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	switch fieldType.Kind() {
+	case reflect.Float32, reflect.Float64:
+	default:
+		return nil
+	}
+	if reflect.Indirect(reflect.ValueOf(srcValue)).Kind() != reflect.String {
+		return nil
+	}
+	_, err := c.Float64(srcValue, FloatOption{Strict: true})
+	if gerror.Is(err, ErrSpecialFloat) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"github.com/gogf/gf/v2/internal/json"
+	"github.com/gogf/gf/v2/util/gconv/internal/localinterface"
+)
+
+// JsonBytes converts `value` to JSON bytes using the map converting of the converter,
+// which means the keys of struct attributes are named in tag priority like Map function,
+// and the attributes having registered string converters are encoded as converted strings.
+// The `value` is always converted recursively, no matter option.Deep is set or not.
+func (c *Converter) JsonBytes(value any, option ...MapOption) ([]byte, error) {
+	if value == nil {
+		return json.Marshal(nil)
+	}
+	if v, ok := value.(localinterface.IVal); ok {
+		value = v.Val()
+	}
+	if s, ok := c.convertValueWithStringConverter(value); ok {
+		return json.Marshal(s)
+	}
+	var usedOption = c.getMapOption(option...)
+	usedOption.Deep = true
+	usedOption.Tags = getPriorityTags(usedOption.Tags)
+	usedOption.visitedRecorder = newMapVisitedRecorder()
+	converted, err := c.doMapConvertForMapOrStructValue(doMapConvertForMapOrStructValueInput{
+		IsRoot:          true,
+		Value:           value,
+		RecursiveType:   RecursiveTypeTrue,
+		RecursiveOption: true,
+		Option:          usedOption,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(converted)
+}
+
+// ScanJson decodes JSON `data` and converts the decoded value to `dstPointer` using Scan function,
+// which means the keys in `data` are bound to struct attributes in tag priority and fuzzy matching,
+// and the registered converters are used for attribute converting.
+//
+// The JSON null resets the matched attribute to its zero value, eg: nil for pointer attribute,
+// and the attribute of absent key is left unchanged. Use ScanJsonPresence if it needs distinguishing
+// JSON null from absent key for the attributes that are already zero values.
+//
+// The attribute of type json.RawMessage or []byte captures the raw bytes of the matched object or array
+// in `data` without decoding, which is useful for deferred or polymorphic handling of sub-documents.
+func (c *Converter) ScanJson(data []byte, dstPointer any, option ...ScanOption) (err error) {
+	var usedOption = c.getScanOption(option...)
+	usedOption.bindNil = true
+	var decoded any
+	if err = json.UnmarshalUseNumber(data, &decoded); err != nil {
+		return err
+	}
+	var jsonRaw = newJsonRawRecorder(data, decoded)
+	usedOption.recorders = usedOption.recorders.with(func(recorders *structRecorders) {
+		recorders.jsonRaw = jsonRaw
+	})
+	return c.Scan(decoded, dstPointer, usedOption)
+}
+
+// ScanJsonPresence does the same as ScanJson, but it also returns the paths of struct attributes having
+// matched keys in `data` like ScanPresence, in which the attributes of JSON null are reported present.
+func (c *Converter) ScanJsonPresence(
+	data []byte, dstPointer any, option ...ScanOption,
+) (present map[string]bool, err error) {
+	var (
+		usedOption = c.getScanOption(option...)
+		recorder   = &scanPresenceRecorder{
+			present: make(map[string]bool),
+		}
+	)
+	usedOption.recorders = usedOption.recorders.with(func(recorders *structRecorders) {
+		recorders.presence = recorder
+	})
+	err = c.ScanJson(data, dstPointer, usedOption)
+	return recorder.present, err
+}
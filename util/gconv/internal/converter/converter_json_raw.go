@@ -0,0 +1,144 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+	"sync"
+	"unsafe"
+
+	"github.com/gogf/gf/v2/internal/json"
+)
+
+var (
+	// rawMessageType is the reflection type of json.RawMessage.
+	rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+
+	// bytesType is the reflection type of []byte.
+	bytesType = reflect.TypeOf([]byte(nil))
+)
+
+// jsonRawRecorder maps the objects and arrays decoded by ScanJson to their raw JSON bytes,
+// which is used for binding the raw sub-documents to json.RawMessage or []byte attributes.
+// The mapping is built lazily at the first lookup, as most structs have no such attributes.
+type jsonRawRecorder struct {
+	data  []byte
+	value any
+	once  sync.Once
+	raws  map[unsafe.Pointer]json.RawMessage
+}
+
+// newJsonRawRecorder creates and returns a jsonRawRecorder for JSON `data` that is decoded as `value`.
+func newJsonRawRecorder(data []byte, value any) *jsonRawRecorder {
+	return &jsonRawRecorder{
+		data:  data,
+		value: value,
+	}
+}
+
+// Lookup returns the raw JSON bytes of the decoded object or array `value`.
+func (r *jsonRawRecorder) Lookup(value any) (json.RawMessage, bool) {
+	key := jsonRawKey(value)
+	if key == nil {
+		return nil, false
+	}
+	r.once.Do(func() {
+		r.raws = make(map[unsafe.Pointer]json.RawMessage)
+		r.record(r.data, r.value)
+	})
+	raw, ok := r.raws[key]
+	return raw, ok
+}
+
+// record walks the raw JSON `data` along with its decoded `value`, and records the raw bytes
+// of all the objects and arrays.
+func (r *jsonRawRecorder) record(data []byte, value any) {
+	switch v := value.(type) {
+	case map[string]any:
+		var rawMap map[string]json.RawMessage
+		if json.Unmarshal(data, &rawMap) != nil {
+			return
+		}
+		r.raws[jsonRawKey(v)] = data
+		for key, item := range v {
+			r.record(rawMap[key], item)
+		}
+
+	case []any:
+		var rawArray []json.RawMessage
+		if json.Unmarshal(data, &rawArray) != nil || len(rawArray) != len(v) {
+			return
+		}
+		if key := jsonRawKey(v); key != nil {
+			r.raws[key] = data
+		}
+		for i, item := range v {
+			r.record(rawArray[i], item)
+		}
+	}
+}
+
+// jsonRawKey returns the identity of decoded object or array `value`.
+// It returns nil if `value` is neither an object nor a non-empty array.
+func jsonRawKey(value any) unsafe.Pointer {
+	switch v := value.(type) {
+	case map[string]any:
+		return reflect.ValueOf(v).UnsafePointer()
+	case []any:
+		if len(v) > 0 {
+			return unsafe.Pointer(&v[0])
+		}
+	}
+	return nil
+}
+
+// bindVarToJsonRawField binds `srcValue` to json.RawMessage attribute `fieldValue`, or []byte
+// attribute in ScanJson. The object and array from ScanJson are bound as their raw JSON bytes,
+// and the other values that are not string are bound as their JSON encoding.
+// It returns false if `fieldValue` is not such attribute.
+func (c *Converter) bindVarToJsonRawField(
+	fieldValue reflect.Value, srcValue any, jsonRaw *jsonRawRecorder,
+) (ok bool, err error) {
+	var fieldType = fieldValue.Type()
+	for fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	var isRawMessage = fieldType == rawMessageType
+	if !isRawMessage && (fieldType != bytesType || jsonRaw == nil) {
+		return false, nil
+	}
+	var raw []byte
+	if jsonRaw != nil {
+		raw, ok = jsonRaw.Lookup(srcValue)
+	}
+	if !ok {
+		switch srcValue.(type) {
+		case string, []byte:
+			// The string value is bound as it is by common converting, which is compatible
+			// with the JSON string in ScanJson for json.RawMessage attribute.
+			if !isRawMessage || jsonRaw == nil {
+				return false, nil
+			}
+		default:
+			if !isRawMessage {
+				return false, nil
+			}
+		}
+		if raw, err = json.Marshal(srcValue); err != nil {
+			return false, err
+		}
+	}
+	for fieldValue.Kind() == reflect.Pointer {
+		if fieldValue.IsNil() {
+			fieldValue.Set(reflect.New(fieldValue.Type().Elem()))
+		}
+		fieldValue = fieldValue.Elem()
+	}
+	// The raw bytes are copied as `data` of ScanJson might be reused by caller.
+	fieldValue.SetBytes(append([]byte(nil), raw...))
+	return true, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/gogf/gf/v2/internal/json"
 	"github.com/gogf/gf/v2/internal/utils"
 	"github.com/gogf/gf/v2/util/gconv/internal/localinterface"
+	"github.com/gogf/gf/v2/util/gconv/internal/structcache"
 	"github.com/gogf/gf/v2/util/gtag"
 )
 
@@ -33,6 +34,18 @@ type MapOption struct {
 	// ContinueOnError specifies whether to continue converting the next element
 	// if one element converting fails.
 	ContinueOnError bool
+
+	// KeyStyle specifies the case style for map keys that are derived from struct attribute names
+	// or keys of nested map. The keys specified by struct tags are not affected.
+	KeyStyle MapKeyStyle
+
+	// ErrorStack specifies whether to include the stack in the map converted from error value,
+	// which is map like {"code": 51, "message": "invalid parameter", "stack": "1. ..."}.
+	ErrorStack bool
+
+	// visitedRecorder records the converted maps of the visited source pointers, which is set by the
+	// outermost deep converting for breaking the reference cycles.
+	visitedRecorder *mapVisitedRecorder
 }
 
 func (c *Converter) getMapOption(option ...MapOption) MapOption {
@@ -94,20 +107,19 @@ func (c *Converter) doMapConvert(
 	if v, ok := value.(localinterface.IVal); ok {
 		value = v.Val()
 	}
+	// The error value is converted to map of its code, message and optional stack.
+	if e, ok := value.(error); ok && isStructuredError(e) {
+		return errorToMap(e, option), nil
+	}
 	var (
 		err     error
-		newTags = gtag.StructTagPriority
+		newTags = getPriorityTags(option.Tags)
 	)
 	if option.Deep {
 		recursive = RecursiveTypeTrue
 	}
-	switch len(option.Tags) {
-	case 0:
-		// No need handling.
-	case 1:
-		newTags = append(strings.Split(option.Tags[0], ","), gtag.StructTagPriority...)
-	default:
-		newTags = append(option.Tags, gtag.StructTagPriority...)
+	if recursive == RecursiveTypeTrue && option.visitedRecorder == nil {
+		option.visitedRecorder = newMapVisitedRecorder()
 	}
 	// Assert the common combination of types, and finally it uses reflection.
 	dataMap := make(map[string]any)
@@ -335,6 +347,19 @@ func (c *Converter) doMapConvert(
 	return dataMap, nil
 }
 
+// getPriorityTags returns the tags in priority order for map key naming,
+// with the custom `tags` in front of the default tag priority.
+func getPriorityTags(tags []string) []string {
+	switch len(tags) {
+	case 0:
+		return gtag.StructTagPriority
+	case 1:
+		return append(strings.Split(tags[0], ","), gtag.StructTagPriority...)
+	default:
+		return append(tags, gtag.StructTagPriority...)
+	}
+}
+
 type doMapConvertForMapOrStructValueInput struct {
 	IsRoot          bool          // It returns directly if it is not root and with no recursive converting.
 	Value           any           // Current operation value.
@@ -345,6 +370,11 @@ type doMapConvertForMapOrStructValueInput struct {
 }
 
 func (c *Converter) doMapConvertForMapOrStructValue(in doMapConvertForMapOrStructValueInput) (any, error) {
+	if !in.IsRoot {
+		if s, ok := c.convertValueWithStringConverter(in.Value); ok {
+			return s, nil
+		}
+	}
 	if !in.IsRoot && !in.RecursiveOption {
 		return in.Value, nil
 	}
@@ -359,18 +389,34 @@ func (c *Converter) doMapConvertForMapOrStructValue(in doMapConvertForMapOrStruc
 	} else {
 		reflectValue = reflect.ValueOf(in.Value)
 	}
-	reflectKind := reflectValue.Kind()
+	if e, ok := in.Value.(error); ok && isStructuredError(e) {
+		return errorToMap(e, in.Option), nil
+	}
+	var (
+		reflectKind = reflectValue.Kind()
+		// visitedValue is the last pointer to struct or the map, which identifies the visited source.
+		visitedValue = reflectValue
+	)
 	// If it is a pointer, we should find its real data type.
 	for reflectKind == reflect.Pointer {
+		visitedValue = reflectValue
 		reflectValue = reflectValue.Elem()
 		reflectKind = reflectValue.Kind()
 	}
+	if reflectKind == reflect.Map {
+		visitedValue = reflectValue
+	}
+	// The visited source is converted to the same map, which is still being filled if it is a cycle.
+	if dataMap, ok := in.Option.visitedRecorder.load(visitedValue); ok {
+		return dataMap, nil
+	}
 	switch reflectKind {
 	case reflect.Map:
 		var (
 			mapIter = reflectValue.MapRange()
 			dataMap = make(map[string]any)
 		)
+		in.Option.visitedRecorder.store(visitedValue, dataMap)
 		for mapIter.Next() {
 			var (
 				mapKeyValue = mapIter.Value()
@@ -393,6 +439,7 @@ func (c *Converter) doMapConvertForMapOrStructValue(in doMapConvertForMapOrStruc
 			if err != nil && !in.Option.ContinueOnError {
 				return nil, err
 			}
+			s = formatMapKey(s, in.Option.KeyStyle)
 			dataMap[s], err = c.doMapConvertForMapOrStructValue(
 				doMapConvertForMapOrStructValueInput{
 					IsRoot:          false,
@@ -410,6 +457,7 @@ func (c *Converter) doMapConvertForMapOrStructValue(in doMapConvertForMapOrStruc
 
 	case reflect.Struct:
 		var dataMap = make(map[string]any)
+		in.Option.visitedRecorder.store(visitedValue, dataMap)
 		// Map converting interface check.
 		if v, ok := in.Value.(localinterface.IMapStrAny); ok {
 			// Value copy, in case of concurrent safety.
@@ -441,6 +489,10 @@ func (c *Converter) doMapConvertForMapOrStructValue(in doMapConvertForMapOrStruc
 			rvField     reflect.Value
 			reflectType = reflectValue.Type() // attribute value type.
 			mapKey      = ""                  // mapKey may be the tag name or the struct attribute name.
+			// cachedStructInfo provides the cached tag options of the attributes.
+			cachedStructInfo = c.internalConverter.GetCachedStructInfo(reflectType, "")
+			// remainingFields are the attributes capturing unknown keys, which are output after other attributes.
+			remainingFields []reflect.Value
 		)
 		for i := 0; i < reflectValue.NumField(); i++ {
 			rtField = reflectType.Field(i)
@@ -453,12 +505,12 @@ func (c *Converter) doMapConvertForMapOrStructValue(in doMapConvertForMapOrStruc
 			mapKey = ""
 			fieldTag := rtField.Tag
 			for _, tag := range in.Option.Tags {
-				if mapKey = fieldTag.Get(tag); mapKey != "" {
+				if mapKey = structcache.TrimTagOptions(tag, fieldTag.Get(tag)); mapKey != "" {
 					break
 				}
 			}
 			if mapKey == "" {
-				mapKey = fieldName
+				mapKey = formatMapKey(fieldName, in.Option.KeyStyle)
 			} else {
 				// Support json tag feature: -, omitempty
 				mapKey = strings.TrimSpace(mapKey)
@@ -479,7 +531,33 @@ func (c *Converter) doMapConvertForMapOrStructValue(in doMapConvertForMapOrStruc
 					}
 				}
 				if mapKey == "" {
-					mapKey = fieldName
+					mapKey = formatMapKey(fieldName, in.Option.KeyStyle)
+				}
+			}
+			tagOptions := cachedStructInfo.GetFieldTagOptions(i)
+			// Remaining attribute specified by tag, eg: `gconv:",remaining"`.
+			if _, ok := tagOptions[structcache.TagOptionRemaining]; ok && reflect.Indirect(rvField).Kind() == reflect.Map {
+				remainingFields = append(remainingFields, reflect.Indirect(rvField))
+				continue
+			}
+			// Wrapper attribute specified by tag, eg: `gconv:",wrapper"`.
+			if _, ok := tagOptions[structcache.TagOptionWrapper]; ok {
+				if unwrapped, isWrapper := unwrapValue(rvField); isWrapper {
+					dataMap[mapKey] = unwrapped
+					continue
+				}
+			}
+			// The converters are checked up front, as most of the converting has no registered converter.
+			if len(c.typeConverterFuncMap) > 0 && rvField.IsValid() && rvField.CanInterface() {
+				if s, ok := c.convertReflectValueWithStringConverter(rvField); ok {
+					dataMap[mapKey] = s
+					continue
+				}
+			}
+			if in.RecursiveOption && rvField.IsValid() && rvField.CanInterface() {
+				if e, ok := rvField.Interface().(error); ok && isStructuredError(e) {
+					dataMap[mapKey] = errorToMap(e, in.Option)
+					continue
 				}
 			}
 			if in.RecursiveOption || rtField.Anonymous {
@@ -500,7 +578,7 @@ func (c *Converter) doMapConvertForMapOrStructValue(in doMapConvertForMapOrStruc
 						continue
 					}
 					var (
-						hasNoTag = mapKey == fieldName
+						hasNoTag = mapKey == fieldName || mapKey == formatMapKey(fieldName, in.Option.KeyStyle)
 						// DO NOT use rvAttrField.Interface() here,
 						// as it might be changed from pointer to struct.
 						rvInterface = rvField.Interface()
@@ -592,6 +670,7 @@ func (c *Converter) doMapConvertForMapOrStructValue(in doMapConvertForMapOrStruc
 						if err != nil && !in.Option.ContinueOnError {
 							return nil, err
 						}
+						s = formatMapKey(s, in.Option.KeyStyle)
 						nestedMap[s], err = c.doMapConvertForMapOrStructValue(
 							doMapConvertForMapOrStructValueInput{
 								IsRoot:          false,
@@ -622,6 +701,32 @@ func (c *Converter) doMapConvertForMapOrStructValue(in doMapConvertForMapOrStruc
 				}
 			}
 		}
+		// The items of remaining attributes do not overwrite the other attributes.
+		for _, remainingField := range remainingFields {
+			var mapIter = remainingField.MapRange()
+			for mapIter.Next() {
+				mapKey = mapIter.Key().String()
+				if _, ok := dataMap[mapKey]; ok {
+					continue
+				}
+				if !in.RecursiveOption {
+					dataMap[mapKey] = mapIter.Value().Interface()
+					continue
+				}
+				dataMap[mapKey], err = c.doMapConvertForMapOrStructValue(
+					doMapConvertForMapOrStructValueInput{
+						IsRoot:          false,
+						Value:           mapIter.Value().Interface(),
+						RecursiveType:   in.RecursiveType,
+						RecursiveOption: in.RecursiveType == RecursiveTypeTrue,
+						Option:          in.Option,
+					},
+				)
+				if err != nil && !in.Option.ContinueOnError {
+					return nil, err
+				}
+			}
+		}
 		if !in.MustMapReturn && len(dataMap) == 0 {
 			return in.Value, nil
 		}
@@ -652,3 +757,41 @@ func (c *Converter) doMapConvertForMapOrStructValue(in doMapConvertForMapOrStruc
 	}
 	return in.Value, nil
 }
+
+// convertValueWithStringConverter converts `value` to string using the registered type converter
+// function like `func(T) (*string, error)`, which is usually used for types that should be presented
+// as string in map, eg: decimal.Decimal.
+func (c *Converter) convertValueWithStringConverter(value any) (string, bool) {
+	if len(c.typeConverterFuncMap) == 0 || value == nil {
+		return "", false
+	}
+	return c.convertReflectValueWithStringConverter(reflect.ValueOf(value))
+}
+
+// convertReflectValueWithStringConverter is the reflect.Value version of convertValueWithStringConverter,
+// which avoids boxing the attribute values in the map converting. The caller checks that there's any
+// registered converter before calling it.
+func (c *Converter) convertReflectValueWithStringConverter(reflectValue reflect.Value) (string, bool) {
+	for reflectValue.Kind() == reflect.Pointer {
+		if reflectValue.IsNil() {
+			return "", false
+		}
+		reflectValue = reflectValue.Elem()
+	}
+	if !reflectValue.IsValid() {
+		return "", false
+	}
+	registeredOutTypeMap, ok := c.typeConverterFuncMap[reflectValue.Type()]
+	if !ok {
+		return "", false
+	}
+	converterFunc, ok := registeredOutTypeMap[reflect.PointerTo(stringType)]
+	if !ok {
+		return "", false
+	}
+	result := converterFunc.Call([]reflect.Value{reflectValue})
+	if !result[1].IsNil() || result[0].IsNil() {
+		return "", false
+	}
+	return result[0].Elem().String(), true
+}
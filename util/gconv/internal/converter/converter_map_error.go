@@ -0,0 +1,56 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+const (
+	errorMapKeyCode    = "code"
+	errorMapKeyMessage = "message"
+	errorMapKeyStack   = "stack"
+)
+
+// isStructuredError checks and returns whether `err` should be converted to map of its code and message,
+// which is the error implementing gerror.ICode, or the error having no exported attributes like the error
+// created by errors.New. The other struct error is converted to map using its attributes as usual.
+// The nil pointer error is not converted.
+func isStructuredError(err error) bool {
+	var reflectValue = reflect.ValueOf(err)
+	if reflectValue.Kind() == reflect.Pointer && reflectValue.IsNil() {
+		return false
+	}
+	if _, ok := err.(gerror.ICode); ok {
+		return true
+	}
+	var reflectType = reflectValue.Type()
+	for reflectType.Kind() == reflect.Pointer {
+		reflectType = reflectType.Elem()
+	}
+	if reflectType.Kind() != reflect.Struct {
+		return true
+	}
+	return !hasExportedField(reflectType)
+}
+
+// errorToMap converts `err` to map with its code, message and optional stack, eg:
+// {"code": 51, "message": "invalid parameter", "stack": "1. ..."}.
+// The code is gcode.CodeNil.Code() if there's no code in `err`, and the stack is included only if
+// option ErrorStack is set and there's stack in `err`.
+func errorToMap(err error, option MapOption) map[string]any {
+	var dataMap = map[string]any{
+		formatMapKey(errorMapKeyCode, option.KeyStyle):    gerror.Code(err).Code(),
+		formatMapKey(errorMapKeyMessage, option.KeyStyle): err.Error(),
+	}
+	if option.ErrorStack && gerror.HasStack(err) {
+		dataMap[formatMapKey(errorMapKeyStack, option.KeyStyle)] = gerror.Stack(err)
+	}
+	return dataMap
+}
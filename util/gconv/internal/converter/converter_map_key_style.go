@@ -0,0 +1,120 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// MapKeyStyle is the case style for map keys that are derived from struct attribute names.
+type MapKeyStyle string
+
+const (
+	MapKeyStyleDefault MapKeyStyle = ""       // Uses the attribute name as it is.
+	MapKeyStyleSnake   MapKeyStyle = "snake"  // Eg: UserName -> user_name.
+	MapKeyStyleCamel   MapKeyStyle = "camel"  // Eg: UserName -> userName.
+	MapKeyStyleKebab   MapKeyStyle = "kebab"  // Eg: UserName -> user-name.
+	MapKeyStylePascal  MapKeyStyle = "pascal" // Eg: userName -> UserName.
+)
+
+// MapWithKeyStyle converts `value` to map[string]any recursively, the keys of which are
+// formatted with `style` if they are derived from attribute names of struct or keys of map.
+// The keys specified by struct tags are kept as they are.
+func (c *Converter) MapWithKeyStyle(value any, style MapKeyStyle, option ...MapOption) (map[string]any, error) {
+	var usedOption = c.getMapOption(option...)
+	usedOption.Deep = true
+	usedOption.KeyStyle = style
+	dataMap, err := c.doMapConvert(value, RecursiveTypeAuto, false, usedOption)
+	if err != nil || style == MapKeyStyleDefault {
+		return dataMap, err
+	}
+	// The keys of root map are not formatted in map converting.
+	var reflectValue = reflect.Indirect(reflect.ValueOf(value))
+	if reflectValue.Kind() != reflect.Map {
+		return dataMap, nil
+	}
+	var formattedMap = make(map[string]any, len(dataMap))
+	for k, v := range dataMap {
+		formattedMap[formatMapKey(k, style)] = v
+	}
+	return formattedMap, nil
+}
+
+// formatMapKey formats attribute name `name` with case style `style`.
+func formatMapKey(name string, style MapKeyStyle) string {
+	if style == MapKeyStyleDefault {
+		return name
+	}
+	var words = splitMapKeyWords(name)
+	if len(words) == 0 {
+		return name
+	}
+	switch style {
+	case MapKeyStyleSnake:
+		return strings.ToLower(strings.Join(words, "_"))
+	case MapKeyStyleKebab:
+		return strings.ToLower(strings.Join(words, "-"))
+	case MapKeyStyleCamel:
+		words[0] = strings.ToLower(words[0])
+		for i := 1; i < len(words); i++ {
+			words[i] = upperFirstRune(words[i])
+		}
+		return strings.Join(words, "")
+	case MapKeyStylePascal:
+		for i := range words {
+			words[i] = upperFirstRune(words[i])
+		}
+		return strings.Join(words, "")
+	default:
+		return name
+	}
+}
+
+// splitMapKeyWords splits `name` into words by case changes and delimiters ' ', '_', '-', '.'.
+// The consecutive upper-case letters are treated as one word, eg: HTTPServer -> [HTTP, Server].
+func splitMapKeyWords(name string) []string {
+	var (
+		words []string
+		runes = []rune(name)
+		start = -1
+	)
+	for i, r := range runes {
+		switch {
+		case r == ' ' || r == '_' || r == '-' || r == '.':
+			if start >= 0 {
+				words = append(words, string(runes[start:i]))
+				start = -1
+			}
+			continue
+		case start < 0:
+			start = i
+			continue
+		}
+		if !unicode.IsUpper(r) {
+			continue
+		}
+		var prev = runes[i-1]
+		if unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+			(unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+// upperFirstRune converts the first rune of `s` to upper case.
+func upperFirstRune(s string) string {
+	var runes = []rune(s)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
@@ -0,0 +1,115 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// MapWithMethods converts struct `value` to map[string]any like Map function, and then invokes the
+// methods named in `methods` of `value`, adding their results to the map.
+//
+// The item of `methods` is the method name like "FullName", the result key of which is the method name
+// formatted with option.KeyStyle, or in format "FullName:full_name" specifying the result key explicitly.
+// The methods must be exported, have no parameter, and return one value, optionally with an error.
+func (c *Converter) MapWithMethods(value any, methods []string, option ...MapOption) (map[string]any, error) {
+	var usedOption = c.getMapOption(option...)
+	dataMap, err := c.doMapConvert(value, RecursiveTypeAuto, false, usedOption)
+	if err != nil || len(methods) == 0 {
+		return dataMap, err
+	}
+	var reflectValue reflect.Value
+	if v, ok := value.(reflect.Value); ok {
+		reflectValue = v
+	} else {
+		reflectValue = reflect.ValueOf(value)
+	}
+	for reflectValue.Kind() == reflect.Pointer && reflectValue.Elem().Kind() == reflect.Pointer {
+		reflectValue = reflectValue.Elem()
+	}
+	switch {
+	case !reflectValue.IsValid():
+		return dataMap, nil
+	case reflectValue.Kind() == reflect.Pointer:
+		if reflectValue.IsNil() {
+			return dataMap, nil
+		}
+	default:
+		// It uses the pointer of a copy for calling the methods of both value and pointer receivers.
+		var pointer = reflect.New(reflectValue.Type())
+		pointer.Elem().Set(reflectValue)
+		reflectValue = pointer
+	}
+	if dataMap == nil {
+		dataMap = make(map[string]any, len(methods))
+	}
+	usedOption.Tags = getPriorityTags(usedOption.Tags)
+	for _, method := range methods {
+		var (
+			methodName = method
+			mapKey     = ""
+		)
+		if pos := strings.IndexByte(method, ':'); pos != -1 {
+			methodName = strings.TrimSpace(method[:pos])
+			mapKey = strings.TrimSpace(method[pos+1:])
+		}
+		if mapKey == "" {
+			mapKey = formatMapKey(methodName, usedOption.KeyStyle)
+		}
+		result, err := c.callMapMethod(reflectValue, methodName)
+		if err == nil {
+			result, err = c.doMapConvertForMapOrStructValue(doMapConvertForMapOrStructValueInput{
+				IsRoot:          false,
+				Value:           result,
+				RecursiveType:   RecursiveTypeAuto,
+				RecursiveOption: usedOption.Deep,
+				Option:          usedOption,
+			})
+		}
+		if err != nil {
+			if !usedOption.ContinueOnError {
+				return nil, err
+			}
+			continue
+		}
+		dataMap[mapKey] = result
+	}
+	return dataMap, nil
+}
+
+// callMapMethod calls the method `methodName` of `pointerValue` and returns its result.
+func (c *Converter) callMapMethod(pointerValue reflect.Value, methodName string) (any, error) {
+	var method = pointerValue.MethodByName(methodName)
+	if !method.IsValid() {
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`method "%s" not found for type "%s"`,
+			methodName, pointerValue.Type().String(),
+		)
+	}
+	var methodType = method.Type()
+	if methodType.NumIn() != 0 ||
+		methodType.NumOut() == 0 || methodType.NumOut() > 2 ||
+		(methodType.NumOut() == 2 && methodType.Out(1) != errorType) {
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`invalid method "%s" for type "%s", it should have no parameter and return one value optionally with error`,
+			methodName, pointerValue.Type().String(),
+		)
+	}
+	var results = method.Call(nil)
+	if len(results) == 2 && !results[1].IsNil() {
+		return nil, gerror.Wrapf(
+			results[1].Interface().(error), `call method "%s" failed`, methodName,
+		)
+	}
+	return results[0].Interface(), nil
+}
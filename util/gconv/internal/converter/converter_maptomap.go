@@ -23,6 +23,18 @@ import (
 //
 // The optional parameter `mapping` is used for struct attribute to map key mapping, which makes
 // sense only if the items of original map `params` is type struct.
+//
+// The keys of `params` are converted to the key type of `pointer` in rules:
+//  1. The key of the same type is used as it is.
+//  2. The key is converted to string using String for string key type, which respects fmt.Stringer.
+//  3. The key is converted to integer for integer key type, and it returns error if the key is not
+//     numeric or overflows the key type, like 300 for uint8.
+//  4. The key is converted using common converting for the other key types, and it returns error if
+//     the key cannot be converted, like string to struct.
+//  5. The converted key is then converted to the custom key type, like `type Id int64`.
+//
+// Note that the items whose keys are equal after converting, like "1" and "01" for integer key type,
+// overwrite each other in random order.
 func (c *Converter) MapToMap(
 	params, pointer any, mapping map[string]string, option ...MapOption,
 ) (err error) {
@@ -127,20 +139,96 @@ func (c *Converter) MapToMap(
 			}
 			mapValue.Set(reflect.ValueOf(convertResult))
 		}
-		convertResult, err := c.doConvert(
-			doConvertInput{
-				FromValue:  key.Interface(),
-				ToTypeName: pointerKeyType.Name(),
-				ReferValue: reflect.New(pointerKeyType).Elem().Interface(),
-			},
-			convertOption,
-		)
+		mapKey, err := c.convertMapKey(key, pointerKeyType, convertOption)
 		if err != nil {
 			return err
 		}
-		var mapKey = reflect.ValueOf(convertResult)
 		dataMap.SetMapIndex(mapKey, mapValue)
 	}
 	pointerRv.Set(dataMap)
 	return nil
 }
+
+// convertMapKey converts map key `key` to type `keyType` for MapToMap, see MapToMap for the rules.
+func (c *Converter) convertMapKey(key reflect.Value, keyType reflect.Type, option ConvertOption) (reflect.Value, error) {
+	for key.Kind() == reflect.Interface && !key.IsNil() {
+		key = key.Elem()
+	}
+	if key.Type() == keyType {
+		return key, nil
+	}
+	var (
+		err    error
+		result reflect.Value
+	)
+	switch keyType.Kind() {
+	case reflect.String:
+		var s string
+		if s, err = c.String(key.Interface()); err != nil {
+			return result, err
+		}
+		result = reflect.ValueOf(s)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		if i, err = c.Int64(key.Interface()); err != nil {
+			return result, err
+		}
+		result = reflect.New(keyType).Elem()
+		if result.OverflowInt(i) {
+			return result, gerror.NewCodef(
+				gcode.CodeInvalidParameter, `map key "%v" overflows type "%s"`, key.Interface(), keyType,
+			)
+		}
+		result.SetInt(i)
+		return result, nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var isNegative bool
+		switch key.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			isNegative = key.Int() < 0
+		case reflect.Float32, reflect.Float64:
+			isNegative = key.Float() < 0
+		}
+		if isNegative {
+			return result, gerror.NewCodef(
+				gcode.CodeInvalidParameter, `map key "%v" overflows type "%s"`, key.Interface(), keyType,
+			)
+		}
+		var u uint64
+		if u, err = c.Uint64(key.Interface()); err != nil {
+			return result, err
+		}
+		result = reflect.New(keyType).Elem()
+		if result.OverflowUint(u) {
+			return result, gerror.NewCodef(
+				gcode.CodeInvalidParameter, `map key "%v" overflows type "%s"`, key.Interface(), keyType,
+			)
+		}
+		result.SetUint(u)
+		return result, nil
+
+	default:
+		var convertResult any
+		if convertResult, err = c.doConvert(
+			doConvertInput{
+				FromValue:  key.Interface(),
+				ToTypeName: keyType.String(),
+				ReferValue: reflect.New(keyType).Elem().Interface(),
+			},
+			option,
+		); err != nil {
+			return result, err
+		}
+		result = reflect.ValueOf(convertResult)
+	}
+	if !result.IsValid() || !result.Type().ConvertibleTo(keyType) {
+		return result, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`cannot convert map key "%v" of type "%s" to type "%s"`,
+			key.Interface(), key.Type(), keyType,
+		)
+	}
+	return result.Convert(keyType), nil
+}
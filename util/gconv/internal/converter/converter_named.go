@@ -0,0 +1,106 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// RegisterNamedConverter registers converter function `fn` with name `name`, which is applied only to
+// the struct attributes specified by tag option `converter`, eg: `gconv:"converter:name"`.
+//
+// The parameter `fn` must be defined as pattern `func(T1) (T2, error)`. The source value is converted
+// to `T1` before calling, and the result of `T2` is converted to the attribute type after calling.
+// It is suggested to do it in boot procedure of the process.
+func (c *Converter) RegisterNamedConverter(name string, fn any) (err error) {
+	if name == "" {
+		return gerror.NewCode(gcode.CodeInvalidParameter, "the converter name should not be empty")
+	}
+	var fReflectType = reflect.TypeOf(fn)
+	if fReflectType == nil || fReflectType.Kind() != reflect.Func ||
+		fReflectType.NumIn() != 1 || fReflectType.NumOut() != 2 ||
+		!fReflectType.Out(1).Implements(errorType) {
+		return gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			"parameter must be type of converter function and defined as pattern `func(T1) (T2, error)`, "+
+				"but defined as `%v`",
+			fReflectType,
+		)
+	}
+	if _, ok := c.namedConverterMap[name]; ok {
+		return gerror.NewCodef(
+			gcode.CodeInvalidOperation,
+			"the converter named `%s` has already been registered",
+			name,
+		)
+	}
+	c.namedConverterMap[name] = reflect.ValueOf(fn)
+	return nil
+}
+
+// UnregisterNamedConverter removes the converter function registered with name `name`.
+// It returns true if the converter is found and removed.
+func (c *Converter) UnregisterNamedConverter(name string) bool {
+	if _, ok := c.namedConverterMap[name]; !ok {
+		return false
+	}
+	delete(c.namedConverterMap, name)
+	return true
+}
+
+// bindVarToNamedConverterField binds `srcValue` to attribute `fieldValue` using the converter
+// registered with name `name`.
+func (c *Converter) bindVarToNamedConverterField(
+	name string, fieldValue reflect.Value, srcValue any, option StructOption,
+) error {
+	fn, ok := c.namedConverterMap[name]
+	if !ok {
+		return gerror.NewCodef(
+			gcode.CodeInvalidConfiguration,
+			"no converter registered with name `%s`",
+			name,
+		)
+	}
+	var (
+		inType  = fn.Type().In(0)
+		inValue reflect.Value
+	)
+	if v, isReflectValue := srcValue.(reflect.Value); isReflectValue {
+		srcValue = v.Interface()
+	}
+	if srcType := reflect.TypeOf(srcValue); srcType.AssignableTo(inType) {
+		inValue = reflect.ValueOf(srcValue)
+	} else {
+		inValue = reflect.New(inType).Elem()
+		if err := c.bindVarToReflectValue(inValue, srcValue, option); err != nil {
+			return err
+		}
+	}
+	results := fn.Call([]reflect.Value{inValue})
+	if errValue := results[1]; !errValue.IsNil() {
+		return errValue.Interface().(error)
+	}
+	var result = results[0]
+	if result.Type().AssignableTo(fieldValue.Type()) {
+		fieldValue.Set(result)
+		return nil
+	}
+	if result.Kind() == reflect.Pointer || result.Kind() == reflect.Interface {
+		if result.IsNil() {
+			fieldValue.Set(reflect.Zero(fieldValue.Type()))
+			return nil
+		}
+		if result.Elem().Type().AssignableTo(fieldValue.Type()) {
+			fieldValue.Set(result.Elem())
+			return nil
+		}
+	}
+	return c.bindVarToReflectValue(fieldValue, result.Interface(), option)
+}
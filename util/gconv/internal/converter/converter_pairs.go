@@ -0,0 +1,282 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/empty"
+	"github.com/gogf/gf/v2/internal/utils"
+	"github.com/gogf/gf/v2/util/gconv/internal/localinterface"
+	"github.com/gogf/gf/v2/util/gconv/internal/structcache"
+	"github.com/gogf/gf/v2/util/gtag"
+)
+
+// Pair is a key-value pair converted from struct attribute.
+type Pair struct {
+	Key   string
+	Value any
+}
+
+// PairsOption specifies the option for Pairs converting.
+type PairsOption struct {
+	// Tags specifies the key name by struct tag name, which has priority over the default tags.
+	Tags []string
+
+	// Nested flattens the attributes of nested struct into pairs, the keys of which are joined
+	// with the key of their parent using char '.', eg: `user.name`.
+	Nested bool
+}
+
+// Pairs converts struct `value` to key-value pairs in order of attribute declaration,
+// or in order of key if `sorted` is true.
+//
+// The key of pair is the tag name or the attribute name like function Map, and the attribute
+// with tag `omitempty` is ignored if its value is empty. The attributes of embedded struct
+// without tag are promoted to the same level as their parent.
+//
+// It also supports map `value`, the pairs of which are always sorted by key.
+func (c *Converter) Pairs(value any, sorted bool, option ...PairsOption) ([]Pair, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if v, ok := value.(localinterface.IVal); ok {
+		value = v.Val()
+	}
+	var (
+		usedOption   PairsOption
+		reflectValue = reflect.ValueOf(value)
+	)
+	if len(option) > 0 {
+		usedOption = option[0]
+	}
+	for reflectValue.Kind() == reflect.Pointer {
+		if reflectValue.IsNil() {
+			return nil, nil
+		}
+		reflectValue = reflectValue.Elem()
+	}
+	switch reflectValue.Kind() {
+	case reflect.Map:
+		dataMap, err := c.Map(value, MapOption{Tags: usedOption.Tags})
+		if err != nil {
+			return nil, err
+		}
+		var pairs = make([]Pair, 0, len(dataMap))
+		for k, v := range dataMap {
+			pairs = append(pairs, Pair{Key: k, Value: v})
+		}
+		sortPairs(pairs)
+		return pairs, nil
+
+	case reflect.Struct:
+		var (
+			tags  = gtag.StructTagPriority
+			pairs = make([]Pair, 0, reflectValue.NumField())
+		)
+		switch len(usedOption.Tags) {
+		case 0:
+		case 1:
+			tags = append(strings.Split(usedOption.Tags[0], ","), gtag.StructTagPriority...)
+		default:
+			tags = append(usedOption.Tags, gtag.StructTagPriority...)
+		}
+		pairs = c.doPairsForStruct(reflectValue, "", tags, usedOption, pairs, make(map[string]int))
+		if sorted {
+			sortPairs(pairs)
+		}
+		return pairs, nil
+
+	default:
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`invalid value type "%s" for pairs converting, which should be struct or map`,
+			reflectValue.Type(),
+		)
+	}
+}
+
+// SortedPairs converts map or struct `value` to key-value pairs sorted by key recursively, which means
+// the nested maps and structs are also converted to pairs sorted by key, including the ones in slices.
+// The values are converted using function Map recursively, and the pairs can be converted back to map
+// using function PairsToMap.
+func (c *Converter) SortedPairs(value any) ([]Pair, error) {
+	if value == nil {
+		return nil, nil
+	}
+	dataMap, err := c.Map(value, MapOption{Deep: true})
+	if err != nil {
+		return nil, err
+	}
+	if dataMap == nil {
+		if reflectValue := reflect.Indirect(reflect.ValueOf(value)); reflectValue.IsValid() {
+			switch reflectValue.Kind() {
+			case reflect.Map, reflect.Struct:
+			default:
+				return nil, gerror.NewCodef(
+					gcode.CodeInvalidParameter,
+					`invalid value type "%s" for pairs converting, which should be struct or map`,
+					reflectValue.Type(),
+				)
+			}
+		}
+		return nil, nil
+	}
+	return mapToSortedPairs(dataMap), nil
+}
+
+// PairsToMap converts `pairs` back to map, which is the inverse of function SortedPairs.
+// The values of type []Pair are converted to nested maps recursively, including the ones in slices.
+func (c *Converter) PairsToMap(pairs []Pair) map[string]any {
+	if pairs == nil {
+		return nil
+	}
+	var dataMap = make(map[string]any, len(pairs))
+	for _, pair := range pairs {
+		dataMap[pair.Key] = c.pairsValueToMapValue(pair.Value)
+	}
+	return dataMap
+}
+
+// mapToSortedPairs converts `dataMap` to pairs sorted by key recursively.
+func mapToSortedPairs(dataMap map[string]any) []Pair {
+	var pairs = make([]Pair, 0, len(dataMap))
+	for k, v := range dataMap {
+		pairs = append(pairs, Pair{Key: k, Value: mapValueToSortedPairsValue(v)})
+	}
+	sortPairs(pairs)
+	return pairs
+}
+
+// mapValueToSortedPairsValue converts the nested maps in `value` to sorted pairs.
+func mapValueToSortedPairsValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		return mapToSortedPairs(v)
+	case []any:
+		var array = make([]any, len(v))
+		for i, item := range v {
+			array[i] = mapValueToSortedPairsValue(item)
+		}
+		return array
+	default:
+		return value
+	}
+}
+
+// pairsValueToMapValue converts the nested pairs in `value` to maps.
+func (c *Converter) pairsValueToMapValue(value any) any {
+	switch v := value.(type) {
+	case []Pair:
+		return c.PairsToMap(v)
+	case []any:
+		var array = make([]any, len(v))
+		for i, item := range v {
+			array[i] = c.pairsValueToMapValue(item)
+		}
+		return array
+	default:
+		return value
+	}
+}
+
+// doPairsForStruct appends the pairs of struct `reflectValue` to `pairs` in order of attribute
+// declaration. The parameter `keyIndexes` is used for overwriting pair of the same key.
+func (c *Converter) doPairsForStruct(
+	reflectValue reflect.Value, keyPrefix string, tags []string, option PairsOption,
+	pairs []Pair, keyIndexes map[string]int,
+) []Pair {
+	var reflectType = reflectValue.Type()
+	for i := 0; i < reflectValue.NumField(); i++ {
+		var (
+			rtField = reflectType.Field(i)
+			rvField = reflectValue.Field(i)
+		)
+		if !utils.IsLetterUpper(rtField.Name[0]) {
+			continue
+		}
+		key, omitEmpty, hasTag := getPairKey(rtField, tags)
+		if key == "-" {
+			continue
+		}
+		if omitEmpty && empty.IsEmpty(rvField.Interface()) {
+			continue
+		}
+		var rvAttrField = rvField
+		for rvAttrField.Kind() == reflect.Pointer && !rvAttrField.IsNil() {
+			rvAttrField = rvAttrField.Elem()
+		}
+		if rvAttrField.Kind() == reflect.Struct && hasExportedField(rvAttrField.Type()) {
+			switch {
+			case rtField.Anonymous && !hasTag:
+				// The attributes of embedded struct are promoted to current level.
+				pairs = c.doPairsForStruct(rvAttrField, keyPrefix, tags, option, pairs, keyIndexes)
+				continue
+			case option.Nested:
+				pairs = c.doPairsForStruct(rvAttrField, keyPrefix+key+".", tags, option, pairs, keyIndexes)
+				continue
+			}
+		}
+		pairs = appendPair(pairs, keyIndexes, Pair{
+			Key:   keyPrefix + key,
+			Value: rvField.Interface(),
+		})
+	}
+	return pairs
+}
+
+// getPairKey returns the pair key of struct field `field`, and whether it has tag `omitempty`.
+func getPairKey(field reflect.StructField, tags []string) (key string, omitEmpty, hasTag bool) {
+	var tagValue string
+	for _, tag := range tags {
+		if tagValue = strings.TrimSpace(structcache.TrimTagOptions(tag, field.Tag.Get(tag))); tagValue != "" {
+			break
+		}
+	}
+	array := strings.Split(tagValue, ",")
+	for _, item := range array[1:] {
+		if strings.TrimSpace(item) == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	if key = strings.TrimSpace(array[0]); key == "" {
+		return field.Name, omitEmpty, false
+	}
+	return key, omitEmpty, true
+}
+
+// appendPair appends `pair` to `pairs`, or overwrites the value of existing pair with the same key.
+func appendPair(pairs []Pair, keyIndexes map[string]int, pair Pair) []Pair {
+	if index, ok := keyIndexes[pair.Key]; ok {
+		pairs[index].Value = pair.Value
+		return pairs
+	}
+	keyIndexes[pair.Key] = len(pairs)
+	return append(pairs, pair)
+}
+
+// hasExportedField checks and returns whether struct type `structType` has any exported field,
+// which is false for struct like time.Time.
+func hasExportedField(structType reflect.Type) bool {
+	for i := 0; i < structType.NumField(); i++ {
+		if structType.Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
+
+// sortPairs sorts `pairs` by key in ascending order.
+func sortPairs(pairs []Pair) {
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].Key < pairs[j].Key
+	})
+}
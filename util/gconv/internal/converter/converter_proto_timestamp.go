@@ -0,0 +1,203 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/util/gconv/internal/localinterface"
+)
+
+// protoTimestampFields are the field indexes of the struct type in protobuf Timestamp shape.
+type protoTimestampFields struct {
+	seconds int // Index of field `Seconds int64`.
+	nanos   int // Index of field `Nanos int32`.
+}
+
+// RegisterProtoTimestamp registers the converting between time and the struct type `t` in protobuf
+// Timestamp shape, which is a struct having exactly the exported attributes `Seconds int64` and
+// `Nanos int32`, like `timestamppb.Timestamp`. The shape is checked structurally, so that no protobuf
+// package is imported. It is suggested to do it in boot procedure of the process.
+//
+// After registration, the struct of type `t` can be converted to time.Time/*gtime.Time, and the time
+// values, time strings and timestamps can be converted to the struct of type `t`, which is done by the
+// converting function registered by RegisterAnyConverterFunc.
+func (c *Converter) RegisterProtoTimestamp(t reflect.Type) (err error) {
+	if t == nil {
+		return gerror.NewCode(gcode.CodeInvalidParameter, "the protobuf Timestamp type should not be nil")
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	fields, ok := getProtoTimestampFields(t)
+	if !ok {
+		return gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			"the type `%s` is not in protobuf Timestamp shape having only attributes `Seconds int64` and `Nanos int32`",
+			t.String(),
+		)
+	}
+	if _, ok = c.protoTimestampTypeMap[t]; ok {
+		return gerror.NewCodef(
+			gcode.CodeInvalidOperation,
+			"the protobuf Timestamp type `%s` has already been registered",
+			t.String(),
+		)
+	}
+	c.protoTimestampTypeMap[t] = fields
+	c.RegisterAnyConverterFunc(c.builtInAnyConvertFuncForProtoTimestamp, t)
+	return nil
+}
+
+// UnregisterProtoTimestamp removes the converting between time and the struct type `t` registered
+// by RegisterProtoTimestamp. It returns true if it is registered before.
+func (c *Converter) UnregisterProtoTimestamp(t reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if _, ok := c.protoTimestampTypeMap[t]; !ok {
+		return false
+	}
+	delete(c.protoTimestampTypeMap, t)
+	c.internalConverter.UnregisterAnyConvertFunc(t)
+	return true
+}
+
+// getProtoTimestampFields returns the field indexes of struct type `t` if it is in protobuf Timestamp shape.
+func getProtoTimestampFields(t reflect.Type) (*protoTimestampFields, bool) {
+	if t.Kind() != reflect.Struct {
+		return nil, false
+	}
+	var (
+		fields   = &protoTimestampFields{seconds: -1, nanos: -1}
+		exported int
+	)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		exported++
+		switch {
+		case field.Name == "Seconds" && field.Type.Kind() == reflect.Int64:
+			fields.seconds = i
+		case field.Name == "Nanos" && field.Type.Kind() == reflect.Int32:
+			fields.nanos = i
+		}
+	}
+	if exported != 2 || fields.seconds < 0 || fields.nanos < 0 {
+		return nil, false
+	}
+	return fields, true
+}
+
+// protoTimestampToTime converts `value` to time.Time in UTC if it is struct or pointer to struct of
+// the registered protobuf Timestamp type. It returns false if `value` is not such value or is nil pointer.
+func (c *Converter) protoTimestampToTime(value any) (time.Time, bool) {
+	if len(c.protoTimestampTypeMap) == 0 {
+		return time.Time{}, false
+	}
+	reflectValue := reflect.ValueOf(value)
+	if reflectValue.Kind() == reflect.Pointer {
+		if reflectValue.IsNil() {
+			return time.Time{}, false
+		}
+		reflectValue = reflectValue.Elem()
+	}
+	if !reflectValue.IsValid() {
+		return time.Time{}, false
+	}
+	fields, ok := c.protoTimestampTypeMap[reflectValue.Type()]
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(
+		reflectValue.Field(fields.seconds).Int(),
+		reflectValue.Field(fields.nanos).Int(),
+	).UTC(), true
+}
+
+// builtInAnyConvertFuncForProtoTimestamp converts `from` to the registered protobuf Timestamp type `to`.
+// The time values, time strings and timestamps are converted as time, and the other values like map
+// are bound by the attribute names `seconds` and `nanos` case-insensitively.
+func (c *Converter) builtInAnyConvertFuncForProtoTimestamp(from any, to reflect.Value) error {
+	for to.Kind() == reflect.Pointer {
+		if to.IsNil() {
+			to.Set(reflect.New(to.Type().Elem()))
+		}
+		to = to.Elem()
+	}
+	fields, ok := c.protoTimestampTypeMap[to.Type()]
+	if !ok {
+		return gerror.NewCodef(
+			gcode.CodeInvalidParameter, "the type `%s` is not registered as protobuf Timestamp", to.Type().String(),
+		)
+	}
+	// The values other than time sources like map are bound by attribute names.
+	if _, isTimestamp := c.protoTimestampToTime(from); !isTimestamp && !isProtoTimestampSource(from) {
+		return c.bindVarToProtoTimestampFields(from, to, fields)
+	}
+	t, err := c.Time(from)
+	if err != nil {
+		return err
+	}
+	to.Field(fields.seconds).SetInt(t.Unix())
+	to.Field(fields.nanos).SetInt(int64(t.Nanosecond()))
+	return nil
+}
+
+// bindVarToProtoTimestampFields binds the values of keys `seconds` and `nanos` of `from` to the attributes
+// of protobuf Timestamp `to`, which matches the keys case-insensitively.
+func (c *Converter) bindVarToProtoTimestampFields(from any, to reflect.Value, fields *protoTimestampFields) error {
+	m, err := c.Map(from)
+	if err != nil {
+		return err
+	}
+	for k, v := range m {
+		var index int
+		switch {
+		case strings.EqualFold(k, "seconds"):
+			index = fields.seconds
+		case strings.EqualFold(k, "nanos"):
+			index = fields.nanos
+		default:
+			continue
+		}
+		i, err := c.Int64(v)
+		if err != nil {
+			return err
+		}
+		to.Field(index).SetInt(i)
+	}
+	return nil
+}
+
+// isProtoTimestampSource checks and returns whether `value` can be converted to protobuf Timestamp
+// shape as time, which are the time values, strings and numbers.
+func isProtoTimestampSource(value any) bool {
+	switch value.(type) {
+	case time.Time, *time.Time, gtime.Time, *gtime.Time, localinterface.IGTime:
+		return true
+	}
+	switch reflect.Indirect(reflect.ValueOf(value)).Kind() {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
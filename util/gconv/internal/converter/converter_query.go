@@ -0,0 +1,209 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// QuerySliceStyle is the style for converting slice of scalar values to query string.
+type QuerySliceStyle string
+
+const (
+	QuerySliceStyleRepeat  QuerySliceStyle = ""        // Repeats the key for each element, eg: tags=a&tags=b.
+	QuerySliceStyleBracket QuerySliceStyle = "bracket" // Repeats the key with brackets, eg: tags[]=a&tags[]=b.
+	QuerySliceStyleIndex   QuerySliceStyle = "index"   // Suffixes the key with index, eg: tags[0]=a&tags[1]=b.
+	QuerySliceStyleComma   QuerySliceStyle = "comma"   // Joins the elements to one value, eg: tags=a,b.
+)
+
+// QueryOption is the option for QueryString converting.
+type QueryOption struct {
+	// MapOption is the option for converting the value to map, in which option Deep is always enabled.
+	MapOption MapOption
+
+	// SliceStyle specifies the style for converting slice of scalar values, which is QuerySliceStyleRepeat
+	// in default. The slice of map/struct values is always serialized with indexes, eg: items[0].name=a.
+	SliceStyle QuerySliceStyle
+}
+
+// QueryString converts `value` to query string in format of `application/x-www-form-urlencoded`, the keys
+// of which are sorted. The `value` should be a map or struct, the attributes of which are converted using
+// their tag names, and the nested map/struct attributes are converted with dotted keys, eg: user.name=john.
+// The nil values are ignored.
+func (c *Converter) QueryString(value any, option ...QueryOption) (string, error) {
+	var usedOption QueryOption
+	if len(option) > 0 {
+		usedOption = option[0]
+	}
+	var mapOption = usedOption.MapOption
+	mapOption.Deep = true
+	dataMap, err := c.Map(value, mapOption)
+	if err != nil {
+		return "", err
+	}
+	if dataMap == nil && value != nil {
+		return "", gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`value should be type of map or struct, but got: %T`,
+			value,
+		)
+	}
+	var values = make(url.Values, len(dataMap))
+	for k, v := range dataMap {
+		if err = c.doQueryStringValue(values, k, v, usedOption); err != nil {
+			return "", err
+		}
+	}
+	return values.Encode(), nil
+}
+
+// doQueryStringValue puts `value` into `values` with key `key`, or with the paths prefixed by `key`
+// recursively if `value` is a map/slice/array.
+func (c *Converter) doQueryStringValue(values url.Values, key string, value any, option QueryOption) error {
+	var reflectValue = reflect.ValueOf(value)
+	switch reflectValue.Kind() {
+	case reflect.Invalid:
+		return nil
+
+	case reflect.Pointer, reflect.Interface:
+		if reflectValue.IsNil() {
+			return nil
+		}
+		return c.doQueryStringValue(values, key, reflectValue.Elem().Interface(), option)
+
+	case reflect.Map:
+		var iter = reflectValue.MapRange()
+		for iter.Next() {
+			mapKey, err := c.String(iter.Key().Interface())
+			if err != nil {
+				return err
+			}
+			if err = c.doQueryStringValue(values, key+"."+mapKey, iter.Value().Interface(), option); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflect.Slice, reflect.Array:
+		// The bytes are treated as a leaf value.
+		if reflectValue.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		var (
+			items          = make([]string, 0, reflectValue.Len())
+			isScalarValues = true
+		)
+		for i := 0; i < reflectValue.Len(); i++ {
+			item := reflect.Indirect(reflectValue.Index(i))
+			if item.Kind() == reflect.Interface {
+				item = reflect.Indirect(item.Elem())
+			}
+			switch item.Kind() {
+			case reflect.Map, reflect.Struct, reflect.Slice, reflect.Array:
+				isScalarValues = false
+			default:
+			}
+		}
+		if !isScalarValues || option.SliceStyle == QuerySliceStyleIndex {
+			for i := 0; i < reflectValue.Len(); i++ {
+				itemKey := key + "[" + strconv.Itoa(i) + "]"
+				if err := c.doQueryStringValue(values, itemKey, reflectValue.Index(i).Interface(), option); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		for i := 0; i < reflectValue.Len(); i++ {
+			s, err := c.String(reflectValue.Index(i).Interface())
+			if err != nil {
+				return err
+			}
+			items = append(items, s)
+		}
+		switch option.SliceStyle {
+		case QuerySliceStyleBracket:
+			values[key+"[]"] = append(values[key+"[]"], items...)
+		case QuerySliceStyleComma:
+			values.Add(key, strings.Join(items, ","))
+		default:
+			values[key] = append(values[key], items...)
+		}
+		return nil
+
+	case reflect.Struct:
+		// The struct that is not converted to map, like time.Time, is treated as a leaf value.
+
+	default:
+	}
+	s, err := c.String(value)
+	if err != nil {
+		return err
+	}
+	values.Add(key, s)
+	return nil
+}
+
+// ScanQuery parses query string `query` in format of `application/x-www-form-urlencoded` and converts
+// it to `dstPointer`, which is usually a pointer to struct. The repeated keys and the keys suffixed with
+// brackets like `tags[]` are converted to slices, and the dotted and indexed keys like `user.name` and
+// `items[0].name` are converted to the nested attributes.
+func (c *Converter) ScanQuery(query string, dstPointer any, option ...ScanOption) (err error) {
+	values, err := url.ParseQuery(strings.TrimPrefix(query, "?"))
+	if err != nil {
+		return gerror.WrapCodef(gcode.CodeInvalidParameter, err, `invalid query string: %s`, query)
+	}
+	// The keys are sorted for merging the values of keys like `tags` and `tags[]` in determined order.
+	var (
+		keys   = make([]string, 0, len(values))
+		params = make(map[string]any, len(values))
+	)
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := values[k]
+		if strings.HasSuffix(k, "[]") {
+			k = strings.TrimSuffix(k, "[]")
+			params[k] = append(toQueryItems(params[k]), toQueryItems(v)...)
+			continue
+		}
+		if len(v) == 1 {
+			if _, ok := params[k]; !ok {
+				params[k] = v[0]
+				continue
+			}
+		}
+		params[k] = append(toQueryItems(params[k]), toQueryItems(v)...)
+	}
+	return c.Scan(params, dstPointer, option...)
+}
+
+// toQueryItems converts the parsed query value to []any for merging the values of the same key.
+func toQueryItems(value any) []any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []any:
+		return v
+	case []string:
+		items := make([]any, len(v))
+		for i, s := range v {
+			items[i] = s
+		}
+		return items
+	default:
+		return []any{v}
+	}
+}
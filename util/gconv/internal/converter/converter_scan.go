@@ -7,12 +7,15 @@
 package converter
 
 import (
+	"context"
 	"reflect"
+	"time"
 
 	"github.com/gogf/gf/v2/errors/gcode"
 	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/internal/json"
 	"github.com/gogf/gf/v2/util/gconv/internal/localinterface"
+	"github.com/gogf/gf/v2/util/gconv/internal/structcache"
 )
 
 // ScanOption is the option for the Scan function.
@@ -20,6 +23,11 @@ type ScanOption struct {
 	// ParamKeyToAttrMap specifies the mapping between parameter keys and struct attribute names.
 	ParamKeyToAttrMap map[string]string
 
+	// FieldNameTransformer derives the expected parameter key from the struct attribute name dynamically,
+	// eg: prefixing or pluralizing, which is usually used for generated structs. The attribute having
+	// key name in tag is not affected, and the attribute name is still matched if the derived key is absent.
+	FieldNameTransformer func(fieldName string) string
+
 	// ContinueOnError specifies whether to continue converting the next element
 	// if one element converting fails.
 	ContinueOnError bool
@@ -32,6 +40,49 @@ type ScanOption struct {
 	// OmitNil specifies whether to skip assignment when the source value is nil,
 	// preserving the existing value in the destination field.
 	OmitNil bool
+
+	// ArrayStrict specifies whether to return error if the source slice is longer than the
+	// destination fixed-size array. The overflowed elements are truncated in default.
+	ArrayStrict bool
+
+	// Location specifies the location for interpreting time string without zone information,
+	// eg: "2024-03-10 01:30:00", when converting to time attributes of type time.Time/gtime.Time.
+	// The zone information in time string has higher priority than Location, and the absolute
+	// time values like timestamp are converted to Location. It uses the local time zone if nil.
+	Location *time.Location
+
+	// TimeToUTC specifies whether to convert the time attributes to UTC after converting,
+	// which is usually used for storage.
+	TimeToUTC bool
+
+	// IgnoreUnknownFlags specifies whether to ignore the unknown flag names when binding the
+	// bitflags attributes, eg: `gconv:",flags"`. It returns error for unknown flag names in default.
+	IgnoreUnknownFlags bool
+
+	// StrictFloat specifies rejecting the special float strings like "Inf" and "NaN" for float
+	// attributes, which returns error ErrSpecialFloat.
+	StrictFloat bool
+
+	// MaxLenStrict specifies returning error ErrMaxLenExceeded instead of truncating for the string/[]byte
+	// attributes whose value exceeds the maximum length specified by tag option, eg: `gconv:"maxlen:256"`.
+	MaxLenStrict bool
+
+	// Context is passed to the default providers registered by RegisterDefaultProvider.
+	// It uses context.Background() if nil.
+	Context context.Context
+
+	// Now specifies the current time source for the time attributes having default value "now",
+	// eg: `gconv:"default:now"`, which is usually used for deterministic time in testing.
+	// It uses time.Now if nil.
+	Now func() time.Time
+
+	// recorders holds the recorders like the lossy coercion warnings and the matched attributes,
+	// which is only set by ScanWithWarnings, ScanPresence and ScanJson.
+	recorders *structRecorders
+
+	// bindNil specifies resetting the attributes to zero values for nil source values in any key matching,
+	// which is only set by ScanJson for distinguishing JSON null from absent key.
+	bindNil bool
 }
 
 func (c *Converter) getScanOption(option ...ScanOption) ScanOption {
@@ -117,7 +168,55 @@ func (c *Converter) Scan(srcValue any, dstPointer any, option ...ScanOption) (er
 		return c.Scan(srcValueReflectValue, dstPointerReflectValueElem, option...)
 	}
 
+	// Registered type converter functions have the highest priority,
+	// which also makes scalar sources work for converters registered for string type.
+	if len(c.typeConverterFuncMap) > 0 && srcValueReflectValue.IsValid() {
+		converterFunc, convertedSrcValue, srcType, ok := c.searchTypeConverterFunc(
+			srcValueReflectValue, dstPointerReflectValue,
+		)
+		if ok {
+			_, err = c.doCallCustomTypeConverter(convertedSrcValue, dstPointerReflectValue, converterFunc, srcType)
+			return err
+		}
+	}
+
+	// The source value might be a wrapper of data that implements interface function Interface,
+	// eg: *gjson.Json or *gvar.Var, it uses its underlying data directly for converting,
+	// which avoids the redundant serialization of the wrapper.
+	if v, ok := srcValue.(localinterface.IInterface); ok {
+		srcValue = v.Interface()
+		if srcValue == nil {
+			return nil
+		}
+		srcValueReflectValue = reflect.ValueOf(srcValue)
+	}
+
 	scanOption := c.getScanOption(option...)
+	// Time converting with custom location.
+	if scanOption.Location != nil || scanOption.TimeToUTC {
+		ok, err := c.bindTimeInLocation(dstPointerReflectValueElem, srcValue, scanOption.Location, scanOption.TimeToUTC)
+		if ok || err != nil {
+			return err
+		}
+	}
+	// Big number types, which cannot be converted as common structs.
+	if dstPointerReflectValueElemKind == reflect.Struct {
+		if ok, err := c.bindBigNumber(dstPointerReflectValueElem, srcValue); ok || err != nil {
+			return err
+		}
+	}
+	// Custom unmarshalling interfaces for non-struct types, eg: `type Level int` implementing
+	// encoding.TextUnmarshaler. The struct types are handled in struct converting.
+	if dstPointerReflectValueElemKind != reflect.Struct && dstPointerReflectValueElemKind != reflect.Invalid &&
+		srcValueReflectValue.IsValid() &&
+		structcache.IsCommonInterfaceType(dstPointerReflectValueElem.Type()) {
+		ok, err := bindVarToReflectValueWithInterfaceCheck(
+			dstPointerReflectValueElem, srcValueReflectValue.Interface(),
+		)
+		if ok {
+			return err
+		}
+	}
 	// Handle different destination types
 	switch dstPointerReflectValueElemKind {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
@@ -182,10 +281,15 @@ func (c *Converter) Scan(srcValue any, dstPointer any, option ...ScanOption) (er
 			var (
 				srcLen   = srcValueReflectValue.Len()
 				newSlice = reflect.MakeSlice(dstPointerReflectValueElem.Type(), srcLen, srcLen)
+				// The element types implementing custom unmarshalling interfaces are scanned one by one.
+				fastPathKind = dstElemType.Kind()
 			)
+			if structcache.IsCommonInterfaceType(dstElemType) {
+				fastPathKind = reflect.Invalid
+			}
 			for i := 0; i < srcLen; i++ {
 				srcElem := srcValueReflectValue.Index(i).Interface()
-				switch dstElemType.Kind() {
+				switch fastPathKind {
 				case reflect.String:
 					v, err := c.String(srcElem)
 					if err != nil && !scanOption.ContinueOnError {
@@ -230,12 +334,44 @@ func (c *Converter) Scan(srcValue any, dstPointer any, option ...ScanOption) (er
 		}
 		return c.doScanForComplicatedTypes(srcValue, dstPointer, dstPointerReflectType, scanOption)
 
+	case reflect.Array:
+		// Handle fixed-size array conversion
+		return c.doScanForArray(srcValue, dstPointerReflectValueElem, scanOption)
+
 	default:
 		// Handle complex types (structs, maps, etc.)
 		return c.doScanForComplicatedTypes(srcValue, dstPointer, dstPointerReflectType, scanOption)
 	}
 }
 
+// doScanForArray converts `srcValue` to fixed-size array `dstArray`, which should be addressable.
+// The destination array is zero-filled if the source is shorter than the array, and the overflowed
+// elements are truncated or returns error according to `option.ArrayStrict` if the source is longer.
+func (c *Converter) doScanForArray(srcValue any, dstArray reflect.Value, option ScanOption) error {
+	srcArray, err := c.SliceAny(srcValue, SliceOption{ContinueOnError: option.ContinueOnError})
+	if err != nil {
+		return err
+	}
+	var dstLen = dstArray.Len()
+	if len(srcArray) > dstLen {
+		if option.ArrayStrict {
+			return gerror.NewCodef(
+				gcode.CodeInvalidParameter,
+				`source length %d exceeds the destination array length %d of type: %s`,
+				len(srcArray), dstLen, dstArray.Type(),
+			)
+		}
+		srcArray = srcArray[:dstLen]
+	}
+	dstArray.SetZero()
+	for i, srcElem := range srcArray {
+		if err = c.Scan(srcElem, dstArray.Index(i).Addr(), option); err != nil && !option.ContinueOnError {
+			return err
+		}
+	}
+	return nil
+}
+
 // doScanForComplicatedTypes handles the scanning of complex data types.
 // It supports converting between maps, structs, and slices of these types.
 // The function first attempts JSON conversion, then falls back to specific type handling.
@@ -297,10 +433,20 @@ func (c *Converter) doScanForComplicatedTypes(
 				ContinueOnError: option.ContinueOnError,
 			}
 			mapOption = StructOption{
-				ParamKeyToAttrMap: keyToAttributeNameMapping,
-				ContinueOnError:   option.ContinueOnError,
-				OmitEmpty:         option.OmitEmpty,
-				OmitNil:           option.OmitNil,
+				ParamKeyToAttrMap:    keyToAttributeNameMapping,
+				FieldNameTransformer: option.FieldNameTransformer,
+				ContinueOnError:      option.ContinueOnError,
+				OmitEmpty:            option.OmitEmpty,
+				OmitNil:              option.OmitNil,
+				Location:             option.Location,
+				TimeToUTC:            option.TimeToUTC,
+				IgnoreUnknownFlags:   option.IgnoreUnknownFlags,
+				StrictFloat:          option.StrictFloat,
+				MaxLenStrict:         option.MaxLenStrict,
+				Context:              option.Context,
+				Now:                  option.Now,
+				recorders:            option.recorders,
+				bindNil:              option.bindNil,
 			}
 		)
 		return c.Structs(srcValue, dstPointer, StructsOption{
@@ -310,11 +456,21 @@ func (c *Converter) doScanForComplicatedTypes(
 
 	default:
 		structOption := StructOption{
-			ParamKeyToAttrMap: keyToAttributeNameMapping,
-			PriorityTag:       "",
-			ContinueOnError:   option.ContinueOnError,
-			OmitEmpty:         option.OmitEmpty,
-			OmitNil:           option.OmitNil,
+			ParamKeyToAttrMap:    keyToAttributeNameMapping,
+			FieldNameTransformer: option.FieldNameTransformer,
+			PriorityTag:          "",
+			ContinueOnError:      option.ContinueOnError,
+			OmitEmpty:            option.OmitEmpty,
+			OmitNil:              option.OmitNil,
+			Location:             option.Location,
+			TimeToUTC:            option.TimeToUTC,
+			IgnoreUnknownFlags:   option.IgnoreUnknownFlags,
+			StrictFloat:          option.StrictFloat,
+			MaxLenStrict:         option.MaxLenStrict,
+			Context:              option.Context,
+			Now:                  option.Now,
+			recorders:            option.recorders,
+			bindNil:              option.bindNil,
 		}
 		return c.Struct(srcValue, dstPointer, structOption)
 	}
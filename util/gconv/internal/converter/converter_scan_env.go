@@ -0,0 +1,304 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/utils"
+	"github.com/gogf/gf/v2/util/gconv/internal/structcache"
+	"github.com/gogf/gf/v2/util/gtag"
+)
+
+// ScanEnvOption is the option for the ScanEnv function.
+type ScanEnvOption struct {
+	// Prefix specifies the key prefix, like: "APP".
+	// Only the keys having this prefix are used for converting, and the prefix is trimmed
+	// from the key before path resolving.
+	Prefix string
+
+	// Separator specifies the separator for nested path, which is "_" in default.
+	Separator string
+
+	// SliceSeparator specifies the separator for splitting the value of slice attribute, eg: "," for
+	// `APP_HOSTS=a,b`. The value is not split if it is empty, which is the default.
+	SliceSeparator string
+
+	// IgnoreUnknown specifies ignoring the keys that cannot be resolved to any struct attribute.
+	// It returns an error for unknown keys if it is false.
+	IgnoreUnknown bool
+
+	// ContinueOnError specifies whether to continue converting the next element
+	// if one element converting fails.
+	ContinueOnError bool
+}
+
+// ScanEnv converts environment-style `srcMap` like `APP_SERVER_PORT=8000` to struct `dstPointer`.
+// The key of `srcMap` is split by separator into nested path, which is resolved against the
+// struct attributes, eg: `APP_SERVER_PORT` is resolved to `App.Server.Port`.
+//
+// The path segments are matched to attribute names or tag names case-insensitively and without
+// symbols, so `SERVER_MAX_BODY_SIZE` can also be resolved to `Server.MaxBodySize`.
+//
+// The slice elements can be specified by indexed keys, eg: `APP_HOSTS_0` and `APP_SERVERS_0_PORT`,
+// the elements of which are ordered by index, or by one key whose value is split using
+// ScanEnvOption.SliceSeparator if the elements are scalar values, or is in JSON format. It reverses ToEnv with the same separators.
+func (c *Converter) ScanEnv(srcMap map[string]string, dstPointer any, option ...ScanEnvOption) (err error) {
+	var usedOption ScanEnvOption
+	if len(option) > 0 {
+		usedOption = option[0]
+	}
+	if usedOption.Separator == "" {
+		usedOption.Separator = "_"
+	}
+	var dstType reflect.Type
+	if v, ok := dstPointer.(reflect.Value); ok {
+		dstType = v.Type()
+	} else {
+		dstType = reflect.TypeOf(dstPointer)
+	}
+	if dstType == nil || dstType.Kind() != reflect.Pointer {
+		return gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`destination pointer should be type of pointer, but got: %v`,
+			dstType,
+		)
+	}
+	for dstType.Kind() == reflect.Pointer {
+		dstType = dstType.Elem()
+	}
+	if dstType.Kind() != reflect.Struct {
+		return gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`destination pointer should be type of *struct, but got: %v`,
+			dstType,
+		)
+	}
+	var (
+		nestedMap   = make(map[string]any)
+		unknownKeys = make([]string, 0)
+		prefix      = usedOption.Prefix
+	)
+	if prefix != "" && !strings.HasSuffix(prefix, usedOption.Separator) {
+		prefix += usedOption.Separator
+	}
+	for key, value := range srcMap {
+		var path = key
+		if prefix != "" {
+			if len(path) <= len(prefix) || !strings.EqualFold(path[:len(prefix)], prefix) {
+				continue
+			}
+			path = path[len(prefix):]
+		}
+		var fieldPath = resolveEnvPath(dstType, strings.Split(path, usedOption.Separator))
+		if len(fieldPath) == 0 {
+			unknownKeys = append(unknownKeys, key)
+			continue
+		}
+		setNestedMapValue(nestedMap, fieldPath, value)
+	}
+	if len(unknownKeys) > 0 && !usedOption.IgnoreUnknown {
+		sort.Strings(unknownKeys)
+		return gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`unknown keys that cannot be resolved to struct attributes: %s`,
+			strings.Join(unknownKeys, ","),
+		)
+	}
+	if len(nestedMap) == 0 {
+		return nil
+	}
+	normalizeEnvNestedMap(nestedMap, dstType, usedOption)
+	return c.Struct(nestedMap, dstPointer, StructOption{
+		ContinueOnError: usedOption.ContinueOnError,
+	})
+}
+
+// resolveEnvPath resolves the key `segments` to struct attribute name path of `structType`.
+// It returns nil if the segments cannot be resolved.
+//
+// It tries the longest segments joining firstly for each struct level, so that the attribute
+// name containing separator can also be matched.
+func resolveEnvPath(structType reflect.Type, segments []string) []string {
+	for structType.Kind() == reflect.Pointer {
+		structType = structType.Elem()
+	}
+	if structType.Kind() != reflect.Struct || len(segments) == 0 {
+		return nil
+	}
+	for count := len(segments); count > 0; count-- {
+		var (
+			name  = strings.Join(segments[:count], "")
+			field reflect.StructField
+			found bool
+		)
+		if name == "" {
+			continue
+		}
+		if field, found = searchStructFieldByName(structType, name); !found {
+			continue
+		}
+		if count == len(segments) {
+			return []string{field.Name}
+		}
+		var fieldType = field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if isEnvSliceType(fieldType) && isEnvIndexSegment(segments[count]) {
+			if count+1 == len(segments) {
+				return []string{field.Name, segments[count]}
+			}
+			if subPath := resolveEnvPath(fieldType.Elem(), segments[count+1:]); len(subPath) > 0 {
+				return append([]string{field.Name, segments[count]}, subPath...)
+			}
+			continue
+		}
+		if subPath := resolveEnvPath(fieldType, segments[count:]); len(subPath) > 0 {
+			return append([]string{field.Name}, subPath...)
+		}
+	}
+	return nil
+}
+
+// isEnvSliceType checks whether `t` is the slice/array type that can be bound by indexed keys.
+func isEnvSliceType(t reflect.Type) bool {
+	return (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t.Elem().Kind() != reflect.Uint8
+}
+
+// isEnvIndexSegment checks whether path segment `segment` is the index of slice element.
+func isEnvIndexSegment(segment string) bool {
+	if segment == "" {
+		return false
+	}
+	for i := 0; i < len(segment); i++ {
+		if segment[i] < '0' || segment[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeEnvNestedMap converts the values of slice attributes in `nestedMap` of struct type
+// `structType` to slices, which are the maps of indexed elements or the strings to be split.
+func normalizeEnvNestedMap(nestedMap map[string]any, structType reflect.Type, option ScanEnvOption) {
+	for structType.Kind() == reflect.Pointer {
+		structType = structType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return
+	}
+	for name, value := range nestedMap {
+		field, ok := structType.FieldByName(name)
+		if !ok {
+			continue
+		}
+		var fieldType = field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		switch v := value.(type) {
+		case map[string]any:
+			if isEnvSliceType(fieldType) {
+				nestedMap[name] = indexedEnvMapToSlice(v, fieldType.Elem(), option)
+			} else {
+				normalizeEnvNestedMap(v, fieldType, option)
+			}
+		case string:
+			// The slice of non-scalar elements is in JSON format.
+			if isEnvSliceType(fieldType) && isEnvScalarType(fieldType.Elem()) && option.SliceSeparator != "" {
+				var items = make([]any, 0)
+				if v != "" {
+					for _, item := range strings.Split(v, option.SliceSeparator) {
+						items = append(items, item)
+					}
+				}
+				nestedMap[name] = items
+			}
+		}
+	}
+}
+
+// indexedEnvMapToSlice converts map `indexedMap` of indexed elements to slice ordered by index.
+func indexedEnvMapToSlice(indexedMap map[string]any, elemType reflect.Type, option ScanEnvOption) []any {
+	var (
+		indexes = make([]int, 0, len(indexedMap))
+		keys    = make(map[int]string, len(indexedMap))
+		items   = make([]any, 0, len(indexedMap))
+	)
+	for key := range indexedMap {
+		index, err := strconv.Atoi(key)
+		if err != nil {
+			continue
+		}
+		indexes = append(indexes, index)
+		keys[index] = key
+	}
+	sort.Ints(indexes)
+	for _, index := range indexes {
+		var item = indexedMap[keys[index]]
+		if m, ok := item.(map[string]any); ok {
+			normalizeEnvNestedMap(m, elemType, option)
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// searchStructFieldByName searches the public attribute of `structType` whose name or tag name
+// equals to `name` case-insensitively and without symbols.
+// It also searches the attributes of embedded struct.
+func searchStructFieldByName(structType reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !utils.IsLetterUpper(field.Name[0]) {
+			continue
+		}
+		if field.Anonymous {
+			var fieldType = field.Type
+			if fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				if embedded, ok := searchStructFieldByName(fieldType, name); ok {
+					return embedded, true
+				}
+			}
+		}
+		if utils.EqualFoldWithoutChars(field.Name, name) {
+			return field, true
+		}
+		for _, tag := range gtag.StructTagPriority {
+			tagValue := strings.TrimSpace(strings.Split(structcache.TrimTagOptions(tag, field.Tag.Get(tag)), ",")[0])
+			if tagValue != "" && tagValue != "-" && utils.EqualFoldWithoutChars(tagValue, name) {
+				return field, true
+			}
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// setNestedMapValue sets `value` to `m` by nested key `path`, which creates the nested map if necessary.
+func setNestedMapValue(m map[string]any, path []string, value any) {
+	for i, key := range path {
+		if i == len(path)-1 {
+			m[key] = value
+			return
+		}
+		subMap, ok := m[key].(map[string]any)
+		if !ok {
+			subMap = make(map[string]any)
+			m[key] = subMap
+		}
+		m = subMap
+	}
+}
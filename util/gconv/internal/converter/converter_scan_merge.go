@@ -0,0 +1,189 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// ScanMergeOption is the option for the ScanMerge function.
+type ScanMergeOption struct {
+	// ScanOption is the option for converting each source to the struct.
+	ScanOption ScanOption
+
+	// ReplaceNested specifies replacing the nested struct and map attributes as a whole by the
+	// later source. The nested attributes are merged deeply in default.
+	ReplaceNested bool
+
+	// AppendSlice specifies appending the slice attributes of the later source to the former ones.
+	// The slice attributes are replaced by the later source in default.
+	AppendSlice bool
+}
+
+// ScanMerge converts the sources `srcs` in order to struct `dstPointer`, in which the later source overrides
+// the attributes of the former ones. It is usually used for configuration layering like defaults, file,
+// environment and flags. The current attribute values of `dstPointer` are taken as the lowest layer.
+//
+// Each source can be a map or struct, which is converted to a new struct of the destination type firstly,
+// and then merged with the non-zero attribute values, which means the zero values of the later source
+// do not override the former ones. The nested struct, pointer to struct and map attributes are merged
+// deeply unless option ReplaceNested is set, and the slice attributes are replaced unless option
+// AppendSlice is set. The struct having no exported attributes like time.Time is always replaced.
+//
+// The `dstPointer` is left unchanged if any source fails converting.
+func (c *Converter) ScanMerge(dstPointer any, srcs []any, option ...ScanMergeOption) (err error) {
+	var usedOption ScanMergeOption
+	if len(option) > 0 {
+		usedOption = option[0]
+	}
+	var dstValue reflect.Value
+	if v, ok := dstPointer.(reflect.Value); ok {
+		dstValue = v
+	} else {
+		dstValue = reflect.ValueOf(dstPointer)
+	}
+	if dstValue.Kind() != reflect.Pointer || dstValue.IsNil() {
+		return gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`destination pointer should be type of non-nil pointer, but got: %v`,
+			dstPointer,
+		)
+	}
+	// Pointer to pointer of struct, which is initialized if it is nil.
+	var (
+		dstElem     = dstValue.Elem()
+		structType  = dstElem.Type()
+		isPtrStruct = structType.Kind() == reflect.Pointer
+	)
+	if isPtrStruct {
+		structType = structType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`destination pointer should be type of *struct or **struct, but got: %v`,
+			dstValue.Type(),
+		)
+	}
+	var merged = reflect.New(structType).Elem()
+	if isPtrStruct {
+		if !dstElem.IsNil() {
+			merged.Set(dstElem.Elem())
+		}
+	} else {
+		merged.Set(dstElem)
+	}
+	for i, src := range srcs {
+		if src == nil {
+			continue
+		}
+		var layer = reflect.New(structType)
+		if err = c.Scan(src, layer.Interface(), usedOption.ScanOption); err != nil {
+			return gerror.WrapCodef(gcode.CodeInvalidParameter, err, `convert source at index %d failed`, i)
+		}
+		mergeStructFields(merged, layer.Elem(), usedOption)
+	}
+	if isPtrStruct {
+		var pointer = reflect.New(structType)
+		pointer.Elem().Set(merged)
+		dstElem.Set(pointer)
+	} else {
+		dstElem.Set(merged)
+	}
+	return nil
+}
+
+// mergeStructFields merges the exported fields of struct `src` into the ones of addressable struct `dst`.
+func mergeStructFields(dst, src reflect.Value, option ScanMergeOption) {
+	for i := 0; i < dst.NumField(); i++ {
+		if dst.Type().Field(i).IsExported() {
+			mergeReflectValue(dst.Field(i), src.Field(i), option)
+		}
+	}
+}
+
+// mergeReflectValue merges the non-zero value `src` into addressable value `dst`.
+func mergeReflectValue(dst, src reflect.Value, option ScanMergeOption) {
+	if src.IsZero() {
+		return
+	}
+	switch dst.Kind() {
+	case reflect.Struct:
+		if option.ReplaceNested || !hasExportedField(dst.Type()) {
+			dst.Set(src)
+			return
+		}
+		mergeStructFields(dst, src, option)
+
+	case reflect.Pointer:
+		if dst.IsNil() || option.ReplaceNested || dst.Type().Elem().Kind() != reflect.Struct {
+			dst.Set(src)
+			return
+		}
+		// It merges into a copy, as the pointed value might be shared with the caller.
+		var pointer = reflect.New(dst.Type().Elem())
+		pointer.Elem().Set(dst.Elem())
+		mergeReflectValue(pointer.Elem(), src.Elem(), option)
+		dst.Set(pointer)
+
+	case reflect.Map:
+		if dst.IsNil() || option.ReplaceNested {
+			dst.Set(src)
+			return
+		}
+		var merged = reflect.MakeMapWithSize(dst.Type(), dst.Len()+src.Len())
+		for _, key := range dst.MapKeys() {
+			merged.SetMapIndex(key, dst.MapIndex(key))
+		}
+		for _, key := range src.MapKeys() {
+			var (
+				srcItem = src.MapIndex(key)
+				dstItem = merged.MapIndex(key)
+			)
+			if !dstItem.IsValid() {
+				merged.SetMapIndex(key, srcItem)
+				continue
+			}
+			var item = reflect.New(dst.Type().Elem()).Elem()
+			item.Set(dstItem)
+			mergeReflectValue(item, srcItem, option)
+			merged.SetMapIndex(key, item)
+		}
+		dst.Set(merged)
+
+	case reflect.Interface:
+		var (
+			dstItem = dst.Elem()
+			srcItem = src.Elem()
+		)
+		if option.ReplaceNested || !dstItem.IsValid() || dstItem.Kind() != reflect.Map ||
+			dstItem.Type() != srcItem.Type() {
+			dst.Set(src)
+			return
+		}
+		var item = reflect.New(dstItem.Type()).Elem()
+		item.Set(dstItem)
+		mergeReflectValue(item, srcItem, option)
+		dst.Set(item)
+
+	case reflect.Slice:
+		if !option.AppendSlice || dst.IsNil() {
+			dst.Set(src)
+			return
+		}
+		var merged = reflect.MakeSlice(dst.Type(), 0, dst.Len()+src.Len())
+		merged = reflect.AppendSlice(merged, dst)
+		merged = reflect.AppendSlice(merged, src)
+		dst.Set(merged)
+
+	default:
+		dst.Set(src)
+	}
+}
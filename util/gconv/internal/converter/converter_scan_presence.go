@@ -0,0 +1,48 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"strings"
+)
+
+// scanPresenceRecorder records the struct attributes having matched source keys during converting.
+type scanPresenceRecorder struct {
+	path    []string        // Current path of struct attributes.
+	present map[string]bool // Paths of the attributes having matched source keys.
+}
+
+// ScanPresence does the same as Scan, but it also returns the paths of struct attributes having matched
+// keys in the source, which distinguishes the attributes that are present in the source from the ones
+// that are absent, even if the source values are empty.
+// The path of nested struct attribute is joined with char '.', like: "Address.City".
+func (c *Converter) ScanPresence(
+	srcValue any, dstPointer any, option ...ScanOption,
+) (present map[string]bool, err error) {
+	var (
+		usedOption = c.getScanOption(option...)
+		recorder   = &scanPresenceRecorder{
+			present: make(map[string]bool),
+		}
+	)
+	usedOption.recorders = usedOption.recorders.with(func(recorders *structRecorders) {
+		recorders.presence = recorder
+	})
+	err = c.Scan(srcValue, dstPointer, usedOption)
+	return recorder.present, err
+}
+
+// push enters the struct attribute `name` and marks it present.
+func (r *scanPresenceRecorder) push(name string) {
+	r.path = append(r.path, name)
+	r.present[strings.Join(r.path, ".")] = true
+}
+
+// pop leaves the current struct attribute.
+func (r *scanPresenceRecorder) pop() {
+	r.path = r.path[:len(r.path)-1]
+}
@@ -0,0 +1,86 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	stdjson "encoding/json"
+	"io"
+	"reflect"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/json"
+)
+
+// ScanStream decodes the JSON array from `reader` element by element, converts each element to
+// `elemType` and sends the converted element to `out`. Only one element is held in memory at a time,
+// so it is suitable for processing huge JSON arrays with bounded memory.
+//
+// The error is also sent to `out` as an element of type `error`, so that the receiver can handle
+// the elements and errors in order. If option.ContinueOnError is true, the element that fails
+// converting is skipped and the next element is processed, or else the streaming stops at the first
+// error. The malformed JSON content always stops the streaming.
+//
+// The channel `out` is closed when the streaming is done, and the first error is returned.
+func (c *Converter) ScanStream(reader io.Reader, elemType reflect.Type, out chan<- any, option ...ScanOption) (err error) {
+	defer close(out)
+	if elemType == nil {
+		err = gerror.NewCode(gcode.CodeInvalidParameter, `element type should not be nil`)
+		out <- err
+		return
+	}
+	var (
+		scanOption = c.getScanOption(option...)
+		decoder    = json.NewDecoder(reader)
+		sendError  = func(e error) {
+			if err == nil {
+				err = e
+			}
+			out <- e
+		}
+	)
+	decoder.UseNumber()
+	token, tokenErr := decoder.Token()
+	if tokenErr != nil {
+		if tokenErr != io.EOF {
+			sendError(gerror.WrapCode(gcode.CodeInvalidParameter, tokenErr, `read JSON array start failed`))
+		}
+		return
+	}
+	if delim, ok := token.(stdjson.Delim); !ok || delim != '[' {
+		sendError(gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`stream content should be JSON array, but got first token: %v`,
+			token,
+		))
+		return
+	}
+	for index := 0; decoder.More(); index++ {
+		var element any
+		if decodeErr := decoder.Decode(&element); decodeErr != nil {
+			sendError(gerror.WrapCodef(
+				gcode.CodeInvalidParameter, decodeErr, `decode JSON array element at index %d failed`, index,
+			))
+			return
+		}
+		elemPointer := reflect.New(elemType)
+		if scanErr := c.Scan(element, elemPointer, scanOption); scanErr != nil {
+			sendError(gerror.WrapCodef(
+				gcode.CodeInvalidParameter, scanErr, `convert JSON array element at index %d failed`, index,
+			))
+			if scanOption.ContinueOnError {
+				continue
+			}
+			return
+		}
+		out <- elemPointer.Elem().Interface()
+	}
+	if _, tokenErr = decoder.Token(); tokenErr != nil {
+		sendError(gerror.WrapCode(gcode.CodeInvalidParameter, tokenErr, `read JSON array end failed`))
+	}
+	return
+}
@@ -0,0 +1,209 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ScanWarning is the non-fatal warning of lossy type coercion during Scan, like float to int truncation.
+type ScanWarning struct {
+	Field  string // Path of the struct attribute, like: "User.Age".
+	Value  any    // Source value.
+	Reason string // Reason of the warning.
+}
+
+// String implements the interface fmt.Stringer.
+func (w ScanWarning) String() string {
+	return fmt.Sprintf(`%s: %s, source value: %v`, w.Field, w.Reason, w.Value)
+}
+
+// scanWarningRecorder records the warnings during converting.
+type scanWarningRecorder struct {
+	path     []string      // Current path of struct attributes.
+	warnings []ScanWarning // Recorded warnings.
+}
+
+// boolTokenMap contains the expected string tokens for bool converting.
+var boolTokenMap = map[string]struct{}{
+	"":      {},
+	"0":     {},
+	"1":     {},
+	"t":     {},
+	"f":     {},
+	"true":  {},
+	"false": {},
+	"yes":   {},
+	"no":    {},
+	"on":    {},
+	"off":   {},
+}
+
+// ScanWithWarnings does the same as Scan, but it also returns the non-fatal warnings of lossy type
+// coercion for struct attributes, like float to int truncation, integer overflow and string to bool
+// converting from unexpected token.
+func (c *Converter) ScanWithWarnings(
+	srcValue any, dstPointer any, option ...ScanOption,
+) (warnings []ScanWarning, err error) {
+	var (
+		usedOption = c.getScanOption(option...)
+		recorder   = &scanWarningRecorder{}
+	)
+	usedOption.recorders = usedOption.recorders.with(func(recorders *structRecorders) {
+		recorders.warning = recorder
+	})
+	err = c.Scan(srcValue, dstPointer, usedOption)
+	return recorder.warnings, err
+}
+
+// push enters the struct attribute `name`.
+func (r *scanWarningRecorder) push(name string) {
+	r.path = append(r.path, name)
+}
+
+// pop leaves the current struct attribute.
+func (r *scanWarningRecorder) pop() {
+	r.path = r.path[:len(r.path)-1]
+}
+
+// check checks the converting result `fieldValue` from `srcValue` and records the warning if lossy.
+func (r *scanWarningRecorder) check(fieldValue reflect.Value, srcValue any) {
+	if v, ok := srcValue.(reflect.Value); ok {
+		if !v.IsValid() || !v.CanInterface() {
+			return
+		}
+		srcValue = v.Interface()
+	}
+	for fieldValue.Kind() == reflect.Pointer {
+		if fieldValue.IsNil() {
+			return
+		}
+		fieldValue = fieldValue.Elem()
+	}
+	if reason := coercionWarningReason(fieldValue, srcValue); reason != "" {
+		r.warnings = append(r.warnings, ScanWarning{
+			Field:  strings.Join(r.path, "."),
+			Value:  srcValue,
+			Reason: reason,
+		})
+	}
+}
+
+// coercionWarningReason returns the reason if converting from `srcValue` to `fieldValue` is lossy.
+// It returns an empty string if there's nothing suspicious.
+func coercionWarningReason(fieldValue reflect.Value, srcValue any) string {
+	srcReflectValue := reflect.ValueOf(srcValue)
+	for srcReflectValue.Kind() == reflect.Pointer {
+		if srcReflectValue.IsNil() {
+			return ""
+		}
+		srcReflectValue = srcReflectValue.Elem()
+	}
+	var srcKind = srcReflectValue.Kind()
+	if srcKind == fieldValue.Kind() {
+		return ""
+	}
+	switch fieldValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var result float64
+		if fieldValue.CanInt() {
+			result = float64(fieldValue.Int())
+		} else {
+			result = float64(fieldValue.Uint())
+		}
+		switch srcKind {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if fieldValue.CanInt() {
+				if fieldValue.Int() != srcReflectValue.Int() {
+					return fmt.Sprintf(`value overflows type %s`, fieldValue.Type())
+				}
+			} else if srcReflectValue.Int() < 0 || fieldValue.Uint() != uint64(srcReflectValue.Int()) {
+				return fmt.Sprintf(`value overflows type %s`, fieldValue.Type())
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if fieldValue.CanUint() {
+				if fieldValue.Uint() != srcReflectValue.Uint() {
+					return fmt.Sprintf(`value overflows type %s`, fieldValue.Type())
+				}
+			} else if fieldValue.Int() < 0 || uint64(fieldValue.Int()) != srcReflectValue.Uint() {
+				return fmt.Sprintf(`value overflows type %s`, fieldValue.Type())
+			}
+		case reflect.Float32, reflect.Float64:
+			return numberCoercionWarningReason(fieldValue.Type(), srcReflectValue.Float(), result)
+		case reflect.String:
+			s := strings.TrimSpace(srcReflectValue.String())
+			if s == "" {
+				return ""
+			}
+			// It might be other formats like hex, which are not checked.
+			f, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return ""
+			}
+			return numberCoercionWarningReason(fieldValue.Type(), f, result)
+		}
+
+	case reflect.Float32:
+		var f float64
+		switch srcKind {
+		case reflect.Float64:
+			f = srcReflectValue.Float()
+		case reflect.String:
+			s := strings.TrimSpace(srcReflectValue.String())
+			if s == "" {
+				return ""
+			}
+			var err error
+			if f, err = strconv.ParseFloat(s, 64); err != nil {
+				return ""
+			}
+		default:
+			return ""
+		}
+		if !math.IsInf(f, 0) && math.IsInf(float64(float32(f)), 0) {
+			return fmt.Sprintf(`value overflows type %s`, fieldValue.Type())
+		}
+
+	case reflect.Bool:
+		switch srcKind {
+		case reflect.String:
+			if _, ok := boolTokenMap[strings.ToLower(strings.TrimSpace(srcReflectValue.String()))]; !ok {
+				return `unexpected token converted to bool`
+			}
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if v := srcReflectValue.Int(); v != 0 && v != 1 {
+				return `non-boolean number converted to bool`
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if v := srcReflectValue.Uint(); v != 0 && v != 1 {
+				return `non-boolean number converted to bool`
+			}
+		case reflect.Float32, reflect.Float64:
+			if v := srcReflectValue.Float(); v != 0 && v != 1 {
+				return `non-boolean number converted to bool`
+			}
+		}
+	}
+	return ""
+}
+
+// numberCoercionWarningReason returns the reason if converting float `src` to integer `result`
+// of type `t` is lossy.
+func numberCoercionWarningReason(t reflect.Type, src, result float64) string {
+	if src == result {
+		return ""
+	}
+	if src != math.Trunc(src) && math.Trunc(src) == result {
+		return fmt.Sprintf(`fractional part truncated converting to type %s`, t)
+	}
+	return fmt.Sprintf(`value overflows type %s`, t)
+}
@@ -0,0 +1,131 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// SliceUnique converts `srcValue` to the slice `dstPointer` pointing to, and removes the duplicated
+// elements by their converted values, which keeps the order of their first occurrences.
+//
+// The element type of the destination slice should be comparable, or else it returns error. It also
+// returns error if any converted element is not comparable at runtime, like the slice in []any.
+// The `dstPointer` is left unchanged if it fails.
+func (c *Converter) SliceUnique(srcValue any, dstPointer any) (err error) {
+	dstValue, err := getUniqueDstValue(dstPointer, reflect.Slice)
+	if err != nil {
+		return err
+	}
+	uniqueSlice, err := c.doSliceUnique(srcValue, dstValue.Type())
+	if err != nil {
+		return err
+	}
+	dstValue.Set(uniqueSlice)
+	return nil
+}
+
+// ToSet converts `srcValue` to the set `dstPointer` pointing to, which is a map with empty struct values
+// like map[string]struct{}, and its keys are the converted elements of `srcValue`.
+//
+// The key type of the destination map should be comparable, or else it returns error. It also returns
+// error if any converted element is not comparable at runtime. The `dstPointer` is left unchanged if
+// it fails.
+func (c *Converter) ToSet(srcValue any, dstPointer any) (err error) {
+	dstValue, err := getUniqueDstValue(dstPointer, reflect.Map)
+	if err != nil {
+		return err
+	}
+	var setType = dstValue.Type()
+	if setType.Elem().Kind() != reflect.Struct || setType.Elem().NumField() != 0 {
+		return gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`destination pointer should be type of pointer to map[T]struct{}, but got: %v`,
+			dstPointer,
+		)
+	}
+	uniqueSlice, err := c.doSliceUnique(srcValue, reflect.SliceOf(setType.Key()))
+	if err != nil {
+		return err
+	}
+	var (
+		set   = reflect.MakeMapWithSize(setType, uniqueSlice.Len())
+		empty = reflect.New(setType.Elem()).Elem()
+	)
+	for i := 0; i < uniqueSlice.Len(); i++ {
+		set.SetMapIndex(uniqueSlice.Index(i), empty)
+	}
+	dstValue.Set(set)
+	return nil
+}
+
+// getUniqueDstValue checks and returns the element of `dstPointer`, which should be a non-nil pointer to
+// the type of kind `kind` with comparable elements or keys.
+func getUniqueDstValue(dstPointer any, kind reflect.Kind) (reflect.Value, error) {
+	dstValue := reflect.ValueOf(dstPointer)
+	if dstValue.Kind() != reflect.Pointer || dstValue.IsNil() || dstValue.Elem().Kind() != kind {
+		return reflect.Value{}, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`destination pointer should be type of non-nil pointer to %s, but got: %v`,
+			kind, dstPointer,
+		)
+	}
+	var elemType = dstValue.Elem().Type()
+	if kind == reflect.Map {
+		elemType = elemType.Key()
+	} else {
+		elemType = elemType.Elem()
+	}
+	if !elemType.Comparable() {
+		return reflect.Value{}, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`element type "%s" of destination is not comparable`,
+			elemType,
+		)
+	}
+	return dstValue.Elem(), nil
+}
+
+// doSliceUnique converts `srcValue` to slice of type `sliceType` and returns it with the duplicated
+// elements removed.
+func (c *Converter) doSliceUnique(srcValue any, sliceType reflect.Type) (reflect.Value, error) {
+	// The source is converted to []any firstly, so that the single value and JSON string are
+	// converted as slice.
+	items, err := c.SliceAny(srcValue)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	var converted = reflect.New(sliceType)
+	if err = c.Scan(items, converted.Interface()); err != nil {
+		return reflect.Value{}, err
+	}
+	var (
+		slice       = converted.Elem()
+		uniqueSlice = reflect.MakeSlice(sliceType, 0, slice.Len())
+		seen        = make(map[any]struct{}, slice.Len())
+	)
+	for i := 0; i < slice.Len(); i++ {
+		item := slice.Index(i)
+		if !item.Comparable() {
+			return reflect.Value{}, gerror.NewCodef(
+				gcode.CodeInvalidParameter,
+				`element at index %d of type "%s" is not comparable`,
+				i, reflect.TypeOf(item.Interface()),
+			)
+		}
+		key := item.Interface()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		uniqueSlice = reflect.Append(uniqueSlice, item)
+	}
+	return uniqueSlice, nil
+}
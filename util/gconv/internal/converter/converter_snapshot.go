@@ -0,0 +1,171 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding"
+	"encoding/hex"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// ScanSnapshot does the same as Scan, but it also returns the snapshot hash of `dstPointer` after converting,
+// which can be compared with the one of SnapshotHash later for detecting the mutations of converted value.
+func (c *Converter) ScanSnapshot(srcValue any, dstPointer any, option ...ScanOption) (hash string, err error) {
+	if err = c.Scan(srcValue, dstPointer, option...); err != nil {
+		return "", err
+	}
+	return SnapshotHash(dstPointer), nil
+}
+
+// SnapshotHash computes and returns the SHA-256 hash in hex of the content of `value`, which is stable
+// across runs for the identical content. It walks the nested values recursively, in which the pointers
+// are dereferenced, the map items are sorted by key, and only the exported struct attributes are taken
+// into account. The value implementing encoding.TextMarshaler like time.Time is hashed with its text.
+func SnapshotHash(value any) string {
+	var (
+		h      = sha256.New()
+		writer = snapshotWriter{
+			writer:  h,
+			visited: make(map[uintptr]struct{}),
+		}
+	)
+	writer.write(reflect.ValueOf(value))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// textMarshalerType is the reflection type of encoding.TextMarshaler.
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+// snapshotWriter writes the content of value to writer in a deterministic encoding.
+type snapshotWriter struct {
+	writer  io.Writer
+	visited map[uintptr]struct{} // Pointers on current path, which stops the recursion of cyclic references.
+}
+
+// writeString writes `s` with its length prefix, which avoids ambiguity of adjacent strings.
+func (w *snapshotWriter) writeString(s string) {
+	_, _ = io.WriteString(w.writer, strconv.Itoa(len(s)))
+	_, _ = io.WriteString(w.writer, ":")
+	_, _ = io.WriteString(w.writer, s)
+}
+
+// write writes the content of `v` along with its kind.
+func (w *snapshotWriter) write(v reflect.Value) {
+	if !v.IsValid() {
+		w.writeString("nil")
+		return
+	}
+	if v.Type().Implements(textMarshalerType) && v.CanInterface() &&
+		(v.Kind() != reflect.Pointer || !v.IsNil()) {
+		if text, err := v.Interface().(encoding.TextMarshaler).MarshalText(); err == nil {
+			w.writeString("text")
+			w.writeString(string(text))
+			return
+		}
+	}
+	// The pointer is transparent, which makes the pointer and its pointed value the same.
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			w.writeString("nil")
+			return
+		}
+		var pointer = v.Pointer()
+		if _, ok := w.visited[pointer]; ok {
+			w.writeString("cycle")
+			return
+		}
+		w.visited[pointer] = struct{}{}
+		w.write(v.Elem())
+		delete(w.visited, pointer)
+		return
+	}
+	w.writeString(v.Kind().String())
+	switch v.Kind() {
+	case reflect.Bool:
+		w.writeString(strconv.FormatBool(v.Bool()))
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		w.writeString(strconv.FormatInt(v.Int(), 10))
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		w.writeString(strconv.FormatUint(v.Uint(), 10))
+
+	case reflect.Float32, reflect.Float64:
+		w.writeString(strconv.FormatFloat(v.Float(), 'g', -1, 64))
+
+	case reflect.Complex64, reflect.Complex128:
+		w.writeString(strconv.FormatComplex(v.Complex(), 'g', -1, 128))
+
+	case reflect.String:
+		w.writeString(v.String())
+
+	case reflect.Interface:
+		if v.IsNil() {
+			w.writeString("nil")
+			return
+		}
+		w.writeString(v.Elem().Type().String())
+		w.write(v.Elem())
+
+	case reflect.Struct:
+		w.writeString(v.Type().String())
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			w.writeString(v.Type().Field(i).Name)
+			w.write(v.Field(i))
+		}
+
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			w.writeString("nil")
+			return
+		}
+		w.writeString(strconv.Itoa(v.Len()))
+		for i := 0; i < v.Len(); i++ {
+			w.write(v.Index(i))
+		}
+
+	case reflect.Map:
+		if v.IsNil() {
+			w.writeString("nil")
+			return
+		}
+		// The map items are sorted by the encoding of their keys.
+		type mapItem struct {
+			key   []byte
+			value reflect.Value
+		}
+		var (
+			items  = make([]mapItem, 0, v.Len())
+			buffer = &bytes.Buffer{}
+		)
+		for _, key := range v.MapKeys() {
+			buffer.Reset()
+			(&snapshotWriter{writer: buffer, visited: w.visited}).write(key)
+			items = append(items, mapItem{key: append([]byte(nil), buffer.Bytes()...), value: v.MapIndex(key)})
+		}
+		sort.Slice(items, func(i, j int) bool {
+			return bytes.Compare(items[i].key, items[j].key) < 0
+		})
+		w.writeString(strconv.Itoa(len(items)))
+		for _, item := range items {
+			_, _ = w.writer.Write(item.key)
+			w.write(item.value)
+		}
+
+	default:
+		// The func, chan and unsafe pointer are only hashed with their nil status.
+		w.writeString(strconv.FormatBool(v.IsNil()))
+	}
+}
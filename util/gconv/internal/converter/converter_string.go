@@ -8,6 +8,7 @@ package converter
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
 	"strconv"
 	"time"
@@ -77,6 +78,10 @@ func (c *Converter) String(anyInput any) (string, error) {
 			return "", nil
 		}
 		return value.String(), nil
+	case big.Int:
+		return value.String(), nil
+	case big.Float:
+		return value.String(), nil
 	default:
 		if f, ok := value.(localinterface.IString); ok {
 			// If the variable implements the String() interface,
@@ -7,8 +7,11 @@
 package converter
 
 import (
+	"context"
+	"errors"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/gogf/gf/v2/errors/gcode"
 	"github.com/gogf/gf/v2/errors/gerror"
@@ -24,6 +27,10 @@ type StructOption struct {
 	// ParamKeyToAttrMap is the map for custom parameter key to attribute name mapping.
 	ParamKeyToAttrMap map[string]string
 
+	// FieldNameTransformer derives the expected parameter key from the attribute name dynamically,
+	// which is not applied to the attribute having key name in tag.
+	FieldNameTransformer func(fieldName string) string
+
 	// PriorityTag is the priority tag for struct converting.
 	PriorityTag string
 
@@ -39,6 +46,49 @@ type StructOption struct {
 	// OmitNil specifies whether to skip assignment when the source value is nil,
 	// preserving the existing value in the destination field.
 	OmitNil bool
+
+	// Location specifies the location for interpreting time string without zone information,
+	// eg: "2024-03-10 01:30:00", when converting to time attributes of type time.Time/gtime.Time.
+	// The zone information in time string has higher priority than Location, and the absolute
+	// time values like timestamp are converted to Location. It uses the local time zone if nil.
+	Location *time.Location
+
+	// TimeToUTC specifies whether to convert the time attributes to UTC after converting,
+	// which is usually used for storage.
+	TimeToUTC bool
+
+	// IgnoreUnknownFlags specifies whether to ignore the unknown flag names when binding the
+	// bitflags attributes, eg: `gconv:",flags"`. It returns error for unknown flag names in default.
+	IgnoreUnknownFlags bool
+
+	// StrictFloat specifies rejecting the special float strings like "Inf" and "NaN" for float
+	// attributes, which returns error ErrSpecialFloat.
+	StrictFloat bool
+
+	// MaxLenStrict specifies returning error ErrMaxLenExceeded instead of truncating for the string/[]byte
+	// attributes whose value exceeds the maximum length specified by tag option, eg: `gconv:"maxlen:256"`.
+	MaxLenStrict bool
+
+	// Context is passed to the default providers registered by RegisterDefaultProvider.
+	// It uses context.Background() if nil.
+	Context context.Context
+
+	// Now specifies the current time source for the time attributes having default value "now",
+	// eg: `gconv:"default:now"`, which is usually used for deterministic time in testing.
+	// It uses time.Now if nil.
+	Now func() time.Time
+
+	// recorders holds the recorders like the lossy coercion warnings and the missing required attributes,
+	// which is nil unless any of them is needed.
+	recorders *structRecorders
+
+	// bindNil specifies resetting the attributes to zero values for nil source values in any key matching,
+	// which is only set by ScanJson for distinguishing JSON null from absent key.
+	bindNil bool
+
+	// visitedRecorder records the destination pointers of the visited source pointers, which is set by
+	// the outermost struct converting from pointer source for breaking the reference cycles.
+	visitedRecorder *structVisitedRecorder
 }
 
 func (c *Converter) getStructOption(option ...StructOption) StructOption {
@@ -158,6 +208,29 @@ func (c *Converter) Struct(params, pointer any, option ...StructOption) (err err
 		// Retrieve its element, may be struct at last.
 		pointerElemReflectValue = pointerElemReflectValue.Elem()
 	}
+	// The source pointer is recorded before its attributes converting, so that the source pointer referenced
+	// again by its attributes is bound to the same destination instead of converting endlessly.
+	if structOption.visitedRecorder == nil {
+		structOption.visitedRecorder = newStructVisitedRecorderFor(paramsReflectValue)
+	}
+	structOption.visitedRecorder.store(paramsReflectValue, pointerElemReflectValue)
+	// Slice params like csv row are bound by position if the struct has attributes with index tag option.
+	if positionalParams, isPositional := getPositionalParams(paramsReflectValue); isPositional {
+		cachedStructInfo := c.internalConverter.GetCachedStructInfo(
+			pointerElemReflectValue.Type(), structOption.PriorityTag,
+		)
+		if cachedStructInfo != nil && cachedStructInfo.HasIndex() {
+			if err = c.bindStructWithPositionalParams(
+				pointerElemReflectValue, cachedStructInfo, positionalParams, structOption,
+			); err != nil {
+				return err
+			}
+			if len(c.defaultProviderMap) > 0 {
+				return c.bindStructWithDefaultProviders(pointerElemReflectValue, cachedStructInfo, structOption)
+			}
+			return nil
+		}
+	}
 	paramsMap, ok := paramsInterface.(map[string]any)
 	if !ok {
 		// paramsMap is the map[string]any type variable for params.
@@ -176,14 +249,27 @@ func (c *Converter) Struct(params, pointer any, option ...StructOption) (err err
 			)
 		}
 	}
-	// Nothing to be done as the parameters are empty.
+	// Nothing to be done as the parameters are empty, except the default values and required checks.
 	if len(paramsMap) == 0 {
-		return nil
+		return c.bindStructWithEmptyParams(pointerElemReflectValue, structOption)
 	}
 	// Get struct info from cache or parse struct and cache the struct info.
 	cachedStructInfo := c.internalConverter.GetCachedStructInfo(
 		pointerElemReflectValue.Type(), structOption.PriorityTag,
 	)
+	// The keys in format of FlatMap like `user.name` and `items[0].name` are converted to nested values,
+	// which is only checked for the struct having nested attributes as the checking loops all the keys.
+	if cachedStructInfo != nil && cachedStructInfo.HasNestedField() && hasFlatMapKey(paramsMap) {
+		paramsMap = unflatMap(paramsMap)
+	}
+	// The custom field setter of the struct has priority over the reflection assignment.
+	if pointerElemReflectValue.CanAddr() {
+		if setter, ok := pointerElemReflectValue.Addr().Interface().(localinterface.ISetField); ok {
+			if paramsMap, err = c.bindStructWithFieldSetter(setter, paramsMap, structOption); err != nil {
+				return err
+			}
+		}
+	}
 	// Nothing to be converted.
 	if cachedStructInfo == nil {
 		return nil
@@ -193,6 +279,33 @@ func (c *Converter) Struct(params, pointer any, option ...StructOption) (err err
 	if cachedStructInfo.HasNoFields() {
 		return nil
 	}
+	// The missing required attributes of all levels are collected into one error by the outermost struct.
+	// The nested required attributes are checked only if there's any nested attribute, as it looks up the type cache.
+	if structOption.recorders.getRequired() == nil &&
+		(cachedStructInfo.HasRequired() ||
+			cachedStructInfo.HasNestedField() && isRequiredStructType(pointerElemReflectValue.Type())) {
+		var recorder = &structRequiredRecorder{}
+		structOption.recorders = structOption.recorders.with(func(recorders *structRecorders) {
+			recorders.required = recorder
+		})
+		defer func() {
+			if err == nil {
+				err = recorder.error()
+			}
+		}()
+	}
+	// The default providers are called for the zero attributes after all the converting done.
+	if len(c.defaultProviderMap) > 0 {
+		defer func() {
+			if err == nil {
+				err = c.bindStructWithDefaultProviders(pointerElemReflectValue, cachedStructInfo, structOption)
+			}
+		}()
+	}
+	// The parameters might be all consumed by the custom field setter.
+	if len(paramsMap) == 0 {
+		return c.bindStructWithoutParams(pointerElemReflectValue, cachedStructInfo, structOption)
+	}
 	var (
 		// Indicates that those values have been used and cannot be reused.
 		usedParamsKeyOrTagNameMap = structcache.GetUsedParamsKeyOrTagNameMapFromPool()
@@ -230,7 +343,8 @@ func (c *Converter) Struct(params, pointer any, option ...StructOption) (err err
 		}
 	}
 	// Already done converting for given `paramsMap`.
-	if len(usedParamsKeyOrTagNameMap) == len(paramsMap) {
+	if len(usedParamsKeyOrTagNameMap) == len(paramsMap) &&
+		!cachedStructInfo.HasDefaultValue() && !cachedStructInfo.HasRequired() {
 		return nil
 	}
 	return c.bindStructWithLoopFieldInfos(
@@ -240,6 +354,61 @@ func (c *Converter) Struct(params, pointer any, option ...StructOption) (err err
 	)
 }
 
+// bindStructWithEmptyParams binds the empty parameters to `structValue`, which retrieves the struct info only
+// for the default values, the required checks and the default providers.
+func (c *Converter) bindStructWithEmptyParams(structValue reflect.Value, option StructOption) (err error) {
+	cachedStructInfo := c.internalConverter.GetCachedStructInfo(structValue.Type(), option.PriorityTag)
+	if cachedStructInfo == nil || cachedStructInfo.HasNoFields() {
+		return nil
+	}
+	if option.recorders.getRequired() == nil && cachedStructInfo.HasRequired() {
+		var recorder = &structRequiredRecorder{}
+		option.recorders = option.recorders.with(func(recorders *structRecorders) {
+			recorders.required = recorder
+		})
+		defer func() {
+			if err == nil {
+				err = recorder.error()
+			}
+		}()
+	}
+	if err = c.bindStructWithoutParams(structValue, cachedStructInfo, option); err != nil {
+		return err
+	}
+	if len(c.defaultProviderMap) > 0 {
+		return c.bindStructWithDefaultProviders(structValue, cachedStructInfo, option)
+	}
+	return nil
+}
+
+// bindStructWithoutParams binds the default values to `structValue` and records the missing required
+// attributes, as there's no parameter for binding.
+func (c *Converter) bindStructWithoutParams(
+	structValue reflect.Value, cachedStructInfo *structcache.CachedStructInfo, option StructOption,
+) error {
+	if cachedStructInfo.HasRequired() {
+		c.recordMissingRequiredFields(cachedStructInfo.GetFieldConvertInfos(), option)
+	}
+	if cachedStructInfo.HasDefaultValue() {
+		return c.bindStructWithDefaultValues(structValue, cachedStructInfo, nil, option)
+	}
+	return nil
+}
+
+// getFieldMatchingKeys returns the parameter keys for matching the attribute in priority, which are the tag
+// name and the attribute name. The key derived by option FieldNameTransformer has priority over the attribute
+// name if the attribute has no tag name.
+func getFieldMatchingKeys(cachedFieldInfo *structcache.CachedFieldInfo, option StructOption) []string {
+	if option.FieldNameTransformer == nil || len(cachedFieldInfo.PriorityTagAndFieldName) > 1 {
+		return cachedFieldInfo.PriorityTagAndFieldName
+	}
+	var fieldName = cachedFieldInfo.FieldName()
+	if transformedKey := option.FieldNameTransformer(fieldName); transformedKey != "" && transformedKey != fieldName {
+		return []string{transformedKey, fieldName}
+	}
+	return cachedFieldInfo.PriorityTagAndFieldName
+}
+
 func (c *Converter) setOtherSameNameField(
 	cachedFieldInfo *structcache.CachedFieldInfo,
 	srcValue any,
@@ -265,15 +434,17 @@ func (c *Converter) bindStructWithLoopFieldInfos(
 ) (err error) {
 	var (
 		cachedFieldInfo *structcache.CachedFieldInfo
-		fuzzLastKey     string
-		fieldValue      reflect.Value
-		paramKey        string
-		paramValue      any
-		matched         bool
-		ok              bool
+		// unboundFieldInfos holds the fields having default values but missing in `paramsMap`.
+		unboundFieldInfos []*structcache.CachedFieldInfo
+		fuzzLastKey       string
+		fieldValue        reflect.Value
+		paramKey          string
+		paramValue        any
+		matched           bool
+		ok                bool
 	)
 	for _, cachedFieldInfo = range cachedStructInfo.GetFieldConvertInfos() {
-		for _, fieldTag := range cachedFieldInfo.PriorityTagAndFieldName {
+		for _, fieldTag := range getFieldMatchingKeys(cachedFieldInfo, option) {
 			if paramValue, ok = paramsMap[fieldTag]; !ok {
 				continue
 			}
@@ -301,7 +472,9 @@ func (c *Converter) bindStructWithLoopFieldInfos(
 		}
 
 		fuzzLastKey = cachedFieldInfo.LastFuzzyKey.Load().(string)
-		if paramValue, ok = paramsMap[fuzzLastKey]; !ok {
+		if paramValue, ok = paramsMap[fuzzLastKey]; ok {
+			paramKey = fuzzLastKey
+		} else {
 			paramKey, paramValue = fuzzyMatchingFieldName(
 				cachedFieldInfo.RemoveSymbolsFieldName, paramsMap, usedParamsKeyOrTagNameMap,
 			)
@@ -310,7 +483,7 @@ func (c *Converter) bindStructWithLoopFieldInfos(
 		}
 		if ok {
 			fieldValue = cachedFieldInfo.GetFieldReflectValueFrom(structValue)
-			if paramValue != nil {
+			if paramValue != nil || option.bindNil {
 				if err = c.bindVarToStructField(
 					cachedFieldInfo, fieldValue, paramValue, option,
 				); err != nil && !option.ContinueOnError {
@@ -324,13 +497,193 @@ func (c *Converter) bindStructWithLoopFieldInfos(
 						return err
 					}
 				}
+			} else if recorder := option.recorders.getPresence(); recorder != nil {
+				// The nil value is not bound, but it is still present in the source.
+				recorder.push(cachedFieldInfo.FieldName())
+				recorder.pop()
 			}
 			usedParamsKeyOrTagNameMap[paramKey] = struct{}{}
+			continue
+		}
+		if cachedFieldInfo.HasDefaultValue {
+			unboundFieldInfos = append(unboundFieldInfos, cachedFieldInfo)
+		}
+		if cachedFieldInfo.IsRequired {
+			c.recordMissingRequiredFields([]*structcache.CachedFieldInfo{cachedFieldInfo}, option)
+		}
+	}
+	if patternFieldInfos := cachedStructInfo.GetPatternFieldInfos(); len(patternFieldInfos) > 0 {
+		if err = c.bindStructWithPatternParams(
+			paramsMap, structValue, usedParamsKeyOrTagNameMap, patternFieldInfos, option,
+		); err != nil && !option.ContinueOnError {
+			return err
+		}
+	}
+	if remainingFieldInfo := cachedStructInfo.GetRemainingFieldInfo(); remainingFieldInfo != nil {
+		if err = c.bindStructWithRemainingParams(
+			paramsMap, structValue, usedParamsKeyOrTagNameMap, remainingFieldInfo, option,
+		); err != nil && !option.ContinueOnError {
+			return err
 		}
 	}
+	if len(unboundFieldInfos) > 0 {
+		return c.bindStructWithDefaultValues(structValue, cachedStructInfo, unboundFieldInfos, option)
+	}
 	return nil
 }
 
+// bindStructWithFieldSetter calls the custom field setter `setter` with each item of `paramsMap`,
+// and returns the items that are not handled by the setter for the reflection assignment.
+func (c *Converter) bindStructWithFieldSetter(
+	setter localinterface.ISetField, paramsMap map[string]any, option StructOption,
+) (map[string]any, error) {
+	var unhandledParamsMap = make(map[string]any, len(paramsMap))
+	for paramKey, paramValue := range paramsMap {
+		handled, err := setter.SetField(paramKey, paramValue)
+		if err != nil {
+			if !option.ContinueOnError {
+				return nil, gerror.Wrapf(err, `set field "%s" failed`, paramKey)
+			}
+			continue
+		}
+		if !handled {
+			unhandledParamsMap[paramKey] = paramValue
+		}
+	}
+	return unhandledParamsMap, nil
+}
+
+// bindStructWithRemainingParams binds the items of `paramsMap` that are not matched to any attribute
+// to the remaining attribute, which is specified by tag option, eg: `gconv:",remaining"`.
+func (c *Converter) bindStructWithRemainingParams(
+	paramsMap map[string]any,
+	structValue reflect.Value,
+	usedParamsKeyOrTagNameMap map[string]struct{},
+	remainingFieldInfo *structcache.CachedFieldInfo,
+	option StructOption,
+) error {
+	var remainingParams = make(map[string]any)
+	for paramKey, paramValue := range paramsMap {
+		if _, ok := usedParamsKeyOrTagNameMap[paramKey]; ok {
+			continue
+		}
+		remainingParams[paramKey] = paramValue
+	}
+	if len(remainingParams) == 0 {
+		return nil
+	}
+	return c.bindVarToStructField(
+		remainingFieldInfo,
+		remainingFieldInfo.GetFieldReflectValueFrom(structValue),
+		remainingParams,
+		option,
+	)
+}
+
+// bindStructWithPatternParams collects the items of `paramsMap` whose keys match the key patterns of
+// `patternFieldInfos` into maps keyed by the wildcard captures, and binds the maps to the pattern
+// attributes, which are specified by tag option, eg: `gconv:"pattern:title_*"`. The collected keys
+// are marked used, so that they are not captured by the remaining attribute.
+func (c *Converter) bindStructWithPatternParams(
+	paramsMap map[string]any,
+	structValue reflect.Value,
+	usedParamsKeyOrTagNameMap map[string]struct{},
+	patternFieldInfos []*structcache.CachedFieldInfo,
+	option StructOption,
+) (err error) {
+	for _, patternFieldInfo := range patternFieldInfos {
+		var patternParams = make(map[string]any)
+		for paramKey, paramValue := range paramsMap {
+			if capture, ok := patternFieldInfo.MatchKeyPattern(paramKey); ok {
+				patternParams[capture] = paramValue
+				usedParamsKeyOrTagNameMap[paramKey] = struct{}{}
+			}
+		}
+		if len(patternParams) == 0 {
+			continue
+		}
+		if err = c.bindVarToStructField(
+			patternFieldInfo,
+			patternFieldInfo.GetFieldReflectValueFrom(structValue),
+			patternParams,
+			option,
+		); err != nil && !option.ContinueOnError {
+			return err
+		}
+	}
+	return nil
+}
+
+// defaultValueNow is the default value that is resolved to the current time for time attributes,
+// eg: `gconv:"default:now"`.
+const defaultValueNow = "now"
+
+// bindStructWithDefaultValues binds the default values specified by tag option to the fields
+// that are missing in the source. The default value "now" of time attributes is resolved using
+// StructOption.Now.
+// If `fieldInfos` is nil, it binds the default values for all fields of `cachedStructInfo`.
+func (c *Converter) bindStructWithDefaultValues(
+	structValue reflect.Value,
+	cachedStructInfo *structcache.CachedStructInfo,
+	fieldInfos []*structcache.CachedFieldInfo,
+	option StructOption,
+) (err error) {
+	if fieldInfos == nil {
+		fieldInfos = cachedStructInfo.GetFieldConvertInfos()
+	}
+	// The default values are not present in the source.
+	if option.recorders.getPresence() != nil {
+		option.recorders = option.recorders.with(func(recorders *structRecorders) {
+			recorders.presence = nil
+		})
+	}
+	for _, cachedFieldInfo := range fieldInfos {
+		if !cachedFieldInfo.HasDefaultValue || c.isFieldBoundByParamKeyToAttrMap(cachedFieldInfo, option) {
+			continue
+		}
+		var (
+			fieldValue       = cachedFieldInfo.GetFieldReflectValueFrom(structValue)
+			defaultValue any = cachedFieldInfo.DefaultValue
+		)
+		if cachedFieldInfo.DefaultValue == defaultValueNow && isTimeType(fieldValue.Type()) {
+			if option.Now != nil {
+				defaultValue = option.Now()
+			} else {
+				defaultValue = time.Now()
+			}
+		}
+		if err = c.bindVarToStructField(
+			cachedFieldInfo, fieldValue, defaultValue, option,
+		); err != nil && !option.ContinueOnError {
+			return err
+		}
+	}
+	return nil
+}
+
+// isTimeType checks whether `reflectType` is type of time.Time/*time.Time/gtime.Time/*gtime.Time.
+func isTimeType(reflectType reflect.Type) bool {
+	for reflectType.Kind() == reflect.Pointer {
+		reflectType = reflectType.Elem()
+	}
+	return reflectType == reflectTypeTime || reflectType == reflectTypeGTime
+}
+
+// isFieldBoundByParamKeyToAttrMap checks whether the field is the mapping target of custom
+// parameter key to attribute mapping, which is already bound before the loop binding.
+func (c *Converter) isFieldBoundByParamKeyToAttrMap(
+	cachedFieldInfo *structcache.CachedFieldInfo, option StructOption,
+) bool {
+	for _, attrName := range option.ParamKeyToAttrMap {
+		for _, fieldTag := range cachedFieldInfo.PriorityTagAndFieldName {
+			if attrName == fieldTag {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // fuzzy matching rule:
 // to match field name and param key in case-insensitive and without symbols.
 func fuzzyMatchingFieldName(
@@ -365,13 +718,42 @@ func (c *Converter) bindVarToStructField(
 	if !fieldValue.CanSet() {
 		return nil
 	}
+	// The recorders tracking the attribute path are nil in common converting.
+	var recorders = option.recorders
+	if recorders != nil {
+		recorders.push(cachedFieldInfo.FieldName())
+	}
+	// The option is referenced by pointer in the defer, which avoids copying it for each attribute.
+	var optionPointer = &option
 	defer func() {
 		if exception := recover(); exception != nil {
-			if err = c.bindVarToReflectValue(fieldValue, srcValue, option); err != nil {
-				err = gerror.Wrapf(err, `error binding srcValue to attribute "%s"`, cachedFieldInfo.FieldName())
+			err = c.bindVarToReflectValue(fieldValue, srcValue, *optionPointer)
+		}
+		// Maximum length check specified by tag option, eg: `gconv:"maxlen:256"`.
+		if err == nil && cachedFieldInfo.MaxLen > 0 {
+			err = checkMaxLenField(fieldValue, cachedFieldInfo.MaxLen, optionPointer.MaxLenStrict)
+		}
+		if err != nil {
+			err = newFieldConvertError(err, cachedFieldInfo.FieldName(), srcValue)
+		}
+		if recorders != nil {
+			if err == nil && recorders.warning != nil {
+				recorders.warning.check(fieldValue, srcValue)
 			}
+			recorders.pop()
 		}
 	}()
+	return c.bindVarToStructFieldValue(cachedFieldInfo, fieldValue, srcValue, option)
+}
+
+// bindVarToStructFieldValue converts `srcValue` and sets it to attribute `fieldValue`, which is the
+// converting part of bindVarToStructField having few returns for keeping its defer cheap.
+func (c *Converter) bindVarToStructFieldValue(
+	cachedFieldInfo *structcache.CachedFieldInfo,
+	fieldValue reflect.Value,
+	srcValue any,
+	option StructOption,
+) (err error) {
 	// Check if the value should be omitted based on OmitEmpty or OmitNil options
 	if option.OmitNil && empty.IsNil(srcValue) {
 		return nil
@@ -384,12 +766,58 @@ func (c *Converter) bindVarToStructField(
 		fieldValue.Set(reflect.Zero(fieldValue.Type()))
 		return nil
 	}
+	// Named converter specified by tag option, eg: `gconv:"converter:trim"`.
+	if cachedFieldInfo.ConverterName != "" {
+		return c.bindVarToNamedConverterField(cachedFieldInfo.ConverterName, fieldValue, srcValue, option)
+	}
+	// Encoded string source specified by tag option, eg: `gconv:"nested:json"`.
+	if cachedFieldInfo.NestedFormat != "" {
+		if srcValue, err = decodeNestedValue(cachedFieldInfo.NestedFormat, srcValue); err != nil {
+			return err
+		}
+		if srcValue == nil {
+			fieldValue.Set(reflect.Zero(fieldValue.Type()))
+			return nil
+		}
+	}
 	// Try to call custom converter.
 	// Issue: https://github.com/gogf/gf/issues/3099
 	var (
 		customConverterInput reflect.Value
 		ok                   bool
 	)
+	// JSON raw message attribute, which captures the raw sub-document.
+	if cachedFieldInfo.IsBytes {
+		if ok, err = c.bindVarToJsonRawField(fieldValue, srcValue, option.recorders.getJsonRaw()); ok || err != nil {
+			return
+		}
+	}
+	// Special float strings check for float attribute.
+	if option.StrictFloat {
+		if err = c.checkStrictFloatField(fieldValue, srcValue); err != nil {
+			return err
+		}
+	}
+	// Bitflags attribute specified by tag, eg: `gconv:",flags"`.
+	if cachedFieldInfo.IsFlags {
+		if ok, err = c.bindVarToFlagsField(fieldValue, srcValue, option); ok || err != nil {
+			return
+		}
+	}
+	// Wrapper attribute specified by tag, eg: `gconv:",wrapper"`.
+	if cachedFieldInfo.IsWrapper {
+		if _, isWrapperField := structcache.GetWrapperValueField(fieldValue.Type()); isWrapperField {
+			if ok, err = c.bindVarToWrapperField(fieldValue, srcValue, option); ok || err != nil {
+				return
+			}
+		} else if unwrapped, isWrapper := unwrapValue(srcValue); isWrapper {
+			if empty.IsNil(unwrapped) {
+				fieldValue.Set(reflect.Zero(fieldValue.Type()))
+				return nil
+			}
+			srcValue = unwrapped
+		}
+	}
 	if cachedFieldInfo.HasCustomConvert {
 		if customConverterInput, ok = srcValue.(reflect.Value); !ok {
 			customConverterInput = reflect.ValueOf(srcValue)
@@ -403,6 +831,12 @@ func (c *Converter) bindVarToStructField(
 			return
 		}
 	}
+	// Time types with custom location.
+	if option.Location != nil || option.TimeToUTC {
+		if ok, err = c.bindTimeInLocation(fieldValue, srcValue, option.Location, option.TimeToUTC); ok || err != nil {
+			return
+		}
+	}
 	// Common types use fast assignment logic
 	if cachedFieldInfo.ConvertFunc != nil {
 		return cachedFieldInfo.ConvertFunc(srcValue, fieldValue)
@@ -457,6 +891,12 @@ func bindVarToReflectValueWithInterfaceCheck(reflectValue reflect.Value, value a
 			return ok, v.UnmarshalText(valueBytes)
 		}
 	}
+	// UnmarshalBinary, which is only available for binary value.
+	if v, ok := pointer.(localinterface.IUnmarshalBinary); ok {
+		if b, ok := value.([]byte); ok && len(b) > 0 {
+			return ok, v.UnmarshalBinary(b)
+		}
+	}
 	// UnmarshalJSON.
 	if v, ok := pointer.(localinterface.IUnmarshalJSON); ok {
 		var valueBytes []byte
@@ -501,7 +941,7 @@ func (c *Converter) bindVarToReflectValue(structFieldValue reflect.Value, value
 	kind := structFieldValue.Kind()
 	// Converting using `Set` interface implements, for some types.
 	switch kind {
-	case reflect.Slice, reflect.Array, reflect.Pointer, reflect.Interface:
+	case reflect.Slice, reflect.Pointer, reflect.Interface:
 		if !structFieldValue.IsNil() {
 			if v, ok := structFieldValue.Interface().(localinterface.ISet); ok {
 				v.Set(value)
@@ -521,13 +961,29 @@ func (c *Converter) bindVarToReflectValue(structFieldValue reflect.Value, value
 	case reflect.Struct:
 		// Recursively converting for struct attribute.
 		if err = c.Struct(value, structFieldValue, option); err != nil {
+			// It returns the error of nested attribute directly, which carries the attribute path.
+			var fieldErr *FieldConvertError
+			if errors.As(err, &fieldErr) {
+				return err
+			}
 			// Note there's reflect conversion mechanism here.
 			structFieldValue.Set(reflect.ValueOf(value).Convert(structFieldValue.Type()))
 		}
 
+	// Fixed-size array, the source is truncated or zero-filled to the array length.
+	case reflect.Array:
+		return c.doScanForArray(value, structFieldValue, ScanOption{
+			ParamKeyToAttrMap: option.ParamKeyToAttrMap,
+			ContinueOnError:   option.ContinueOnError,
+		})
+
 	// Note that the slice element might be type of struct,
 	// so it uses Struct function doing the converting internally.
-	case reflect.Slice, reflect.Array:
+	case reflect.Slice:
+		// The value might be a wrapper of slice, eg: *gjson.Json, it uses its underlying data.
+		if v, ok := value.(localinterface.IInterface); ok {
+			value = v.Interface()
+		}
 		var (
 			reflectArray  reflect.Value
 			reflectValue  = reflect.ValueOf(value)
@@ -552,6 +1008,10 @@ func (c *Converter) bindVarToReflectValue(structFieldValue reflect.Value, value
 						elemTypeName = elemType.String()
 					}
 					var elem reflect.Value
+					if visited, ok := option.visitedRecorder.load(reflectValue.Index(i).Interface(), elemType); ok {
+						reflectArray.Index(i).Set(visited)
+						continue
+					}
 					if elemType.Kind() == reflect.Pointer {
 						elem = reflect.New(elemType.Elem()).Elem()
 					} else {
@@ -644,6 +1104,11 @@ func (c *Converter) bindVarToReflectValue(structFieldValue reflect.Value, value
 
 	case reflect.Pointer:
 		if structFieldValue.IsNil() || structFieldValue.IsZero() {
+			// The visited source pointer reuses its converted destination pointer.
+			if visited, ok := option.visitedRecorder.load(value, structFieldValue.Type()); ok {
+				structFieldValue.Set(visited)
+				return nil
+			}
 			// Nil or empty pointer, it creates a new one.
 			item := reflect.New(structFieldValue.Type().Elem())
 			if ok, err = bindVarToReflectValueWithInterfaceCheck(item, value); ok {
@@ -0,0 +1,77 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/util/gconv/internal/structcache"
+)
+
+// getPositionalParams retrieves and returns the slice value from `params` for binding by position,
+// like csv row of type []string. It returns false if `params` is not slice or array, or it is []byte.
+func getPositionalParams(params reflect.Value) (reflect.Value, bool) {
+	for params.Kind() == reflect.Pointer || params.Kind() == reflect.Interface {
+		if params.IsNil() {
+			return params, false
+		}
+		params = params.Elem()
+	}
+	switch params.Kind() {
+	case reflect.Slice, reflect.Array:
+		if params.Type().Elem().Kind() == reflect.Uint8 {
+			return params, false
+		}
+		return params, true
+	default:
+		return params, false
+	}
+}
+
+// bindStructWithPositionalParams binds the elements of slice `params` to the attributes of
+// `structValue` by the position index specified by tag option, eg: `gconv:"index:0"`.
+// The attributes without index tag option are skipped, and the attributes whose index is out of
+// the slice length are left untouched except the ones having default value.
+//
+// Each element is converted by its own dynamic type, which consults the registered converters,
+// so the elements of heterogeneous slice like []any{1, "john", time.Now()} can be bound to the
+// attributes of different types. The binding error is wrapped with the position index.
+func (c *Converter) bindStructWithPositionalParams(
+	structValue reflect.Value,
+	cachedStructInfo *structcache.CachedStructInfo,
+	params reflect.Value,
+	option StructOption,
+) (err error) {
+	var unboundFieldInfos = make([]*structcache.CachedFieldInfo, 0)
+	for _, cachedFieldInfo := range cachedStructInfo.GetFieldConvertInfos() {
+		if !cachedFieldInfo.HasIndex {
+			continue
+		}
+		if cachedFieldInfo.Index >= params.Len() {
+			if cachedFieldInfo.HasDefaultValue {
+				unboundFieldInfos = append(unboundFieldInfos, cachedFieldInfo)
+			}
+			continue
+		}
+		if err = c.bindVarToStructField(
+			cachedFieldInfo,
+			cachedFieldInfo.GetFieldReflectValueFrom(structValue),
+			params.Index(cachedFieldInfo.Index).Interface(),
+			option,
+		); err != nil && !option.ContinueOnError {
+			return gerror.WrapCodef(
+				gcode.CodeConversionFailed, err, `bind element at index %d failed`, cachedFieldInfo.Index,
+			)
+		}
+	}
+	if len(unboundFieldInfos) > 0 {
+		return c.bindStructWithDefaultValues(structValue, cachedStructInfo, unboundFieldInfos, option)
+	}
+	return nil
+}
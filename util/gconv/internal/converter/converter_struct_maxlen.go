@@ -0,0 +1,77 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+var (
+	// ErrMaxLenExceeded is the error that indicates the value of string/[]byte attribute exceeds the
+	// maximum length specified by tag option, eg: `gconv:"maxlen:256"`, in strict mode.
+	ErrMaxLenExceeded = gerror.NewWithOption(gerror.Option{
+		Text: "value exceeds maximum length",
+		Code: gcode.CodeInvalidParameter,
+	})
+)
+
+// checkMaxLenField checks the length of the string/[]byte attribute `fieldValue` after assignment,
+// which truncates the value to `maxLen` if it exceeds, or returns error ErrMaxLenExceeded if `strict`
+// is true. The length of string is counted in runes and the length of []byte is counted in bytes.
+// The truncated value is cloned for not retaining the oversized source. The attributes of other types
+// are ignored.
+func checkMaxLenField(fieldValue reflect.Value, maxLen int, strict bool) error {
+	for fieldValue.Kind() == reflect.Pointer {
+		if fieldValue.IsNil() {
+			return nil
+		}
+		fieldValue = fieldValue.Elem()
+	}
+	var length int
+	switch fieldValue.Kind() {
+	case reflect.String:
+		length = utf8.RuneCountInString(fieldValue.String())
+	case reflect.Slice:
+		if fieldValue.Type().Elem().Kind() != reflect.Uint8 {
+			return nil
+		}
+		length = fieldValue.Len()
+	default:
+		return nil
+	}
+	if length <= maxLen {
+		return nil
+	}
+	if strict {
+		return gerror.WrapCodef(
+			gcode.CodeInvalidParameter, ErrMaxLenExceeded,
+			`length %d exceeds maxlen %d`, length, maxLen,
+		)
+	}
+	if fieldValue.Kind() == reflect.String {
+		var (
+			s     = fieldValue.String()
+			count = 0
+		)
+		for i := range s {
+			if count == maxLen {
+				fieldValue.SetString(strings.Clone(s[:i]))
+				break
+			}
+			count++
+		}
+		return nil
+	}
+	fieldValue.SetBytes(bytes.Clone(fieldValue.Bytes()[:maxLen]))
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"net/url"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/json"
+	"github.com/gogf/gf/v2/util/gconv/internal/structcache"
+)
+
+// decodeNestedValue decodes the string source value `srcValue` in encoding `format` specified by
+// tag option, eg: `gconv:"nested:json"`. It returns `srcValue` directly if it is not a string.
+// The returned value is nil if `srcValue` is an empty string.
+func decodeNestedValue(format string, srcValue any) (any, error) {
+	var data []byte
+	switch v := srcValue.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return srcValue, nil
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	switch format {
+	case structcache.NestedFormatJson:
+		var decoded any
+		if err := json.UnmarshalUseNumber(data, &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+
+	case structcache.NestedFormatForm:
+		values, err := url.ParseQuery(string(data))
+		if err != nil {
+			return nil, gerror.WrapCode(gcode.CodeInvalidParameter, err, `url.ParseQuery failed`)
+		}
+		var decoded = make(map[string]any, len(values))
+		for k, v := range values {
+			if len(v) == 1 {
+				decoded[k] = v[0]
+			} else {
+				decoded[k] = v
+			}
+		}
+		return decoded, nil
+
+	default:
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, `unsupported nested format "%s"`, format)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+// structRecorders holds the recorders of struct converting. It is nil in common converting, and is
+// only created if any recorder is needed, so that the common attribute binding does nothing for recording.
+type structRecorders struct {
+	warning  *scanWarningRecorder    // Lossy coercion warnings, which is set by ScanWithWarnings.
+	presence *scanPresenceRecorder   // Attributes having matched source keys, which is set by ScanPresence.
+	jsonRaw  *jsonRawRecorder        // Raw JSON bytes of decoded objects and arrays, which is set by ScanJson.
+	required *structRequiredRecorder // Missing required attributes, which is set by the outermost struct.
+}
+
+// with returns a copy of `r` updated by `update`, as the recorders are shared by the options passed
+// to the nested converting. It returns nil if there's no recorder after updating.
+func (r *structRecorders) with(update func(recorders *structRecorders)) *structRecorders {
+	var recorders structRecorders
+	if r != nil {
+		recorders = *r
+	}
+	update(&recorders)
+	if recorders == (structRecorders{}) {
+		return nil
+	}
+	return &recorders
+}
+
+// getWarning returns the warning recorder, which is nil if `r` is nil.
+func (r *structRecorders) getWarning() *scanWarningRecorder {
+	if r == nil {
+		return nil
+	}
+	return r.warning
+}
+
+// getPresence returns the presence recorder, which is nil if `r` is nil.
+func (r *structRecorders) getPresence() *scanPresenceRecorder {
+	if r == nil {
+		return nil
+	}
+	return r.presence
+}
+
+// getJsonRaw returns the raw JSON recorder, which is nil if `r` is nil.
+func (r *structRecorders) getJsonRaw() *jsonRawRecorder {
+	if r == nil {
+		return nil
+	}
+	return r.jsonRaw
+}
+
+// getRequired returns the required recorder, which is nil if `r` is nil.
+func (r *structRecorders) getRequired() *structRequiredRecorder {
+	if r == nil {
+		return nil
+	}
+	return r.required
+}
+
+// push enters the struct attribute `name` for the recorders tracking attribute path.
+func (r *structRecorders) push(name string) {
+	if r.presence != nil {
+		r.presence.push(name)
+	}
+	if r.required != nil {
+		r.required.push(name)
+	}
+	if r.warning != nil {
+		r.warning.push(name)
+	}
+}
+
+// pop leaves the current struct attribute for the recorders tracking attribute path.
+func (r *structRecorders) pop() {
+	if r.presence != nil {
+		r.presence.pop()
+	}
+	if r.required != nil {
+		r.required.pop()
+	}
+	if r.warning != nil {
+		r.warning.pop()
+	}
+}
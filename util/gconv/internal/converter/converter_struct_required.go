@@ -0,0 +1,121 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/util/gconv/internal/structcache"
+)
+
+// requiredStructTypeMap caches whether the struct type has required attributes recursively,
+// which is reflect.Type => bool.
+var requiredStructTypeMap sync.Map
+
+// structRequiredRecorder records the required struct attributes that are missing in the source.
+type structRequiredRecorder struct {
+	path    []string        // Current path of struct attributes.
+	missing []string        // Paths of the missing required attributes in order.
+	exists  map[string]bool // Paths of the missing required attributes for deduplication.
+}
+
+// push enters the struct attribute `name`.
+func (r *structRequiredRecorder) push(name string) {
+	r.path = append(r.path, name)
+}
+
+// pop leaves the current struct attribute.
+func (r *structRequiredRecorder) pop() {
+	r.path = r.path[:len(r.path)-1]
+}
+
+// record marks the required attribute `name` of current struct missing.
+func (r *structRequiredRecorder) record(name string) {
+	var path = strings.Join(append(r.path[:len(r.path):len(r.path)], name), ".")
+	if r.exists[path] {
+		return
+	}
+	if r.exists == nil {
+		r.exists = make(map[string]bool)
+	}
+	r.exists[path] = true
+	r.missing = append(r.missing, path)
+}
+
+// error returns the error containing all the missing required attributes.
+// It returns nil if there's no missing required attribute.
+func (r *structRequiredRecorder) error() error {
+	if len(r.missing) == 0 {
+		return nil
+	}
+	return gerror.NewCodef(
+		gcode.CodeMissingParameter,
+		`missing required fields: %s`,
+		strings.Join(r.missing, ", "),
+	)
+}
+
+// recordMissingRequiredFields records the required attributes of `fieldInfos` missing in the source.
+func (c *Converter) recordMissingRequiredFields(
+	fieldInfos []*structcache.CachedFieldInfo, option StructOption,
+) {
+	var recorder = option.recorders.getRequired()
+	if recorder == nil {
+		return
+	}
+	for _, fieldInfo := range fieldInfos {
+		if fieldInfo.IsRequired && !c.isFieldBoundByParamKeyToAttrMap(fieldInfo, option) {
+			recorder.record(fieldInfo.FieldName())
+		}
+	}
+}
+
+// isRequiredStructType checks and returns whether struct type `t` or its nested struct attributes
+// have attributes marked by tag option `required`, eg: `gconv:",required"`.
+func isRequiredStructType(t reflect.Type) bool {
+	if v, ok := requiredStructTypeMap.Load(t); ok {
+		return v.(bool)
+	}
+	var isRequired = doCheckRequiredStructType(t, make(map[reflect.Type]struct{}))
+	requiredStructTypeMap.Store(t, isRequired)
+	return isRequired
+}
+
+func doCheckRequiredStructType(t reflect.Type, visited map[reflect.Type]struct{}) bool {
+	for {
+		switch t.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+			t = t.Elem()
+			continue
+		}
+		break
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	if _, ok := visited[t]; ok {
+		return false
+	}
+	visited[t] = struct{}{}
+	for i := 0; i < t.NumField(); i++ {
+		var field = t.Field(i)
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+		if _, ok := structcache.ParseTagOptions(field)[structcache.TagOptionRequired]; ok {
+			return true
+		}
+		if doCheckRequiredStructType(field.Type, visited) {
+			return true
+		}
+	}
+	return false
+}
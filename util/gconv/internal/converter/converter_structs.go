@@ -11,6 +11,7 @@ import (
 
 	"github.com/gogf/gf/v2/errors/gcode"
 	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/util/gconv/internal/localinterface"
 )
 
 // StructsOption is the option for Structs function.
@@ -56,12 +57,40 @@ func (c *Converter) Structs(params any, pointer any, option ...StructsOption) (e
 			)
 		}
 	}
-	// Converting `params` to map slice.
 	var (
-		paramsList    []any
-		paramsRv      = reflect.ValueOf(params)
-		paramsKind    = paramsRv.Kind()
 		structsOption = c.getStructsOption(option...)
+		paramsList    []any
+	)
+	if paramsList, err = c.getStructsParamsList(params, structsOption); err != nil {
+		return err
+	}
+	// If `params` is an empty slice, no conversion.
+	if len(paramsList) == 0 {
+		return nil
+	}
+	var reflectElemArray = reflect.MakeSlice(pointerRv.Type().Elem(), len(paramsList), len(paramsList))
+	for i := 0; i < len(paramsList); i++ {
+		if err = c.bindStructsElement(
+			paramsList[i], i, pointerRv.Elem(), reflectElemArray, structsOption.StructOption,
+		); err != nil {
+			return err
+		}
+	}
+	pointerRv.Elem().Set(reflectElemArray)
+	return nil
+}
+
+// getStructsParamsList converts `params` to the element list for Structs converting.
+func (c *Converter) getStructsParamsList(params any, option StructsOption) ([]any, error) {
+	// The `params` might be a wrapper of slice that implements interface function Interface,
+	// eg: *gjson.Json, it uses its underlying data directly.
+	if v, ok := params.(localinterface.IInterface); ok {
+		params = v.Interface()
+	}
+	var (
+		paramsList []any
+		paramsRv   = reflect.ValueOf(params)
+		paramsKind = paramsRv.Kind()
 	)
 	for paramsKind == reflect.Pointer {
 		paramsRv = paramsRv.Elem()
@@ -75,61 +104,55 @@ func (c *Converter) Structs(params any, pointer any, option ...StructsOption) (e
 		}
 	default:
 		paramsMaps, err := c.SliceMap(params, SliceMapOption{
-			SliceOption: structsOption.SliceOption,
+			SliceOption: option.SliceOption,
 			MapOption: MapOption{
-				ContinueOnError: structsOption.StructOption.ContinueOnError,
+				ContinueOnError: option.StructOption.ContinueOnError,
 			},
 		})
 		if err != nil {
-			return err
+			return nil, err
 		}
 		paramsList = make([]any, len(paramsMaps))
 		for i := 0; i < len(paramsMaps); i++ {
 			paramsList[i] = paramsMaps[i]
 		}
 	}
-	// If `params` is an empty slice, no conversion.
-	if len(paramsList) == 0 {
-		return nil
-	}
+	return paramsList, nil
+}
+
+// bindStructsElement converts `param` to the element at `index` of `reflectElemArray`,
+// reusing the existing element at the same index of `pointerRvElem` if any.
+func (c *Converter) bindStructsElement(
+	param any, index int, pointerRvElem, reflectElemArray reflect.Value, option StructOption,
+) error {
 	var (
-		reflectElemArray = reflect.MakeSlice(pointerRv.Type().Elem(), len(paramsList), len(paramsList))
-		itemType         = reflectElemArray.Index(0).Type()
-		itemTypeKind     = itemType.Kind()
-		pointerRvElem    = pointerRv.Elem()
-		pointerRvLength  = pointerRvElem.Len()
+		itemType         = reflectElemArray.Type().Elem()
+		tempReflectValue reflect.Value
 	)
-	if itemTypeKind == reflect.Pointer {
+	if itemType.Kind() == reflect.Pointer {
 		// Pointer element.
-		for i := 0; i < len(paramsList); i++ {
-			var tempReflectValue reflect.Value
-			if i < pointerRvLength {
-				// Might be nil.
-				tempReflectValue = pointerRvElem.Index(i).Elem()
-			}
-			if !tempReflectValue.IsValid() {
-				tempReflectValue = reflect.New(itemType.Elem()).Elem()
-			}
-			if err = c.Struct(paramsList[i], tempReflectValue, structsOption.StructOption); err != nil {
-				return err
-			}
-			reflectElemArray.Index(i).Set(tempReflectValue.Addr())
+		if index < pointerRvElem.Len() {
+			// Might be nil.
+			tempReflectValue = pointerRvElem.Index(index).Elem()
 		}
-	} else {
-		// Struct element.
-		for i := 0; i < len(paramsList); i++ {
-			var tempReflectValue reflect.Value
-			if i < pointerRvLength {
-				tempReflectValue = pointerRvElem.Index(i)
-			} else {
-				tempReflectValue = reflect.New(itemType).Elem()
-			}
-			if err = c.Struct(paramsList[i], tempReflectValue, structsOption.StructOption); err != nil {
-				return err
-			}
-			reflectElemArray.Index(i).Set(tempReflectValue)
+		if !tempReflectValue.IsValid() {
+			tempReflectValue = reflect.New(itemType.Elem()).Elem()
 		}
+		if err := c.Struct(param, tempReflectValue, option); err != nil {
+			return err
+		}
+		reflectElemArray.Index(index).Set(tempReflectValue.Addr())
+		return nil
 	}
-	pointerRv.Elem().Set(reflectElemArray)
+	// Struct element.
+	if index < pointerRvElem.Len() {
+		tempReflectValue = pointerRvElem.Index(index)
+	} else {
+		tempReflectValue = reflect.New(itemType).Elem()
+	}
+	if err := c.Struct(param, tempReflectValue, option); err != nil {
+		return err
+	}
+	reflectElemArray.Index(index).Set(tempReflectValue)
 	return nil
 }
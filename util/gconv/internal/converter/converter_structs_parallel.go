@@ -0,0 +1,120 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+	"runtime"
+	"sync"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// StructsParallel does the same as Structs, but it splits the elements of `params` across `workers`
+// goroutines for converting, which is usually used for converting huge slice. The order of elements
+// is preserved in the result slice. It uses runtime.GOMAXPROCS(0) workers if `workers` <= 0.
+//
+// Unlike Structs returning the first error, it converts all the elements, and returns an
+// *ElementConvertError carrying the errors of all the failed elements with their indexes.
+// The result slice is not assigned to `pointer` if any element fails converting.
+func (c *Converter) StructsParallel(params any, pointer any, workers int, option ...StructsOption) (err error) {
+	defer func() {
+		// Catch the panic, especially the reflection operation panics.
+		if exception := recover(); exception != nil {
+			if v, ok := exception.(error); ok && gerror.HasStack(v) {
+				err = v
+			} else {
+				err = gerror.NewCodeSkipf(gcode.CodeInternalPanic, 1, "%+v", exception)
+			}
+		}
+	}()
+
+	// Pointer type check.
+	pointerRv, ok := pointer.(reflect.Value)
+	if !ok {
+		pointerRv = reflect.ValueOf(pointer)
+		if kind := pointerRv.Kind(); kind != reflect.Pointer {
+			return gerror.NewCodef(
+				gcode.CodeInvalidParameter,
+				"pointer should be type of pointer, but got: %v", kind,
+			)
+		}
+	}
+	var (
+		structsOption = c.getStructsOption(option...)
+		paramsList    []any
+	)
+	if paramsList, err = c.getStructsParamsList(params, structsOption); err != nil {
+		return err
+	}
+	// If `params` is an empty slice, no conversion.
+	if len(paramsList) == 0 {
+		return nil
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(paramsList) {
+		workers = len(paramsList)
+	}
+	var (
+		wg               sync.WaitGroup
+		errs             = make([]error, len(paramsList))
+		chunkSize        = (len(paramsList) + workers - 1) / workers
+		pointerRvElem    = pointerRv.Elem()
+		reflectElemArray = reflect.MakeSlice(pointerRv.Type().Elem(), len(paramsList), len(paramsList))
+	)
+	// Each worker converts a contiguous chunk of elements, and the elements are set to their own indexes
+	// of the result slice, so there's no conflict between workers.
+	for start := 0; start < len(paramsList); start += chunkSize {
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			for i := start; i < end; i++ {
+				errs[i] = c.bindStructsElementWithRecover(
+					paramsList[i], i, pointerRvElem, reflectElemArray, structsOption.StructOption,
+				)
+			}
+		}(start, min(start+chunkSize, len(paramsList)))
+	}
+	wg.Wait()
+
+	var elementErr *ElementConvertError
+	for i, e := range errs {
+		if e == nil {
+			continue
+		}
+		if elementErr == nil {
+			elementErr = &ElementConvertError{}
+		}
+		elementErr.Indexes = append(elementErr.Indexes, i)
+		elementErr.Errors = append(elementErr.Errors, e)
+	}
+	if elementErr != nil {
+		return elementErr
+	}
+	pointerRv.Elem().Set(reflectElemArray)
+	return nil
+}
+
+// bindStructsElementWithRecover does bindStructsElement, and it also recovers the panic of converting
+// as error, as the panic in goroutine cannot be recovered by the caller.
+func (c *Converter) bindStructsElementWithRecover(
+	param any, index int, pointerRvElem, reflectElemArray reflect.Value, option StructOption,
+) (err error) {
+	defer func() {
+		if exception := recover(); exception != nil {
+			if v, ok := exception.(error); ok && gerror.HasStack(v) {
+				err = v
+			} else {
+				err = gerror.NewCodef(gcode.CodeInternalPanic, "%+v", exception)
+			}
+		}
+	}()
+	return c.bindStructsElement(param, index, pointerRvElem, reflectElemArray, option)
+}
@@ -7,6 +7,7 @@
 package converter
 
 import (
+	"reflect"
 	"time"
 
 	"github.com/gogf/gf/v2/internal/empty"
@@ -34,7 +35,8 @@ func (c *Converter) Time(anyInput any, format ...string) (time.Time, error) {
 }
 
 // Duration converts `any` to time.Duration.
-// If `any` is string, then it uses time.ParseDuration to convert it.
+// If `any` is string, then it uses gtime.ParseDuration to convert it, and falls back to ParseDuration
+// for the human-readable forms like "2 days" that gtime.ParseDuration rejects.
 // If `any` is numeric, then it converts `any` as nanoseconds.
 func (c *Converter) Duration(anyInput any) (time.Duration, error) {
 	// It's already this type.
@@ -46,7 +48,10 @@ func (c *Converter) Duration(anyInput any) (time.Duration, error) {
 		return 0, err
 	}
 	if !utils.IsNumeric(s) {
-		return gtime.ParseDuration(s)
+		if d, err := gtime.ParseDuration(s); err == nil {
+			return d, nil
+		}
+		return ParseDuration(s)
 	}
 	i, err := c.Int64(anyInput)
 	if err != nil {
@@ -79,6 +84,9 @@ func (c *Converter) GTime(anyInput any, format ...string) (*gtime.Time, error) {
 			return gtime.New(t), nil
 		}
 	}
+	if t, ok := c.protoTimestampToTime(anyInput); ok {
+		return gtime.New(t), nil
+	}
 	s, err := c.String(anyInput)
 	if err != nil {
 		return nil, err
@@ -109,3 +117,97 @@ func (c *Converter) GTime(anyInput any, format ...string) (*gtime.Time, error) {
 		return gtime.StrToTime(s)
 	}
 }
+
+var (
+	reflectTypeTime  = reflect.TypeOf(time.Time{})
+	reflectTypeGTime = reflect.TypeOf(gtime.Time{})
+)
+
+// bindTimeInLocation converts `value` to time in location `loc` and binds it to `reflectValue`
+// if `reflectValue` is type of time.Time/*time.Time/gtime.Time/*gtime.Time. It converts the time to
+// UTC if `toUTC` is true. It returns false if `reflectValue` is not a time type.
+func (c *Converter) bindTimeInLocation(
+	reflectValue reflect.Value, value any, loc *time.Location, toUTC bool,
+) (ok bool, err error) {
+	var (
+		reflectType = reflectValue.Type()
+		isPointer   = reflectType.Kind() == reflect.Pointer
+	)
+	if isPointer {
+		reflectType = reflectType.Elem()
+	}
+	if reflectType != reflectTypeTime && reflectType != reflectTypeGTime {
+		return false, nil
+	}
+	t, err := c.timeInLocation(value, loc, toUTC)
+	if err != nil {
+		return true, err
+	}
+	var timeValue reflect.Value
+	if reflectType == reflectTypeTime {
+		timeValue = reflect.ValueOf(t)
+	} else {
+		timeValue = reflect.ValueOf(*gtime.New(t))
+	}
+	if isPointer {
+		ptr := reflect.New(reflectType)
+		ptr.Elem().Set(timeValue)
+		reflectValue.Set(ptr)
+	} else {
+		reflectValue.Set(timeValue)
+	}
+	return true, nil
+}
+
+// timeInLocation converts `value` to time.Time, the string of which without zone information
+// is interpreted in location `loc`, and the result is converted to location `loc`, or to UTC
+// if `toUTC` is true. The zero time is returned as it is.
+func (c *Converter) timeInLocation(value any, loc *time.Location, toUTC bool) (time.Time, error) {
+	if v, ok := value.(reflect.Value); ok {
+		if !v.IsValid() {
+			return time.Time{}, nil
+		}
+		value = v.Interface()
+	}
+	var t time.Time
+	switch v := value.(type) {
+	case time.Time:
+		t = v
+	case *time.Time:
+		if v != nil {
+			t = *v
+		}
+	case gtime.Time:
+		t = v.Time
+	case *gtime.Time:
+		if v != nil {
+			t = v.Time
+		}
+	default:
+		if empty.IsNil(value) {
+			return time.Time{}, nil
+		}
+		s, err := c.String(value)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if s == "" {
+			return time.Time{}, nil
+		}
+		gt, err := gtime.StrToTimeInLocation(s, loc)
+		if err != nil {
+			return time.Time{}, err
+		}
+		t = gt.Time
+	}
+	if t.IsZero() {
+		return t, nil
+	}
+	if loc != nil {
+		t = t.In(loc)
+	}
+	if toUTC {
+		t = t.UTC()
+	}
+	return t, nil
+}
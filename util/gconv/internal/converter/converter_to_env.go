@@ -0,0 +1,233 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/utils"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/util/gconv/internal/structcache"
+	"github.com/gogf/gf/v2/util/gtag"
+)
+
+// EnvSliceStyle is the style for converting slice attribute to environment variables.
+type EnvSliceStyle string
+
+const (
+	EnvSliceStyleJoin  EnvSliceStyle = ""      // Joins the elements to one variable, eg: APP_HOSTS=a,b.
+	EnvSliceStyleIndex EnvSliceStyle = "index" // One variable for each element, eg: APP_HOSTS_0=a.
+)
+
+// ToEnvOption is the option for the ToEnv function.
+type ToEnvOption struct {
+	// Separator specifies the separator for nested path, which is "_" in default.
+	Separator string
+
+	// SliceStyle specifies the style for converting slice attributes, which is EnvSliceStyleJoin in default.
+	SliceStyle EnvSliceStyle
+
+	// SliceSeparator specifies the separator for joining slice elements in EnvSliceStyleJoin,
+	// which is "," in default.
+	SliceSeparator string
+}
+
+// ToEnv converts struct `value` to environment-style map like `APP_SERVER_PORT=8000`, which is the
+// reverse of ScanEnv. The key is the attribute path joined by separator, prefixed with `prefix` if it
+// is not empty, and each path segment is the attribute name or tag name in upper snake case,
+// eg: `Server.MaxBodySize` is converted to key `APP_SERVER_MAX_BODY_SIZE`.
+//
+// The nested struct attributes are converted recursively, and the attributes of embedded struct are
+// flattened. The nil attributes are ignored, including nil slices and maps. The time values are
+// formatted in RFC3339 with nanoseconds, and the others are converted using String, so the map values
+// are formatted as JSON. The slice elements are joined
+// using separator, or converted as indexed keys like `APP_HOSTS_0`, see ToEnvOption.
+//
+// The slices of struct/map/slice elements are formatted as JSON in EnvSliceStyleJoin.
+func (c *Converter) ToEnv(value any, prefix string, option ...ToEnvOption) (map[string]string, error) {
+	var usedOption ToEnvOption
+	if len(option) > 0 {
+		usedOption = option[0]
+	}
+	if usedOption.Separator == "" {
+		usedOption.Separator = "_"
+	}
+	if usedOption.SliceSeparator == "" {
+		usedOption.SliceSeparator = ","
+	}
+	var reflectValue reflect.Value
+	if v, ok := value.(reflect.Value); ok {
+		reflectValue = v
+	} else {
+		reflectValue = reflect.ValueOf(value)
+	}
+	for reflectValue.Kind() == reflect.Pointer || reflectValue.Kind() == reflect.Interface {
+		if reflectValue.IsNil() {
+			return nil, gerror.NewCode(gcode.CodeInvalidParameter, `the struct value cannot be nil`)
+		}
+		reflectValue = reflectValue.Elem()
+	}
+	if reflectValue.Kind() != reflect.Struct {
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`the value should be type of struct/*struct, but got: %v`,
+			reflectValue.Kind(),
+		)
+	}
+	if prefix != "" && !strings.HasSuffix(prefix, usedOption.Separator) {
+		prefix += usedOption.Separator
+	}
+	var envMap = make(map[string]string)
+	if err := c.doToEnvForStruct(reflectValue, prefix, envMap, usedOption); err != nil {
+		return nil, err
+	}
+	return envMap, nil
+}
+
+// doToEnvForStruct converts the attributes of struct `structValue` to `envMap` with key prefix `prefix`.
+func (c *Converter) doToEnvForStruct(
+	structValue reflect.Value, prefix string, envMap map[string]string, option ToEnvOption,
+) error {
+	var structType = structValue.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		var (
+			field      = structType.Field(i)
+			fieldValue = structValue.Field(i)
+			name, ok   = getEnvFieldName(field)
+		)
+		if !ok {
+			continue
+		}
+		if field.Anonymous && name == "" {
+			for fieldValue.Kind() == reflect.Pointer {
+				if fieldValue.IsNil() {
+					break
+				}
+				fieldValue = fieldValue.Elem()
+			}
+			if fieldValue.Kind() == reflect.Struct && !isTimeType(fieldValue.Type()) {
+				if err := c.doToEnvForStruct(fieldValue, prefix, envMap, option); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if name == "" {
+			name = field.Name
+		}
+		key := prefix + strings.ToUpper(formatMapKey(name, MapKeyStyleSnake))
+		if err := c.doToEnvForValue(fieldValue, key, envMap, option); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// doToEnvForValue converts `value` to `envMap` with key `key`.
+func (c *Converter) doToEnvForValue(
+	value reflect.Value, key string, envMap map[string]string, option ToEnvOption,
+) (err error) {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.Invalid:
+		return nil
+
+	case reflect.Struct:
+		switch value.Type() {
+		case reflectTypeTime:
+			envMap[key] = value.Interface().(time.Time).Format(time.RFC3339Nano)
+			return nil
+		case reflectTypeGTime:
+			envMap[key] = value.Interface().(gtime.Time).Time.Format(time.RFC3339Nano)
+			return nil
+		}
+		return c.doToEnvForStruct(value, key+option.Separator, envMap, option)
+
+	case reflect.Map:
+		if value.IsNil() {
+			return nil
+		}
+
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			return nil
+		}
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		if option.SliceStyle == EnvSliceStyleIndex {
+			for i := 0; i < value.Len(); i++ {
+				if err = c.doToEnvForValue(
+					value.Index(i), key+option.Separator+strconv.Itoa(i), envMap, option,
+				); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		if !isEnvScalarType(value.Type().Elem()) {
+			break
+		}
+		var items = make([]string, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			if items[i], err = c.String(value.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		envMap[key] = strings.Join(items, option.SliceSeparator)
+		return nil
+	}
+	if envMap[key], err = c.String(value.Interface()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// getEnvFieldName retrieves and returns the tag name of attribute `field`, which is empty if there's
+// no tag name. It returns false if the attribute is unexported or ignored by tag "-".
+func getEnvFieldName(field reflect.StructField) (string, bool) {
+	if !utils.IsLetterUpper(field.Name[0]) {
+		return "", false
+	}
+	for _, tag := range gtag.StructTagPriority {
+		tagValue := strings.TrimSpace(strings.Split(structcache.TrimTagOptions(tag, field.Tag.Get(tag)), ",")[0])
+		switch tagValue {
+		case "":
+			continue
+		case "-":
+			return "", false
+		default:
+			return tagValue, true
+		}
+	}
+	return "", true
+}
+
+// isEnvScalarType checks whether `elemType` is the scalar type that can be joined in EnvSliceStyleJoin.
+func isEnvScalarType(elemType reflect.Type) bool {
+	for elemType.Kind() == reflect.Pointer {
+		elemType = elemType.Elem()
+	}
+	switch elemType.Kind() {
+	case reflect.Struct:
+		return isTimeType(elemType)
+	case reflect.Map, reflect.Slice, reflect.Array, reflect.Interface:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+)
+
+// visitedKey is the key of visited source pointer, which is the pointer address along with
+// its type, as the pointers to a struct and to its first attribute have the same address.
+type visitedKey struct {
+	pointer uintptr
+	typ     reflect.Type
+}
+
+// visitedStructKey is the key of visited source pointer converted to destination struct type.
+type visitedStructKey struct {
+	visitedKey
+	dstType reflect.Type
+}
+
+// getVisitedKey returns the visited key of `reflectValue`, which is valid only for the non-nil
+// pointer to struct and the non-nil map, as only they can form reference cycles in converting.
+func getVisitedKey(reflectValue reflect.Value) (key visitedKey, ok bool) {
+	switch reflectValue.Kind() {
+	case reflect.Pointer:
+		if reflectValue.IsNil() || reflectValue.Type().Elem().Kind() != reflect.Struct {
+			return key, false
+		}
+	case reflect.Map:
+		if reflectValue.IsNil() {
+			return key, false
+		}
+	default:
+		return key, false
+	}
+	return visitedKey{pointer: reflectValue.Pointer(), typ: reflectValue.Type()}, true
+}
+
+// mapVisitedRecorder records the converted maps of the visited source pointers in deep map converting.
+// The source pointer visited again is converted to the same map, which breaks the reference cycles and
+// preserves the shared references of the source.
+type mapVisitedRecorder struct {
+	maps map[visitedKey]map[string]any
+}
+
+// newMapVisitedRecorder creates and returns a new mapVisitedRecorder.
+func newMapVisitedRecorder() *mapVisitedRecorder {
+	return &mapVisitedRecorder{
+		maps: make(map[visitedKey]map[string]any),
+	}
+}
+
+// load returns the converted map of source `reflectValue` if it is visited.
+func (r *mapVisitedRecorder) load(reflectValue reflect.Value) (map[string]any, bool) {
+	if r == nil {
+		return nil, false
+	}
+	key, ok := getVisitedKey(reflectValue)
+	if !ok {
+		return nil, false
+	}
+	dataMap, ok := r.maps[key]
+	return dataMap, ok
+}
+
+// store records `dataMap` as the converted map of source `reflectValue`.
+func (r *mapVisitedRecorder) store(reflectValue reflect.Value, dataMap map[string]any) {
+	if r == nil {
+		return
+	}
+	if key, ok := getVisitedKey(reflectValue); ok {
+		r.maps[key] = dataMap
+	}
+}
+
+// structVisitedRecorder records the destination struct pointers of the visited source pointers in struct
+// converting. The source pointer visited again is bound to the same destination pointer of the same type,
+// which breaks the reference cycles and preserves the shared references of the source.
+type structVisitedRecorder struct {
+	pointers map[visitedStructKey]reflect.Value
+}
+
+// newStructVisitedRecorderFor creates and returns a structVisitedRecorder if source `params` is pointer
+// to struct, or else it returns nil, as the other sources have no pointer to be visited again.
+func newStructVisitedRecorderFor(params reflect.Value) *structVisitedRecorder {
+	if params.Kind() != reflect.Pointer {
+		return nil
+	}
+	if _, ok := getVisitedKey(params); !ok {
+		return nil
+	}
+	return &structVisitedRecorder{
+		pointers: make(map[visitedStructKey]reflect.Value),
+	}
+}
+
+// load returns the destination pointer of source `value` for destination pointer type `dstType`
+// if it is visited.
+func (r *structVisitedRecorder) load(value any, dstType reflect.Type) (reflect.Value, bool) {
+	if r == nil || value == nil || dstType.Kind() != reflect.Pointer {
+		return reflect.Value{}, false
+	}
+	reflectValue, ok := value.(reflect.Value)
+	if !ok {
+		reflectValue = reflect.ValueOf(value)
+	}
+	key, ok := getVisitedKey(reflectValue)
+	if !ok {
+		return reflect.Value{}, false
+	}
+	pointer, ok := r.pointers[visitedStructKey{visitedKey: key, dstType: dstType.Elem()}]
+	return pointer, ok
+}
+
+// store records addressable struct `dst` as the destination of source `params`.
+func (r *structVisitedRecorder) store(params reflect.Value, dst reflect.Value) {
+	if r == nil || !dst.CanAddr() {
+		return
+	}
+	if key, ok := getVisitedKey(params); ok && params.Kind() == reflect.Pointer {
+		r.pointers[visitedStructKey{visitedKey: key, dstType: dst.Type()}] = dst.Addr()
+	}
+}
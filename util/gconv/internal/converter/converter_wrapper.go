@@ -0,0 +1,81 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package converter
+
+import (
+	"reflect"
+
+	"github.com/gogf/gf/v2/util/gconv/internal/structcache"
+)
+
+// bindVarToWrapperField wraps the scalar `srcValue` into the wrapper attribute `fieldValue`, which is
+// a struct having single exported field like `wrapperspb.StringValue`.
+// It returns false if `fieldValue` is not wrapper type or `srcValue` is not scalar value.
+func (c *Converter) bindVarToWrapperField(
+	fieldValue reflect.Value, srcValue any, option StructOption,
+) (ok bool, err error) {
+	valueField, ok := structcache.GetWrapperValueField(fieldValue.Type())
+	if !ok || !isWrappableValue(srcValue) {
+		return false, nil
+	}
+	for fieldValue.Kind() == reflect.Pointer {
+		if fieldValue.IsNil() {
+			fieldValue.Set(reflect.New(fieldValue.Type().Elem()))
+		}
+		fieldValue = fieldValue.Elem()
+	}
+	return true, c.bindVarToReflectValue(fieldValue.FieldByIndex(valueField.Index), srcValue, option)
+}
+
+// unwrapValue retrieves the value of the single exported field from wrapper value `value`.
+// It returns nil if `value` is nil pointer of wrapper type, and returns false if `value`
+// is not wrapper type.
+func unwrapValue(value any) (unwrapped any, ok bool) {
+	var reflectValue reflect.Value
+	if v, isReflectValue := value.(reflect.Value); isReflectValue {
+		reflectValue = v
+	} else {
+		reflectValue = reflect.ValueOf(value)
+	}
+	if !reflectValue.IsValid() {
+		return nil, false
+	}
+	valueField, ok := structcache.GetWrapperValueField(reflectValue.Type())
+	if !ok {
+		return nil, false
+	}
+	for reflectValue.Kind() == reflect.Pointer {
+		if reflectValue.IsNil() {
+			return nil, true
+		}
+		reflectValue = reflectValue.Elem()
+	}
+	return reflectValue.FieldByIndex(valueField.Index).Interface(), true
+}
+
+// isWrappableValue checks whether `value` can be wrapped into wrapper type,
+// which is not type of map or struct.
+func isWrappableValue(value any) bool {
+	var reflectValue reflect.Value
+	if v, ok := value.(reflect.Value); ok {
+		reflectValue = v
+	} else {
+		reflectValue = reflect.ValueOf(value)
+	}
+	for reflectValue.Kind() == reflect.Pointer || reflectValue.Kind() == reflect.Interface {
+		if reflectValue.IsNil() {
+			return false
+		}
+		reflectValue = reflectValue.Elem()
+	}
+	switch reflectValue.Kind() {
+	case reflect.Invalid, reflect.Map, reflect.Struct:
+		return false
+	default:
+		return true
+	}
+}
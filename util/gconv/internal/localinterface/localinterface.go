@@ -101,12 +101,25 @@ type IUnmarshalJSON interface {
 	UnmarshalJSON(b []byte) error
 }
 
+// IUnmarshalBinary is the interface for custom defined types customizing value assignment.
+// Note that only pointer can implement interface IUnmarshalBinary.
+type IUnmarshalBinary interface {
+	UnmarshalBinary(data []byte) error
+}
+
 // IUnmarshalValue is the interface for custom defined types customizing value assignment.
 // Note that only pointer can implement interface IUnmarshalValue.
 type IUnmarshalValue interface {
 	UnmarshalValue(any) error
 }
 
+// ISetField is the interface for struct customizing attribute assignment, which is usually
+// implemented by struct having unexported attributes and setter methods.
+// Note that only pointer can implement interface ISetField.
+type ISetField interface {
+	SetField(name string, value any) (handled bool, err error)
+}
+
 // ISet is the interface for custom value assignment.
 type ISet interface {
 	Set(value any) (old any)
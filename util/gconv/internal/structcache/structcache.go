@@ -59,6 +59,14 @@ func (cf *Converter) MarkTypeConvertFunc(fieldType reflect.Type) {
 	cf.typeConverterFuncMarkMap[fieldType] = struct{}{}
 }
 
+// UnmarkTypeConvertFunc removes the mark of converting function registered for custom type.
+func (cf *Converter) UnmarkTypeConvertFunc(fieldType reflect.Type) {
+	if fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	delete(cf.typeConverterFuncMarkMap, fieldType)
+}
+
 // RegisterAnyConvertFunc registers custom type converting function for specified type.
 func (cf *Converter) RegisterAnyConvertFunc(dstType reflect.Type, convertFunc AnyConvertFunc) {
 	if dstType == nil || convertFunc == nil {
@@ -82,6 +90,19 @@ func (cf *Converter) RegisterAnyConvertFunc(dstType reflect.Type, convertFunc An
 	)
 }
 
+// UnregisterAnyConvertFunc removes the converting function registered for specified type.
+// It returns true if the converting function is found and removed.
+func (cf *Converter) UnregisterAnyConvertFunc(dstType reflect.Type) bool {
+	for dstType.Kind() == reflect.Pointer {
+		dstType = dstType.Elem()
+	}
+	if _, ok := cf.anyToTypeConvertMap[dstType]; !ok {
+		return false
+	}
+	delete(cf.anyToTypeConvertMap, dstType)
+	return true
+}
+
 // GetAnyConvertFuncByType retrieves and returns the converting function for specified type.
 func (cf *Converter) GetAnyConvertFuncByType(dstType reflect.Type) AnyConvertFunc {
 	if dstType.Kind() == reflect.Pointer {
@@ -108,33 +129,61 @@ func (cf *Converter) checkTypeImplInterface(t reflect.Type) AnyConvertFunc {
 }
 
 var (
-	implUnmarshalText  = reflect.TypeOf((*localinterface.IUnmarshalText)(nil)).Elem()
-	implUnmarshalJSON  = reflect.TypeOf((*localinterface.IUnmarshalJSON)(nil)).Elem()
-	implUnmarshalValue = reflect.TypeOf((*localinterface.IUnmarshalValue)(nil)).Elem()
+	implUnmarshalText   = reflect.TypeOf((*localinterface.IUnmarshalText)(nil)).Elem()
+	implUnmarshalBinary = reflect.TypeOf((*localinterface.IUnmarshalBinary)(nil)).Elem()
+	implUnmarshalJSON   = reflect.TypeOf((*localinterface.IUnmarshalJSON)(nil)).Elem()
+	implUnmarshalValue  = reflect.TypeOf((*localinterface.IUnmarshalValue)(nil)).Elem()
+
+	// commonInterfaceTypeMap caches the checking result of IsCommonInterfaceType.
+	// map[reflect.Type]bool
+	commonInterfaceTypeMap sync.Map
 )
 
 func checkTypeIsCommonInterface(field reflect.StructField) bool {
+	return IsCommonInterfaceType(field.Type)
+}
+
+// IsCommonInterfaceType checks and returns whether type `t` or its pointer implements any of the common
+// unmarshalling interfaces: UnmarshalValue, UnmarshalText, UnmarshalBinary and UnmarshalJSON.
+// The time and big number types are excluded, as they are converted using default converting logic.
+// The checking result is cached by type for performance.
+func IsCommonInterfaceType(t reflect.Type) bool {
+	if v, ok := commonInterfaceTypeMap.Load(t); ok {
+		return v.(bool)
+	}
+	isCommonInterface := doCheckTypeIsCommonInterface(t)
+	commonInterfaceTypeMap.Store(t, isCommonInterface)
+	return isCommonInterface
+}
+
+func doCheckTypeIsCommonInterface(t reflect.Type) bool {
 	isCommonInterface := false
-	switch field.Type.String() {
+	switch t.String() {
 	case "time.Time", "*time.Time":
 		// default convert.
 
 	case "gtime.Time", "*gtime.Time":
 		// default convert.
 
+	case "big.Int", "*big.Int", "big.Float", "*big.Float":
+		// default convert.
+
 	default:
-		// Implemented three types of interfaces that must be pointer types, otherwise it is meaningless
-		if field.Type.Kind() != reflect.Pointer {
-			field.Type = reflect.PointerTo(field.Type)
+		// Implemented these interfaces that must be pointer types, otherwise it is meaningless
+		if t.Kind() != reflect.Pointer {
+			t = reflect.PointerTo(t)
 		}
 		switch {
-		case field.Type.Implements(implUnmarshalText):
+		case t.Implements(implUnmarshalText):
+			isCommonInterface = true
+
+		case t.Implements(implUnmarshalBinary):
 			isCommonInterface = true
 
-		case field.Type.Implements(implUnmarshalJSON):
+		case t.Implements(implUnmarshalJSON):
 			isCommonInterface = true
 
-		case field.Type.Implements(implUnmarshalValue):
+		case t.Implements(implUnmarshalValue):
 			isCommonInterface = true
 		}
 	}
@@ -8,6 +8,7 @@ package structcache
 
 import (
 	"reflect"
+	"strings"
 	"sync/atomic"
 )
 
@@ -75,6 +76,52 @@ type CachedFieldInfoBase struct {
 	// even if their types are different and their indexes are different
 	OtherSameNameField []*CachedFieldInfo
 
+	// HasDefaultValue marks whether this field has default value specified by tag option,
+	// eg: `gconv:"default:10"`.
+	HasDefaultValue bool
+
+	// DefaultValue is the default value string specified by tag option, which is converted
+	// to the field type and used if the field is missing in the source.
+	DefaultValue string
+
+	// IsBytes marks whether this field is type of []byte or pointer to it, like json.RawMessage.
+	IsBytes bool
+
+	// IsWrapper marks whether this field is specified as wrapper by tag option,
+	// eg: `gconv:",wrapper"`.
+	IsWrapper bool
+
+	// HasIndex marks whether this field has position index specified by tag option,
+	// eg: `gconv:"index:0"`.
+	HasIndex bool
+
+	// Index is the position of this field in slice source specified by tag option.
+	Index int
+
+	// NestedFormat is the encoding format of string source value specified by tag option,
+	// eg: `gconv:"nested:json"`, which is decoded before binding to this field.
+	NestedFormat string
+
+	// IsFlags marks whether this field is specified as bitflags by tag option,
+	// eg: `gconv:",flags"`.
+	IsFlags bool
+
+	// IsRequired marks whether this field is specified as required by tag option,
+	// eg: `gconv:",required"`.
+	IsRequired bool
+
+	// ConverterName is the name of registered converter function specified by tag option,
+	// eg: `gconv:"converter:trim"`, which is used for converting instead of the type matching.
+	ConverterName string
+
+	// KeyPattern is the source key pattern having one wildcard char '*' specified by tag option,
+	// eg: `gconv:"pattern:title_*"`, which is set only for the map field collecting matched keys.
+	KeyPattern string
+
+	// MaxLen is the maximum length of the string/[]byte field specified by tag option,
+	// eg: `gconv:"maxlen:256"`, which is not limited if it is zero.
+	MaxLen int
+
 	// ConvertFunc is the converting function for this field.
 	ConvertFunc AnyConvertFunc
 
@@ -90,6 +137,19 @@ type CachedFieldInfoBase struct {
 	RemoveSymbolsFieldName string
 }
 
+// MatchKeyPattern checks whether source key `key` matches the KeyPattern of current field info,
+// and returns the wildcard capture of `key`, which should not be empty.
+func (cfi *CachedFieldInfo) MatchKeyPattern(key string) (capture string, ok bool) {
+	prefix, suffix, found := strings.Cut(cfi.KeyPattern, "*")
+	if !found || len(key) <= len(prefix)+len(suffix) {
+		return "", false
+	}
+	if !strings.HasPrefix(key, prefix) || !strings.HasSuffix(key, suffix) {
+		return "", false
+	}
+	return key[len(prefix) : len(key)-len(suffix)], true
+}
+
 // FieldName returns the field name of current field info.
 func (cfi *CachedFieldInfo) FieldName() string {
 	return cfi.PriorityTagAndFieldName[len(cfi.PriorityTagAndFieldName)-1]
@@ -8,6 +8,7 @@ package structcache
 
 import (
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/gogf/gf/v2/internal/utils"
@@ -28,6 +29,31 @@ type CachedStructInfo struct {
 	//
 	// It will be stored twice, which keys are `name` and `field`.
 	tagOrFiledNameToFieldInfoMap map[string]*CachedFieldInfo
+
+	// hasDefaultValue marks whether any field of the struct has default value in tag.
+	hasDefaultValue bool
+
+	// hasIndex marks whether any field of the struct has position index in tag.
+	hasIndex bool
+
+	// hasRequired marks whether any field of the struct is required in tag.
+	hasRequired bool
+
+	// hasNestedField marks whether any field of the struct is type of struct/map/slice/array/interface,
+	// which might be bound from the nested values.
+	hasNestedField bool
+
+	// remainingFieldInfo is the map field capturing the unmatched source keys,
+	// which is specified by tag option, eg: `gconv:",remaining"`.
+	remainingFieldInfo *CachedFieldInfo
+
+	// patternFieldInfos are the map fields collecting the source keys matching their key patterns,
+	// which is specified by tag option, eg: `gconv:"pattern:title_*"`.
+	patternFieldInfos []*CachedFieldInfo
+
+	// fieldTagOptions holds the converting tag options of the attributes of the struct itself,
+	// which is keyed by the attribute index and only has the attributes having options.
+	fieldTagOptions map[int]map[string]string
 }
 
 // NewCachedStructInfo creates and returns a new CachedStructInfo object.
@@ -44,7 +70,46 @@ func (csi *CachedStructInfo) GetFieldConvertInfos() []*CachedFieldInfo {
 }
 
 func (csi *CachedStructInfo) HasNoFields() bool {
-	return len(csi.tagOrFiledNameToFieldInfoMap) == 0
+	return len(csi.tagOrFiledNameToFieldInfoMap) == 0 && csi.remainingFieldInfo == nil &&
+		len(csi.patternFieldInfos) == 0
+}
+
+// HasDefaultValue checks and returns whether any field of the struct has default value in tag.
+func (csi *CachedStructInfo) HasDefaultValue() bool {
+	return csi.hasDefaultValue
+}
+
+// HasIndex checks and returns whether any field of the struct has position index in tag.
+func (csi *CachedStructInfo) HasIndex() bool {
+	return csi.hasIndex
+}
+
+// HasRequired checks and returns whether any field of the struct is required in tag.
+func (csi *CachedStructInfo) HasRequired() bool {
+	return csi.hasRequired
+}
+
+// HasNestedField checks and returns whether any field of the struct might be bound from the nested
+// values, like the field of type struct/map/slice/array/interface and the remaining/pattern map fields.
+func (csi *CachedStructInfo) HasNestedField() bool {
+	return csi.hasNestedField || csi.remainingFieldInfo != nil || len(csi.patternFieldInfos) > 0
+}
+
+// GetRemainingFieldInfo returns the map field capturing the unmatched source keys.
+// It returns nil if there's no such field in the struct.
+func (csi *CachedStructInfo) GetRemainingFieldInfo() *CachedFieldInfo {
+	return csi.remainingFieldInfo
+}
+
+// GetPatternFieldInfos returns the map fields collecting the source keys matching their key patterns.
+func (csi *CachedStructInfo) GetPatternFieldInfos() []*CachedFieldInfo {
+	return csi.patternFieldInfos
+}
+
+// GetFieldTagOptions returns the converting tag options of the attribute at index `fieldIndex` of the struct,
+// which is parsed by ParseTagOptions. It returns nil if the attribute has no option.
+func (csi *CachedStructInfo) GetFieldTagOptions(fieldIndex int) map[string]string {
+	return csi.fieldTagOptions[fieldIndex]
 }
 
 func (csi *CachedStructInfo) GetFieldInfo(fieldName string) *CachedFieldInfo {
@@ -52,6 +117,29 @@ func (csi *CachedStructInfo) GetFieldInfo(fieldName string) *CachedFieldInfo {
 }
 
 func (csi *CachedStructInfo) AddField(field reflect.StructField, fieldIndexes []int, priorityTags []string) {
+	// The tag options of the attributes of the struct itself are cached for the map converting.
+	if len(fieldIndexes) == 1 {
+		if tagOptions := ParseTagOptions(field); tagOptions != nil {
+			if csi.fieldTagOptions == nil {
+				csi.fieldTagOptions = make(map[int]map[string]string)
+			}
+			csi.fieldTagOptions[fieldIndexes[0]] = tagOptions
+		}
+	}
+	// The remaining field does not take part in the key matching.
+	if isRemainingField(field) {
+		if csi.remainingFieldInfo == nil {
+			csi.remainingFieldInfo = csi.makeCachedFieldInfo(field, fieldIndexes, priorityTags)
+		}
+		return
+	}
+	// The pattern field does not take part in the key matching either.
+	if keyPattern := getFieldKeyPattern(field); keyPattern != "" {
+		patternFieldInfo := csi.makeCachedFieldInfo(field, fieldIndexes, priorityTags)
+		patternFieldInfo.KeyPattern = keyPattern
+		csi.patternFieldInfos = append(csi.patternFieldInfos, patternFieldInfo)
+		return
+	}
 	tagOrFieldNameArray := csi.genPriorityTagAndFieldName(field, priorityTags)
 	for _, tagOrFieldName := range tagOrFieldNameArray {
 		cachedFieldInfo, found := csi.tagOrFiledNameToFieldInfoMap[tagOrFieldName]
@@ -118,6 +206,42 @@ func (csi *CachedStructInfo) makeCachedFieldInfo(
 		PriorityTagAndFieldName: csi.genPriorityTagAndFieldName(field, priorityTags),
 		RemoveSymbolsFieldName:  utils.RemoveSymbols(field.Name),
 	}
+	var fieldType = field.Type
+	for fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	switch fieldType.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Array, reflect.Interface:
+		csi.hasNestedField = true
+	case reflect.Slice:
+		csi.hasNestedField = true
+		base.IsBytes = fieldType.Elem().Kind() == reflect.Uint8
+	default:
+	}
+	if tagOptions := ParseTagOptions(field); tagOptions != nil {
+		base.DefaultValue, base.HasDefaultValue = tagOptions[TagOptionDefault]
+		if base.HasDefaultValue {
+			csi.hasDefaultValue = true
+		}
+		_, base.IsWrapper = tagOptions[TagOptionWrapper]
+		if indexValue, ok := tagOptions[TagOptionIndex]; ok {
+			if index, err := strconv.Atoi(indexValue); err == nil && index >= 0 {
+				base.Index, base.HasIndex = index, true
+				csi.hasIndex = true
+			}
+		}
+		base.NestedFormat = tagOptions[TagOptionNested]
+		_, base.IsFlags = tagOptions[TagOptionFlags]
+		base.ConverterName = tagOptions[TagOptionConverter]
+		if maxLenValue, ok := tagOptions[TagOptionMaxLen]; ok {
+			if maxLen, err := strconv.Atoi(maxLenValue); err == nil && maxLen > 0 {
+				base.MaxLen = maxLen
+			}
+		}
+		if _, base.IsRequired = tagOptions[TagOptionRequired]; base.IsRequired {
+			csi.hasRequired = true
+		}
+	}
 	base.LastFuzzyKey.Store(field.Name)
 	return &CachedFieldInfo{
 		CachedFieldInfoBase: base,
@@ -159,7 +283,7 @@ func (csi *CachedStructInfo) genPriorityTagAndFieldName(
 			// Example:
 			// orm:"id, priority"
 			// orm:"name, with:uid=id"
-			tagValueItems := strings.Split(value, ",")
+			tagValueItems := strings.Split(TrimTagOptions(tag, value), ",")
 			// json:",omitempty"
 			trimmedTagName := strings.TrimSpace(tagValueItems[0])
 			if trimmedTagName != "" {
@@ -0,0 +1,230 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package structcache
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/gogf/gf/v2/util/gtag"
+)
+
+const (
+	// TagOptionDefault is the tag option specifying the default value for the field,
+	// which is used if the field is missing in the source, eg: `gconv:"default:10"`.
+	TagOptionDefault = "default"
+
+	// TagOptionWrapper is the flag tag option marking the field as wrapper type, which is a struct
+	// having a single exported field like `*wrapperspb.StringValue`, eg: `gconv:",wrapper"`.
+	// The scalar value is wrapped automatically when converting to the wrapper field, and the
+	// wrapper value is unwrapped automatically when converting to scalar field or map.
+	TagOptionWrapper = "wrapper"
+
+	// TagOptionIndex is the tag option specifying the position of the field in slice source,
+	// which is used for binding slice like csv row to struct by position, eg: `gconv:"index:0"`.
+	TagOptionIndex = "index"
+
+	// TagOptionRemaining is the flag tag option marking the map field capturing all the source keys
+	// that are not matched to other fields, eg: `gconv:",remaining"`. The captured items are output
+	// back when converting the struct to map, which enables the passthrough of unknown keys.
+	TagOptionRemaining = "remaining"
+
+	// TagOptionNested is the tag option specifying the encoding format of string source value for the
+	// struct/slice/map field, which is decoded before binding, eg: `gconv:"nested:json"`.
+	// It supports formats NestedFormatJson and NestedFormatForm.
+	TagOptionNested = "nested"
+
+	// TagOptionFlags is the flag tag option marking the integer field as bitflags, which is bound from
+	// the flag names using the mapping registered for the field type, eg: `gconv:",flags"`.
+	TagOptionFlags = "flags"
+
+	// TagOptionRequired is the flag tag option marking the field as required, which makes the converting
+	// fail if the field is missing in the source, eg: `gconv:",required"`.
+	TagOptionRequired = "required"
+
+	// TagOptionConverter is the tag option specifying the name of converter function for the field,
+	// which is registered by RegisterNamedConverter, eg: `gconv:"converter:trim"`.
+	TagOptionConverter = "converter"
+
+	// TagOptionPattern is the tag option specifying the key pattern having one wildcard char '*' for the map
+	// field, which collects all the matched source keys into the map keyed by the wildcard capture,
+	// eg: `gconv:"pattern:title_*"` collects keys `title_en` and `title_fr` as map keys `en` and `fr`.
+	TagOptionPattern = "pattern"
+
+	// TagOptionMaxLen is the tag option specifying the maximum length of the string/[]byte field, which
+	// truncates the oversized value in default, eg: `gconv:"maxlen:256"`. The length of string is counted
+	// in runes and the length of []byte is counted in bytes.
+	TagOptionMaxLen = "maxlen"
+)
+
+const (
+	NestedFormatJson = "json" // JSON encoded string, eg: `{"name":"john"}`.
+	NestedFormatForm = "form" // URL-encoded form string, eg: `name=john&age=18`.
+)
+
+// tagOptionTags are the tags that can contain converting options.
+var tagOptionTags = []string{gtag.GConv, gtag.GConvShort}
+
+// tagValueOptions are the converting options in format `key:value`, like `default:10`.
+var tagValueOptions = map[string]struct{}{
+	TagOptionDefault:   {},
+	TagOptionIndex:     {},
+	TagOptionNested:    {},
+	TagOptionConverter: {},
+	TagOptionPattern:   {},
+	TagOptionMaxLen:    {},
+}
+
+// tagFlagOptions are the converting options without value, like `wrapper`.
+var tagFlagOptions = map[string]struct{}{
+	TagOptionWrapper:   {},
+	TagOptionRemaining: {},
+	TagOptionFlags:     {},
+	TagOptionRequired:  {},
+}
+
+// isTagOptionTag checks and returns whether tag `tag` can contain converting options.
+func isTagOptionTag(tag string) bool {
+	for _, optionTag := range tagOptionTags {
+		if tag == optionTag {
+			return true
+		}
+	}
+	return false
+}
+
+// isTagOptionItem checks and returns whether `item` at position `index` of value of tag `tag` is a
+// known converting option in format `key:value`, like `default:10`, or a flag option like `wrapper`.
+// The options are only available in tags gconv/c, so the names like `dc:title` in other tags are kept.
+// The flag options are only after the name, eg: `name,wrapper` or `,wrapper`, as the first item like
+// `required` in `c:"required"` is always the name.
+func isTagOptionItem(tag, item string, index int) bool {
+	if !isTagOptionTag(tag) {
+		return false
+	}
+	key, _, hasValue := strings.Cut(item, ":")
+	key = strings.TrimSpace(key)
+	if hasValue {
+		_, ok := tagValueOptions[key]
+		return ok
+	}
+	if index == 0 {
+		return false
+	}
+	_, ok := tagFlagOptions[key]
+	return ok
+}
+
+// TrimTagOptions removes the converting options from value `tagValue` of tag `tag`, and returns
+// the remaining tag value, eg: `name,default:10` -> `name`.
+// It returns an empty string if there's no name in tag value, eg: `default:10`.
+func TrimTagOptions(tag, tagValue string) string {
+	// Fast path for the common tag value having no option, like `name` or empty value.
+	if !isTagOptionTag(tag) || !strings.ContainsAny(tagValue, ",:") {
+		return tagValue
+	}
+	var items = strings.Split(tagValue, ",")
+	var remaining = make([]string, 0, len(items))
+	for i, item := range items {
+		if isTagOptionItem(tag, item, i) {
+			continue
+		}
+		remaining = append(remaining, item)
+	}
+	if len(remaining) == len(items) {
+		return tagValue
+	}
+	return strings.Join(remaining, ",")
+}
+
+// ParseTagOptions parses and returns the converting options from tags gconv/c of struct field.
+// The options are in format `key:value`, and are separated by char ',' with the field name,
+// eg: `gconv:"age,default:10"`. The value of flag option is empty, eg: `gconv:",wrapper"`.
+// The returned map is nil if there's no converting option.
+func ParseTagOptions(field reflect.StructField) map[string]string {
+	var options map[string]string
+	for _, tag := range tagOptionTags {
+		// The empty tag value or single name without options has nothing to be parsed.
+		tagValue := field.Tag.Get(tag)
+		if !strings.ContainsAny(tagValue, ",:") {
+			continue
+		}
+		for i, item := range strings.Split(tagValue, ",") {
+			if !isTagOptionItem(tag, item, i) {
+				continue
+			}
+			array := strings.SplitN(item, ":", 2)
+			if options == nil {
+				options = make(map[string]string)
+			}
+			key := strings.TrimSpace(array[0])
+			// The option in former tag has higher priority.
+			if _, ok := options[key]; ok {
+				continue
+			}
+			if len(array) > 1 {
+				options[key] = strings.TrimSpace(array[1])
+			} else {
+				options[key] = ""
+			}
+		}
+	}
+	return options
+}
+
+// isRemainingField checks and returns whether `field` is a map field marked by tag option
+// `remaining`, eg: `gconv:",remaining"`.
+func isRemainingField(field reflect.StructField) bool {
+	if _, ok := ParseTagOptions(field)[TagOptionRemaining]; !ok {
+		return false
+	}
+	var fieldType = field.Type
+	for fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	return fieldType.Kind() == reflect.Map && fieldType.Key().Kind() == reflect.String
+}
+
+// getFieldKeyPattern returns the key pattern of `field`, which is a map field having string key and
+// tag option `pattern` with exactly one wildcard char '*', eg: `gconv:"pattern:title_*"`.
+// It returns empty string if `field` is not such a field.
+func getFieldKeyPattern(field reflect.StructField) string {
+	pattern := ParseTagOptions(field)[TagOptionPattern]
+	if strings.Count(pattern, "*") != 1 {
+		return ""
+	}
+	var fieldType = field.Type
+	for fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	if fieldType.Kind() != reflect.Map || fieldType.Key().Kind() != reflect.String {
+		return ""
+	}
+	return pattern
+}
+
+// GetWrapperValueField retrieves and returns the single exported field of wrapper type `t`,
+// which is a struct or pointer to struct having only one exported field, eg: `wrapperspb.StringValue`.
+// It returns false if `t` is not a wrapper type.
+func GetWrapperValueField(t reflect.Type) (field reflect.StructField, ok bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			continue
+		}
+		if ok {
+			return reflect.StructField{}, false
+		}
+		field, ok = t.Field(i), true
+	}
+	return
+}
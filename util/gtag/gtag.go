@@ -47,6 +47,8 @@ const (
 	Security             = "security"        // Security defines scheme for authentication. Detail to see https://swagger.io/docs/specification/authentication/
 	In                   = "in"              // Swagger distinguishes between the following parameter types based on the parameter location. Detail to see https://swagger.io/docs/specification/describing-parameters/
 	Required             = "required"        // OpenAPIv3 required attribute name for request body.
+	Style                = "style"           // Serialization style of parameter, like OpenAPI, eg: form, spaceDelimited, pipeDelimited.
+	Explode              = "explode"         // Whether array parameter is serialized as separate parameters, like OpenAPI.
 	Status               = "status"          // Response status code, usually for OpenAPI in response struct.
 	ResponseExample      = "responseExample" // Response example resource path, usually for OpenAPI in response struct.
 	ResponseExampleShort = "resEg"           // Short name of ResponseExample.